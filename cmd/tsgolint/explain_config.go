@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+func runExplainConfig(args []string) int {
+	flags := flag.NewFlagSet("explain-config", flag.ContinueOnError)
+	var prefer string
+	flags.StringVar(&prefer, "prefer", "", "comma-separated config file names or patterns to look for first, e.g. tsconfig.test.json")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, "Usage: tsgolint explain-config [--prefer PATTERNS] FILE\n\nExplain which tsconfig FILE is linted with, and why.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 1
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 1
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error getting current directory: %v\n", err)
+		return 1
+	}
+	cwd = tspath.NormalizePath(cwd)
+
+	fs := bundled.WrapFS(cachedvfs.From(osvfs.FS()))
+	filePath := tspath.NormalizePath(tspath.ResolvePath(cwd, flags.Arg(0)))
+	if !fs.FileExists(filePath) {
+		fmt.Fprintf(os.Stderr, "error: file %v does not exist\n", filePath)
+		return 1
+	}
+
+	resolver := utils.NewTsConfigResolver(fs, cwd)
+	if prefer != "" {
+		if err := resolver.SetPreferredConfigs(strings.Split(prefer, ",")); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			return 1
+		}
+	}
+
+	explanation := resolver.ExplainTsconfigForFile(filePath)
+	fmt.Print(formatConfigExplanation(explanation))
+	return 0
+}
+
+func formatConfigExplanation(explanation utils.ConfigExplanation) string {
+	var output strings.Builder
+
+	fmt.Fprintf(&output, "File: %v\n", explanation.FileName)
+	if explanation.NearestConfig == "" {
+		fmt.Fprintf(&output, "No tsconfig.json or jsconfig.json found in the directory of the file or its parents.\n")
+	} else {
+		fmt.Fprintf(&output, "Nearest config: %v\n", explanation.NearestConfig)
+	}
+
+	for _, step := range explanation.Steps {
+		switch step.Kind {
+		case utils.ConfigStepVisit:
+			fmt.Fprintf(&output, "  checked %v", step.ConfigFileName)
+			if step.ReferencedBy != "" {
+				fmt.Fprintf(&output, " (referenced by %v)", step.ReferencedBy)
+			}
+			fmt.Fprintf(&output, ": %v\n", step.Rejection)
+		case utils.ConfigStepPreferred:
+			fmt.Fprintf(&output, "  checked preferred config %v: %v\n", step.ConfigFileName, step.Rejection)
+		case utils.ConfigStepAncestor:
+			fmt.Fprintf(&output, "  no project contains the file, searching the config of a parent directory: %v\n", step.ConfigFileName)
+		case utils.ConfigStepSolutionSearchingDisabled:
+			fmt.Fprintf(&output, "  %v sets disableSolutionSearching, parent directories are not searched\n", step.ConfigFileName)
+		case utils.ConfigStepFallback:
+			fallback := step.ConfigFileName
+			if fallback == "" {
+				fallback = "the inferred project"
+			}
+			fmt.Fprintf(&output, "  no project contains the file, falling back to %v\n", fallback)
+		}
+	}
+
+	if explanation.ConfigFileName == "" {
+		fmt.Fprintf(&output, "Result: inferred project\n")
+	} else {
+		fmt.Fprintf(&output, "Result: %v\n", explanation.ConfigFileName)
+	}
+	return output.String()
+}
@@ -2,14 +2,16 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/binary"
 	"flag"
 	"fmt"
 	"io"
-	"log"
+	"maps"
 	"os"
 	"runtime"
 	"slices"
+	"strings"
 	"sync"
 
 	"github.com/go-json-experiment/json"
@@ -18,6 +20,7 @@ import (
 	"github.com/microsoft/typescript-go/shim/bundled"
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
 	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
 	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
 	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
@@ -28,12 +31,21 @@ import (
 
 type headlessOptions struct {
 	traceOut       string
+	traceEventsOut string
 	cpuprofOut     string
 	heapOut        string
 	allocsOut      string
 	fix            bool
 	fixSuggestions bool
-	debugTimings   bool
+	verifyFixes    bool
+	fixKinds       []rule.RuleFixKind
+	applyFixes     bool
+	fixIterations  int
+	// Send the fixes which overlap others
+	reportFixConflicts bool
+	debug              debugOptions
+	// Overridden by the payload
+	inferredProjectOptions *utils.InferredProjectOptions
 }
 
 var suppressProgramDiagnostics = sync.OnceValue(func() bool {
@@ -43,28 +55,77 @@ var suppressProgramDiagnostics = sync.OnceValue(func() bool {
 func parseHeadlessOptions(args []string) (*headlessOptions, error) {
 	var opts headlessOptions
 	var debug string
+	var fixKinds string
 
 	flag.StringVar(&opts.traceOut, "trace", "", "file to put trace to")
+	flag.StringVar(&opts.traceEventsOut, "trace-events", "", "file to put Chrome trace events to")
 	flag.StringVar(&opts.cpuprofOut, "cpuprof", "", "file to put cpu profiling to")
 	flag.StringVar(&opts.heapOut, "heap", "", "file to put heap profiling to")
 	flag.StringVar(&opts.allocsOut, "allocs", "", "file to put allocs profiling to")
 	flag.BoolVar(&opts.fix, "fix", false, "generate fixes for code problems")
 	flag.BoolVar(&opts.fixSuggestions, "fix-suggestions", false, "generate suggestions for code problems")
+	flag.BoolVar(&opts.verifyFixes, "verify-fixes", false, "type-check fixes and revert those introducing type errors")
+	flag.StringVar(&fixKinds, "fix-kinds", "", "comma-separated kinds of fixes and suggestions to generate: safe, unsafe, layout (default: all)")
+	flag.BoolVar(&opts.applyFixes, "apply-fixes", false, "apply fixes in memory and lint again until no fixes remain; implies -fix")
+	flag.BoolVar(&opts.reportFixConflicts, "report-fix-conflicts", false, "report fixes which overlap others; implies -fix")
+	flag.IntVar(&opts.fixIterations, "max-fix-iterations", linter.DefaultMaxFixIterations, "maximum number of lint passes with -apply-fixes")
 	flag.StringVar(&debug, "debug", "", "enable debug output options")
+	var inferredProjectOptions string
+	flag.StringVar(&inferredProjectOptions, "inferred-project-options", "", `JSON compiler options of the files not included by any tsconfig, e.g. {"strict":true}: strict, target, lib, moduleResolution, allowJs, checkJs, types`)
 
 	if err := flag.CommandLine.Parse(args); err != nil {
 		return nil, err
 	}
 
-	debugTimings, err := parseDebugTimings(debug)
+	kinds, err := parseFixKinds(fixKinds)
 	if err != nil {
 		return nil, err
 	}
-	opts.debugTimings = debugTimings
+	opts.fixKinds = kinds
+
+	if opts.reportFixConflicts {
+		opts.fix = true
+	}
+	if opts.applyFixes {
+		opts.fix = true
+		if opts.fixIterations < 1 {
+			return nil, fmt.Errorf("-max-fix-iterations must be at least 1, got %d", opts.fixIterations)
+		}
+	}
+
+	opts.debug, err = parseDebugOptions(debug)
+	if err != nil {
+		return nil, err
+	}
+
+	if inferredProjectOptions != "" {
+		opts.inferredProjectOptions = &utils.InferredProjectOptions{}
+		if err := json.Unmarshal([]byte(inferredProjectOptions), opts.inferredProjectOptions, json.RejectUnknownMembers(true)); err != nil {
+			return nil, fmt.Errorf("invalid -inferred-project-options: %w", err)
+		}
+		if _, err := opts.inferredProjectOptions.CompilerOptions(); err != nil {
+			return nil, fmt.Errorf("invalid -inferred-project-options: %w", err)
+		}
+	}
 
 	return &opts, nil
 }
 
+func parseFixKinds(options string) ([]rule.RuleFixKind, error) {
+	var kinds []rule.RuleFixKind
+	for option := range strings.SplitSeq(options, ",") {
+		if option == "" {
+			continue
+		}
+		kind, ok := rule.ParseRuleFixKind(option)
+		if !ok {
+			return nil, fmt.Errorf("unknown fix kind %q", option)
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
 type headlessRange struct {
 	Pos int `json:"pos"`
 	End int `json:"end"`
@@ -97,21 +158,45 @@ func headlessRuleMessageFromRuleMessage(msg rule.RuleMessage) headlessRuleMessag
 type headlessFix struct {
 	Text  string        `json:"text"`
 	Range headlessRange `json:"range"`
+	// "safe", "unsafe" or "layout"
+	Kind string `json:"kind"`
+}
+
+// Edits of a file other than the diagnostic's one. They belong to the same fix
+// as the diagnostic's `fixes` and must be applied together with them.
+type headlessFileFixes struct {
+	FilePath string        `json:"file_path"`
+	Fixes    []headlessFix `json:"fixes"`
 }
 type headlessSuggestion struct {
-	Message headlessRuleMessage `json:"message"`
-	Fixes   []headlessFix       `json:"fixes"`
+	Message   headlessRuleMessage `json:"message"`
+	Fixes     []headlessFix       `json:"fixes"`
+	FileFixes []headlessFileFixes `json:"file_fixes,omitempty"`
 }
 
-func headlessFixesFromRuleFixes(fixes []rule.RuleFix) []headlessFix {
-	headlessFixes := make([]headlessFix, len(fixes))
-	for i, fix := range fixes {
-		headlessFixes[i] = headlessFix{
+// Returns the fixes of the diagnostic's file, and the fixes of other files
+// grouped by file.
+func headlessFixesFromRuleFixes(fixes []rule.RuleFix) ([]headlessFix, []headlessFileFixes) {
+	headlessFixes := make([]headlessFix, 0, len(fixes))
+	var fileFixes []headlessFileFixes
+	for _, fix := range fixes {
+		hf := headlessFix{
 			Text:  fix.Text,
 			Range: *headlessRangeFromRange(fix.Range),
+			Kind:  fix.Kind.String(),
+		}
+		if fix.FileName == "" {
+			headlessFixes = append(headlessFixes, hf)
+			continue
+		}
+		idx := slices.IndexFunc(fileFixes, func(f headlessFileFixes) bool { return f.FilePath == fix.FileName })
+		if idx < 0 {
+			idx = len(fileFixes)
+			fileFixes = append(fileFixes, headlessFileFixes{FilePath: fix.FileName})
 		}
+		fileFixes[idx].Fixes = append(fileFixes[idx].Fixes, hf)
 	}
-	return headlessFixes
+	return headlessFixes, fileFixes
 }
 
 // Diagnostic kind discriminator
@@ -120,6 +205,7 @@ type headlessDiagnosticKind uint8
 const (
 	headlessDiagnosticKindRule headlessDiagnosticKind = iota
 	headlessDiagnosticKindTsconfig
+	headlessDiagnosticKindUnusedDisableDirective
 )
 
 // A labeled span of source code. Useful for highlighting additional info related to a diagnostic in the context
@@ -141,6 +227,7 @@ type headlessDiagnostic struct {
 	// Only for kind="rule"
 	Rule        *string              `json:"rule,omitempty"`
 	Fixes       []headlessFix        `json:"fixes,omitempty"`
+	FileFixes   []headlessFileFixes  `json:"file_fixes,omitempty"`
 	Suggestions []headlessSuggestion `json:"suggestions,omitempty"`
 }
 
@@ -150,32 +237,198 @@ const (
 	headlessMessageTypeError headlessMessageType = iota
 	headlessMessageTypeDiagnostic
 	headlessMessageTypeTiming
+	headlessMessageTypeFixedFile
+	headlessMessageTypeFixConflict
+	headlessMessageTypeConfigExplanation
 )
 
 type headlessMessagePayloadError struct {
 	Error string `json:"error"`
 }
 
+// Final text of a file changed by `-apply-fixes`. Sent after all diagnostics,
+// whose ranges are relative to this text.
+type headlessFixedFilePayload struct {
+	FilePath string `json:"file_path"`
+	Text     string `json:"text"`
+}
+
+// Fixes of a diagnostic which cannot be applied together with the fixes of
+// another one, sent with `-report-fix-conflicts` after the diagnostics.
+// Conflicts are computed by applying the fixes of each file in one pass; with
+// `-apply-fixes`, they are the ones remaining after the last pass.
+type headlessFixConflictPayload struct {
+	// File in which the fixes overlap
+	FilePath string                   `json:"file_path"`
+	Winner   headlessConflictingFixes `json:"winner"`
+	Loser    headlessConflictingFixes `json:"loser"`
+}
+
+type headlessConflictingFixes struct {
+	Rule      string `json:"rule"`
+	MessageId string `json:"message_id"`
+	// File of the diagnostic
+	DiagnosticFilePath string `json:"diagnostic_file_path"`
+	// Range spanned by the fixes in the conflicting file
+	Range headlessRange `json:"range"`
+}
+
+func headlessFixConflictPayloadFromConflict(conflict linter.FixConflict[rule.RuleDiagnostic]) headlessFixConflictPayload {
+	side := func(d rule.RuleDiagnostic, r core.TextRange) headlessConflictingFixes {
+		return headlessConflictingFixes{
+			Rule:               d.RuleName,
+			MessageId:          d.Message.Id,
+			DiagnosticFilePath: d.SourceFile.FileName(),
+			Range:              headlessRange{Pos: r.Pos(), End: r.End()},
+		}
+	}
+	return headlessFixConflictPayload{
+		FilePath: conflict.FileName,
+		Winner:   side(conflict.Winner, conflict.WinnerRange),
+		Loser:    side(conflict.Loser, conflict.LoserRange),
+	}
+}
+
+// How the tsconfig of a file was found, sent with `-debug explain-config`
+// before the diagnostics. Only config_file_path is set for files whose
+// tsconfig is set by the payload.
+type headlessConfigExplanationPayload struct {
+	FilePath string `json:"file_path"`
+	// The config the search started from, empty if there is none
+	NearestConfig string               `json:"nearest_config"`
+	Steps         []headlessConfigStep `json:"steps"`
+	// Empty if the file is linted in the inferred project
+	ConfigFilePath string `json:"config_file_path"`
+}
+
+type headlessConfigStep struct {
+	// "visit", "ancestor", "solution_searching_disabled", "fallback" or
+	// "preferred"
+	Kind           string `json:"kind"`
+	ConfigFilePath string `json:"config_file_path"`
+	// Only for kind="visit"
+	ReferencedBy string `json:"referenced_by,omitempty"`
+	// Only for kind="visit" and kind="preferred": "none", "not_loaded", "no_files",
+	// "composite_mismatch" or "not_in_file_names"
+	Rejection string `json:"rejection,omitempty"`
+}
+
+var headlessConfigStepKinds = map[utils.ConfigStepKind]string{
+	utils.ConfigStepVisit:                     "visit",
+	utils.ConfigStepAncestor:                  "ancestor",
+	utils.ConfigStepSolutionSearchingDisabled: "solution_searching_disabled",
+	utils.ConfigStepFallback:                  "fallback",
+	utils.ConfigStepPreferred:                 "preferred",
+}
+
+var headlessConfigRejections = map[utils.ConfigRejection]string{
+	utils.ConfigRejectionNone:              "none",
+	utils.ConfigRejectionNotLoaded:         "not_loaded",
+	utils.ConfigRejectionNoFiles:           "no_files",
+	utils.ConfigRejectionCompositeMismatch: "composite_mismatch",
+	utils.ConfigRejectionNotInFileNames:    "not_in_file_names",
+}
+
+func headlessConfigExplanationPayloadFromExplanation(explanation utils.ConfigExplanation) headlessConfigExplanationPayload {
+	steps := make([]headlessConfigStep, len(explanation.Steps))
+	for i, step := range explanation.Steps {
+		steps[i] = headlessConfigStep{
+			Kind:           headlessConfigStepKinds[step.Kind],
+			ConfigFilePath: step.ConfigFileName,
+			ReferencedBy:   step.ReferencedBy,
+		}
+		if step.Kind == utils.ConfigStepVisit || step.Kind == utils.ConfigStepPreferred {
+			steps[i].Rejection = headlessConfigRejections[step.Rejection]
+		}
+	}
+	return headlessConfigExplanationPayload{
+		FilePath:       explanation.FileName,
+		NearestConfig:  explanation.NearestConfig,
+		Steps:          steps,
+		ConfigFilePath: explanation.ConfigFileName,
+	}
+}
+
 type headlessTimingPayload struct {
-	Rules []headlessRuleTiming `json:"rules"`
+	Rules    []headlessRuleTiming    `json:"rules"`
+	Programs []headlessProgramTiming `json:"programs"`
+	// The slowest files, at most slowestFilesCount
+	Files     []headlessFileTiming   `json:"files"`
+	TypeCache headlessTypeCacheStats `json:"type_cache"`
+}
+
+type headlessTypeCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
 }
 
 type headlessRuleTiming struct {
-	RuleName string `json:"rule_name"`
-	Duration uint64 `json:"duration"`
-	Calls    uint64 `json:"calls"`
+	RuleName        string `json:"rule_name"`
+	Duration        uint64 `json:"duration"`
+	CheckerDuration uint64 `json:"checker_duration"`
+	Calls           uint64 `json:"calls"`
+}
+
+type headlessProgramTiming struct {
+	// Empty for the inferred project
+	ConfigFilePath string `json:"config_file_path"`
+	Files          int    `json:"files"`
+	Create         uint64 `json:"create"`
+	Bind           uint64 `json:"bind"`
+	Check          uint64 `json:"check"`
 }
 
-func headlessTimingPayloadFromRecords(records []linter.RuleTimingRecord) headlessTimingPayload {
+type headlessFileTiming struct {
+	FilePath        string `json:"file_path"`
+	Duration        uint64 `json:"duration"`
+	CheckerDuration uint64 `json:"checker_duration"`
+}
+
+func headlessTimingPayloadFromStore(timingStore *linter.RuleTimingStore) headlessTimingPayload {
+	records := timingStore.Collect()
 	rules := make([]headlessRuleTiming, len(records))
 	for i, record := range records {
 		rules[i] = headlessRuleTiming{
-			RuleName: record.RuleName,
-			Duration: uint64(record.Duration),
-			Calls:    record.Calls,
+			RuleName:        record.RuleName,
+			Duration:        uint64(record.Duration),
+			CheckerDuration: uint64(record.CheckerDuration),
+			Calls:           record.Calls,
+		}
+	}
+
+	programRecords := timingStore.CollectPrograms()
+	programs := make([]headlessProgramTiming, len(programRecords))
+	for i, record := range programRecords {
+		programs[i] = headlessProgramTiming{
+			ConfigFilePath: record.ConfigFileName,
+			Files:          record.Files,
+			Create:         uint64(record.Create),
+			Bind:           uint64(record.Bind),
+			Check:          uint64(record.Check),
 		}
 	}
-	return headlessTimingPayload{Rules: rules}
+
+	fileRecords := timingStore.CollectFiles()
+	fileRecords = fileRecords[:min(len(fileRecords), slowestFilesCount)]
+	files := make([]headlessFileTiming, len(fileRecords))
+	for i, record := range fileRecords {
+		files[i] = headlessFileTiming{
+			FilePath:        record.FileName,
+			Duration:        uint64(record.Duration),
+			CheckerDuration: uint64(record.CheckerDuration),
+		}
+	}
+
+	typeCacheStats := timingStore.TypeCacheStats()
+	return headlessTimingPayload{
+		Rules:    rules,
+		Programs: programs,
+		Files:    files,
+		TypeCache: headlessTypeCacheStats{
+			Hits:   typeCacheStats.Hits,
+			Misses: typeCacheStats.Misses,
+		},
+	}
 }
 
 // Unified diagnostic type for channel
@@ -211,15 +464,94 @@ func writeErrorMessage(text string) error {
 	})
 }
 
-func runHeadless(args []string) int {
-	logLevel := utils.GetLogLevel()
-	log.SetOutput(os.Stderr)
-	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds)
+// The files of a payload, assigned to programs.
+type headlessWorkload struct {
+	workload linter.Workload
+	// Files whose tsconfig was searched for
+	searchedFiles []string
+	// Files whose tsconfig is set by their config
+	explicitTsconfigs map[string]string
+	// Rules of each file
+	fileConfigs map[string][]headlessRule
+}
+
+// Assigns the files of payload to programs. Files of configs with a `tsconfig`
+// are linted with it, which must include them. The tsconfig of the other files
+// is searched for with resolver.
+func assignHeadlessWorkload(payload *headlessPayload, cwd string, fs vfs.FS, resolver *utils.TsConfigResolver, traceEvents *linter.TraceEvents) (headlessWorkload, error) {
+	totalFileCount := 0
+	for _, config := range payload.Configs {
+		totalFileCount += len(config.FilePaths)
+	}
+	resolverLogger := utils.Logger(utils.LogSubsystemResolver)
+	resolverLogger.Debug("assigning files to programs", "files", totalFileCount)
+
+	assigned := headlessWorkload{
+		workload: linter.Workload{
+			Programs:       make(map[string][]string),
+			UnmatchedFiles: []string{},
+		},
+		searchedFiles:     make([]string, 0, totalFileCount),
+		explicitTsconfigs: make(map[string]string),
+		fileConfigs:       make(map[string][]headlessRule, totalFileCount),
+	}
+	for _, config := range payload.Configs {
+		tsconfig := ""
+		if config.Tsconfig != "" {
+			tsconfig = tspath.NormalizePath(tspath.ResolvePath(cwd, config.Tsconfig))
+			if !fs.FileExists(tsconfig) {
+				return headlessWorkload{}, fmt.Errorf("tsconfig %v does not exist", tsconfig)
+			}
+		}
+
+		for _, filePath := range config.FilePaths {
+			normalized := tspath.NormalizeSlashes(filePath)
+			if tsconfig == "" {
+				assigned.searchedFiles = append(assigned.searchedFiles, normalized)
+			} else {
+				assigned.explicitTsconfigs[normalized] = tsconfig
+			}
 
-	if logLevel == utils.LogLevelDebug {
-		log.Printf("Starting tsgolint")
+			assigned.fileConfigs[normalized] = config.Rules
+		}
 	}
 
+	workload := &assigned.workload
+	for file, tsconfig := range assigned.explicitTsconfigs {
+		if rejection := resolver.CheckConfigForFile(tsconfig, file); rejection != utils.ConfigRejectionNone {
+			return headlessWorkload{}, fmt.Errorf("file %v cannot be linted with tsconfig %v, which %v", file, tsconfig, rejection)
+		}
+		workload.Programs[tsconfig] = append(workload.Programs[tsconfig], file)
+	}
+	resolverLogger.Debug("assigned files to explicit tsconfigs", "files", len(assigned.explicitTsconfigs))
+
+	endResolution := traceEvents.Begin(linter.TraceMainThread, "tsconfig", "resolve tsconfigs", map[string]any{"files": len(assigned.searchedFiles)})
+	result := resolver.FindTsConfigParallel(assigned.searchedFiles)
+	endResolution()
+	for file, tsconfig := range result {
+		if tsconfig == "" {
+			workload.UnmatchedFiles = append(workload.UnmatchedFiles, file)
+		} else {
+			workload.Programs[tsconfig] = append(workload.Programs[tsconfig], file)
+		}
+	}
+
+	resolverLogger.Debug("assigned files to programs", "programs", len(workload.Programs), "unmatchedFiles", len(workload.UnmatchedFiles))
+	if resolverLogger.Enabled(context.Background(), utils.LogLevelDebug) {
+		for program, files := range workload.Programs {
+			resolverLogger.Debug("program files", "tsconfig", program, "files", len(files))
+		}
+		for _, file := range workload.UnmatchedFiles {
+			resolverLogger.Debug("unmatched file", "file", file)
+		}
+	}
+	return assigned, nil
+}
+
+func runHeadless(args []string) int {
+	logger := utils.Logger(utils.LogSubsystemLinter)
+	logger.Info("starting tsgolint")
+
 	opts, err := parseHeadlessOptions(args)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error parsing options: %v", err)
@@ -233,6 +565,11 @@ func runHeadless(args []string) int {
 		defer cleanup()
 	}
 
+	var traceEvents *linter.TraceEvents
+	if opts.traceEventsOut != "" {
+		traceEvents = linter.NewTraceEvents()
+	}
+
 	cwd, err := os.Getwd()
 	if err != nil {
 		writeErrorMessage(fmt.Sprintf("error getting current directory: %v", err))
@@ -250,6 +587,14 @@ func runHeadless(args []string) int {
 		writeErrorMessage(fmt.Sprintf("error parsing config: %v", err))
 		return 1
 	}
+	inferredProjectOptions := opts.inferredProjectOptions
+	if payload.InferredProjectOptions != nil {
+		if _, err := payload.InferredProjectOptions.CompilerOptions(); err != nil {
+			writeErrorMessage(fmt.Sprintf("error parsing config: invalid inferred_project_options: %v", err))
+			return 1
+		}
+		inferredProjectOptions = payload.InferredProjectOptions
+	}
 
 	baseFS := osvfs.FS()
 	if len(payload.SourceOverrides) > 0 {
@@ -257,56 +602,33 @@ func runHeadless(args []string) int {
 	}
 	fs := bundled.WrapFS(cachedvfs.From(baseFS))
 
-	workload := linter.Workload{
-		Programs:       make(map[string][]string),
-		UnmatchedFiles: []string{},
-	}
-
-	totalFileCount := 0
-	for _, config := range payload.Configs {
-		totalFileCount += len(config.FilePaths)
-	}
-	if logLevel == utils.LogLevelDebug {
-		log.Printf("Starting to assign files to programs. Total files: %d", totalFileCount)
-	}
-
 	tsConfigResolver := utils.NewTsConfigResolver(fs, cwd)
-
-	normalizedFiles := make([]string, 0, totalFileCount)
-	fileConfigs := make(map[string][]headlessRule, totalFileCount)
-	for _, config := range payload.Configs {
-		for _, filePath := range config.FilePaths {
-			normalized := tspath.NormalizeSlashes(filePath)
-			normalizedFiles = append(normalizedFiles, normalized)
-
-			fileConfigs[normalized] = config.Rules
-		}
+	if err := tsConfigResolver.SetPreferredConfigs(payload.PreferredTsconfigs); err != nil {
+		writeErrorMessage(fmt.Sprintf("error parsing config: %v", err))
+		return 1
 	}
 
-	result := tsConfigResolver.FindTsConfigParallel(normalizedFiles)
-	for file, tsconfig := range result {
-		if tsconfig == "" {
-			workload.UnmatchedFiles = append(workload.UnmatchedFiles, file)
-		} else {
-			workload.Programs[tsconfig] = append(workload.Programs[tsconfig], file)
-		}
+	assigned, err := assignHeadlessWorkload(payload, cwd, fs, tsConfigResolver, traceEvents)
+	if err != nil {
+		writeErrorMessage(fmt.Sprintf("error parsing config: %v", err))
+		return 1
 	}
+	workload := assigned.workload
+	fileConfigs := assigned.fileConfigs
 
-	if logLevel == utils.LogLevelDebug {
-		for file, tsconfig := range result {
-			tsconfigStr := "<none>"
-			if tsconfig != "" {
-				tsconfigStr = tsconfig
-			}
-			log.Printf("Got tsconfig for file %s: %s", file, tsconfigStr)
+	if opts.debug.explainConfig {
+		explanations := make([]utils.ConfigExplanation, 0, len(fileConfigs))
+		for _, file := range assigned.searchedFiles {
+			explanations = append(explanations, tsConfigResolver.ExplainTsconfigForFile(file))
 		}
-
-		log.Printf("Done assigning files to programs. Total programs: %d. Unmatched files: %d", len(workload.Programs), len(workload.UnmatchedFiles))
-		for program, files := range workload.Programs {
-			log.Printf("  Program %s: %d files", program, len(files))
+		for file, tsconfig := range assigned.explicitTsconfigs {
+			explanations = append(explanations, utils.ConfigExplanation{FileName: file, ConfigFileName: tsconfig})
 		}
-		for _, file := range workload.UnmatchedFiles {
-			log.Printf("  Unmatched file: %s", file)
+		for _, explanation := range explanations {
+			if err := writeMessage(os.Stdout, headlessMessageTypeConfigExplanation, headlessConfigExplanationPayloadFromExplanation(explanation)); err != nil {
+				logger.Error("failed to write config explanation", "error", err)
+				return 1
+			}
 		}
 	}
 
@@ -321,15 +643,15 @@ func runHeadless(args []string) int {
 		})
 	}
 
-	if logLevel == utils.LogLevelDebug {
-		log.Printf("Starting linter with %d workers", runtime.GOMAXPROCS(0))
-		log.Printf("Workload distribution: %d programs", len(workload.Programs))
-	}
+	logger.Debug("starting linter", "workers", runtime.GOMAXPROCS(0), "programs", len(workload.Programs))
 
 	var wg sync.WaitGroup
 
 	diagnosticsChan := make(chan anyDiagnostic, 4096)
 
+	// Converted right away, so that they do not keep the programs alive
+	var fixConflicts []headlessFixConflictPayload
+
 	// Handle all diagnostics
 	wg.Go(func() {
 		w := bufio.NewWriterSize(os.Stdout, 4096*100)
@@ -362,16 +684,23 @@ func runHeadless(args []string) int {
 					}
 				}
 
+				if rd.RuleName == linter.UnusedDisableDirectiveRuleName {
+					hd.Kind = headlessDiagnosticKindUnusedDisableDirective
+					hd.Rule = nil
+				}
+
 				if opts.fix {
-					hd.Fixes = headlessFixesFromRuleFixes(rd.Fixes())
+					hd.Fixes, hd.FileFixes = headlessFixesFromRuleFixes(rd.Fixes())
 				}
 				if opts.fixSuggestions {
 					suggestions := rd.GetSuggestions()
 					hd.Suggestions = make([]headlessSuggestion, len(suggestions))
 					for i, suggestion := range suggestions {
+						fixes, fileFixes := headlessFixesFromRuleFixes(suggestion.Fixes())
 						hd.Suggestions[i] = headlessSuggestion{
-							Message: headlessRuleMessageFromRuleMessage(suggestion.Message),
-							Fixes:   headlessFixesFromRuleFixes(suggestion.Fixes()),
+							Message:   headlessRuleMessageFromRuleMessage(suggestion.Message),
+							Fixes:     fixes,
+							FileFixes: fileFixes,
 						}
 					}
 				}
@@ -402,16 +731,11 @@ func runHeadless(args []string) int {
 	})
 
 	var timingStore *linter.RuleTimingStore
-	if opts.debugTimings {
+	if opts.debug.timings {
 		timingStore = linter.NewRuleTimingStore()
 	}
 
-	if logLevel == utils.LogLevelDebug {
-		log.Printf("Running Linter")
-	}
-
-	err = linter.RunLinter(linter.RunLinterOptions{
-		LogLevel:         logLevel,
+	linterOptions := linter.RunLinterOptions{
 		CurrentDirectory: cwd,
 		Workload:         workload,
 		Workers:          runtime.GOMAXPROCS(0),
@@ -425,12 +749,7 @@ func runHeadless(args []string) int {
 				if !ok {
 					panic(fmt.Sprintf("unknown rule: %v", headlessRule.Name))
 				}
-				rules[i] = linter.ConfiguredRule{
-					Name: r.Name,
-					Run: func(ctx rule.RuleContext) rule.RuleListeners {
-						return r.Run(ctx, headlessRule.Options)
-					},
-				}
+				rules[i] = configureRule(r, headlessRule.Options)
 			}
 
 			return rules
@@ -440,34 +759,74 @@ func runHeadless(args []string) int {
 		Fixes: linter.Fixes{
 			Fix:            opts.fix,
 			FixSuggestions: opts.fixSuggestions,
+			Kinds:          opts.fixKinds,
 		},
 		TypeErrors: linter.TypeErrors{
 			ReportSyntactic: payload.ReportSyntactic,
 			ReportSemantic:  payload.ReportSemantic,
 		},
-		SuppressProgramDiagnostics: suppressProgramDiagnostics(),
-		TimingStore:                timingStore,
-	})
+		SuppressProgramDiagnostics:    suppressProgramDiagnostics(),
+		TimingStore:                   timingStore,
+		TraceEvents:                   traceEvents,
+		ReportUnusedDisableDirectives: payload.ReportUnusedDisableDirectives,
+		VerifyFixes:                   opts.verifyFixes,
+		InferredProjectOptions:        inferredProjectOptions,
+	}
+	if opts.reportFixConflicts {
+		// Called after each program, not concurrently
+		linterOptions.OnFixConflict = func(conflict linter.FixConflict[rule.RuleDiagnostic]) {
+			fixConflicts = append(fixConflicts, headlessFixConflictPayloadFromConflict(conflict))
+		}
+	}
+
+	var fixedFiles map[string]string
+	if opts.applyFixes {
+		fixedFiles, err = linter.RunLinterWithFixes(linterOptions, opts.fixIterations)
+	} else {
+		err = linter.RunLinter(linterOptions)
+	}
 
 	close(diagnosticsChan)
 	if err != nil {
-		log.Printf("ERROR: Linter failed: %v", err)
+		logger.Error("linter failed", "error", err)
 		writeErrorMessage(fmt.Sprintf("error running linter: %v", err))
 		return 1
 	}
 
 	wg.Wait()
 
-	if opts.debugTimings {
-		if err := writeMessage(os.Stdout, headlessMessageTypeTiming, headlessTimingPayloadFromRecords(timingStore.Collect())); err != nil {
-			log.Printf("ERROR: failed to write timing output: %v", err)
+	for _, conflict := range fixConflicts {
+		if err := writeMessage(os.Stdout, headlessMessageTypeFixConflict, conflict); err != nil {
+			logger.Error("failed to write fix conflict", "error", err)
+			return 1
+		}
+	}
+
+	for _, filePath := range slices.Sorted(maps.Keys(fixedFiles)) {
+		if err := writeMessage(os.Stdout, headlessMessageTypeFixedFile, headlessFixedFilePayload{
+			FilePath: filePath,
+			Text:     fixedFiles[filePath],
+		}); err != nil {
+			logger.Error("failed to write fixed file", "error", err)
+			return 1
+		}
+	}
+
+	if traceEvents != nil {
+		if err := traceEvents.WriteFile(opts.traceEventsOut); err != nil {
+			logger.Error("failed to write trace events", "error", err)
 			return 1
 		}
 	}
 
-	if logLevel == utils.LogLevelDebug {
-		log.Printf("Linting Complete")
+	if opts.debug.timings {
+		if err := writeMessage(os.Stdout, headlessMessageTypeTiming, headlessTimingPayloadFromStore(timingStore)); err != nil {
+			logger.Error("failed to write timing output", "error", err)
+			return 1
+		}
 	}
 
+	logger.Info("linting complete")
+
 	return 0
 }
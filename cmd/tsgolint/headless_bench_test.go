@@ -99,7 +99,6 @@ func runAllRulesBenchmark(b *testing.B, singleThreaded bool) {
 	// Warm up: run once to ensure everything is initialized
 	var diagnosticCount int64
 	err := linter.RunLinterOnProgram(linter.RunLinterOnProgramOptions{
-		LogLevel:             utils.LogLevelNormal,
 		Program:              env.program,
 		Files:                env.files,
 		Workers:              workers,
@@ -117,7 +116,6 @@ func runAllRulesBenchmark(b *testing.B, singleThreaded bool) {
 	b.ResetTimer()
 	for b.Loop() {
 		err := linter.RunLinterOnProgram(linter.RunLinterOnProgramOptions{
-			LogLevel:             utils.LogLevelNormal,
 			Program:              env.program,
 			Files:                env.files,
 			Workers:              workers,
@@ -215,7 +213,6 @@ func BenchmarkE2E(b *testing.B) {
 
 		var diagnosticCount int64
 		err := linter.RunLinter(linter.RunLinterOptions{
-			LogLevel:             utils.LogLevelNormal,
 			CurrentDirectory:     dir,
 			Workload:             workload,
 			Workers:              workers,
@@ -238,7 +235,6 @@ func BenchmarkE2E(b *testing.B) {
 		workload, fs := buildWorkload()
 
 		err := linter.RunLinter(linter.RunLinterOptions{
-			LogLevel:             utils.LogLevelNormal,
 			CurrentDirectory:     dir,
 			Workload:             workload,
 			Workers:              workers,
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+func TestAssignHeadlessWorkloadTsconfig(t *testing.T) {
+	rootDir := t.TempDir()
+	filePath := filepath.Join(rootDir, "src", "index.ts")
+	testFilePath := filepath.Join(rootDir, "src", "index.test.ts")
+	rootConfigPath := filepath.Join(rootDir, "tsconfig.json")
+	testConfigPath := filepath.Join(rootDir, "tsconfig.test.json")
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for path, text := range map[string]string{
+		filePath:       "export {};\n",
+		testFilePath:   "export {};\n",
+		rootConfigPath: `{}`,
+		testConfigPath: `{ "include": ["src/**/*.test.ts"] }`,
+	} {
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs := bundled.WrapFS(osvfs.FS())
+	assign := func(configs ...headlessConfig) (headlessWorkload, error) {
+		return assignHeadlessWorkload(&headlessPayload{Version: 2, Configs: configs}, rootDir, fs, utils.NewTsConfigResolver(fs, rootDir), nil)
+	}
+
+	// The tsconfig of the test file is not searched for
+	assigned, err := assign(
+		headlessConfig{FilePaths: []string{testFilePath}, Tsconfig: "tsconfig.test.json"},
+		headlessConfig{FilePaths: []string{filePath}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string][]string{testConfigPath: {testFilePath}, rootConfigPath: {filePath}}; !reflect.DeepEqual(assigned.workload.Programs, want) {
+		t.Errorf("expected programs %v, got %v", want, assigned.workload.Programs)
+	}
+	if len(assigned.workload.UnmatchedFiles) != 0 {
+		t.Errorf("expected no unmatched files, got %v", assigned.workload.UnmatchedFiles)
+	}
+	if !reflect.DeepEqual(assigned.searchedFiles, []string{filePath}) {
+		t.Errorf("expected only %v to be searched, got %v", filePath, assigned.searchedFiles)
+	}
+
+	_, err = assign(headlessConfig{FilePaths: []string{filePath}, Tsconfig: "tsconfig.test.json"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be linted with tsconfig "+testConfigPath+", which does not include the file") {
+		t.Errorf("expected the tsconfig to be rejected, got %v", err)
+	}
+
+	_, err = assign(headlessConfig{FilePaths: []string{filePath}, Tsconfig: "tsconfig.missing.json"})
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("expected a missing tsconfig error, got %v", err)
+	}
+}
@@ -0,0 +1,724 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+const lspSource = "tsgolint"
+
+type lspDocument struct {
+	uri     string
+	version int32
+	text    string
+}
+
+type lspServer struct {
+	logger *slog.Logger
+
+	reader  *lsproto.BaseReader
+	writer  *lsproto.BaseWriter
+	writeMu sync.Mutex
+
+	// Requests sent to the client, waiting for a response
+	nextRequestID   atomic.Int32
+	pendingMu       sync.Mutex
+	pendingRequests map[string]chan *lspMessage
+
+	// Held while the linter runs, so that workspace diagnostics and open
+	// documents are not linted concurrently
+	lintMu sync.Mutex
+	// Guarded by lintMu
+	workspaceReports *lspWorkspaceReports
+
+	// Guards everything below
+	mu                    sync.Mutex
+	currentDirectory      string
+	supportsConfiguration bool
+	// The client pulls diagnostics (`textDocument/diagnostic`) instead of
+	// receiving `textDocument/publishDiagnostics`
+	pullDiagnostics           bool
+	supportsDiagnosticRefresh bool
+	settings                  lspSettings
+	// Open documents by file name
+	documents map[string]*lspDocument
+	// Results of the last lint of each open document, by file name
+	lintResults map[string]*lspLintResult
+	// Signaled whenever lintResults is updated
+	lintDone *sync.Cond
+	// Files which were last published with a non-empty diagnostics list and are not open,
+	// e.g. a tsconfig.json with errors. These need to be cleared explicitly.
+	publishedFiles map[string]struct{}
+	shutdown       bool
+
+	lintRequests chan struct{}
+}
+
+func newLSPServer(r io.Reader, w io.Writer, currentDirectory string) *lspServer {
+	s := &lspServer{
+		logger:           utils.Logger(utils.LogSubsystemLinter),
+		reader:           lsproto.NewBaseReader(r),
+		writer:           lsproto.NewBaseWriter(w),
+		pendingRequests:  make(map[string]chan *lspMessage),
+		currentDirectory: currentDirectory,
+		documents:        make(map[string]*lspDocument),
+		lintResults:      make(map[string]*lspLintResult),
+		publishedFiles:   make(map[string]struct{}),
+		// Buffered so that edits made while a lint is running coalesce into a single follow-up run.
+		lintRequests: make(chan struct{}, 1),
+	}
+	s.lintDone = sync.NewCond(&s.mu)
+	return s
+}
+
+func runLSP(args []string) int {
+	flags := flag.NewFlagSet("lsp", flag.ContinueOnError)
+	// Editors commonly pass `--stdio`; it is the only supported transport.
+	flags.Bool("stdio", true, "communicate over stdin/stdout")
+	if err := flags.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "error parsing options: %v", err)
+		return 1
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error getting current directory: %v", err)
+		return 1
+	}
+
+	return newLSPServer(os.Stdin, os.Stdout, tspath.NormalizePath(cwd)).run()
+}
+
+func (s *lspServer) run() int {
+	go s.lintLoop()
+
+	for {
+		data, err := s.reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 1
+			}
+			s.logger.Error("failed to read message", "error", err)
+			return 1
+		}
+
+		var msg lspMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.logger.Error("failed to parse message", "error", err)
+			continue
+		}
+
+		if msg.isResponse() {
+			s.handleResponse(&msg)
+			continue
+		}
+
+		if lsproto.Method(msg.Method) == lsproto.MethodExit {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.shutdown {
+				return 0
+			}
+			return 1
+		}
+
+		if err := s.handleMessage(&msg); err != nil {
+			if msg.isRequest() {
+				s.replyError(msg.ID, lsproto.ErrorCodeInvalidParams, err.Error())
+			} else {
+				s.logger.Error("failed to handle message", "method", msg.Method, "error", err)
+			}
+		}
+	}
+}
+
+func (s *lspServer) handleMessage(msg *lspMessage) error {
+	s.logger.Debug("received message", "method", msg.Method)
+
+	switch lsproto.Method(msg.Method) {
+	case lsproto.MethodInitialize:
+		var params lspInitializeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		s.initialize(&params)
+
+		var diagnosticProvider *lspDiagnosticOptions
+		if params.Capabilities.TextDocument.Diagnostic != nil {
+			diagnosticProvider = &lspDiagnosticOptions{
+				Identifier:            lspSource,
+				InterFileDependencies: true,
+				WorkspaceDiagnostics:  true,
+			}
+		}
+		s.reply(msg.ID, lspInitializeResult{
+			Capabilities: lspServerCapabilities{
+				TextDocumentSync: lspTextDocumentSyncOptions{
+					OpenClose: true,
+					Change:    lsproto.TextDocumentSyncKindFull,
+				},
+				CodeActionProvider: lspCodeActionOptions{
+					CodeActionKinds: []lsproto.CodeActionKind{lsproto.CodeActionKindQuickFix, lspCodeActionKindSourceFixAll},
+				},
+				DiagnosticProvider: diagnosticProvider,
+			},
+			ServerInfo: lspServerInfo{Name: lspSource},
+		})
+	case lsproto.MethodInitialized:
+		go s.pullConfiguration()
+	case lsproto.MethodWorkspaceDidChangeConfiguration:
+		s.mu.Lock()
+		supportsConfiguration := s.supportsConfiguration
+		s.mu.Unlock()
+		if supportsConfiguration {
+			go s.pullConfiguration()
+			return nil
+		}
+
+		var params struct {
+			Settings struct {
+				Tsgolint *lspSettings `json:"tsgolint"`
+			} `json:"settings"`
+		}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		if params.Settings.Tsgolint != nil {
+			s.updateSettings(*params.Settings.Tsgolint)
+		}
+	case lsproto.MethodTextDocumentDidOpen:
+		var params lspDidOpenTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		s.setDocument(params.TextDocument.Uri, params.TextDocument.Version, params.TextDocument.Text)
+	case lsproto.MethodTextDocumentDidChange:
+		var params lspDidChangeTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil
+		}
+		s.setDocument(params.TextDocument.Uri, params.TextDocument.Version, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case lsproto.MethodTextDocumentDidClose:
+		var params lspDidCloseTextDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		s.closeDocument(params.TextDocument.Uri)
+	case lsproto.MethodTextDocumentCodeAction:
+		var params lspCodeActionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		s.reply(msg.ID, s.codeActions(&params))
+	case lsproto.MethodTextDocumentDiagnostic:
+		var params lspDocumentDiagnosticParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		// May wait for a lint of the latest version of the document
+		go func() {
+			s.reply(msg.ID, s.documentDiagnostics(&params))
+		}()
+	case lsproto.MethodWorkspaceDiagnostic:
+		var params lspWorkspaceDiagnosticParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return err
+		}
+		go func() {
+			report, err := s.workspaceDiagnostics(&params)
+			if err != nil {
+				s.replyError(msg.ID, lsproto.ErrorCodeInternalError, err.Error())
+				return
+			}
+			s.reply(msg.ID, report)
+		}()
+	case lsproto.MethodShutdown:
+		s.mu.Lock()
+		s.shutdown = true
+		s.mu.Unlock()
+		s.reply(msg.ID, nil)
+	default:
+		if msg.isRequest() {
+			s.replyError(msg.ID, lsproto.ErrorCodeMethodNotFound, "unsupported method "+msg.Method)
+		}
+	}
+
+	return nil
+}
+
+func (s *lspServer) initialize(params *lspInitializeParams) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rootUri := ""
+	if params.RootUri != nil {
+		rootUri = *params.RootUri
+	} else if len(params.WorkspaceFolders) > 0 {
+		rootUri = params.WorkspaceFolders[0].Uri
+	}
+	if rootDirectory := uriToFileName(rootUri); rootDirectory != "" {
+		s.currentDirectory = rootDirectory
+	}
+
+	s.supportsConfiguration = params.Capabilities.Workspace.Configuration
+	s.pullDiagnostics = params.Capabilities.TextDocument.Diagnostic != nil
+	s.supportsDiagnosticRefresh = params.Capabilities.Workspace.Diagnostics.RefreshSupport
+	if params.InitializationOptions != nil {
+		s.settings = *params.InitializationOptions
+	}
+}
+
+// Asks the client for the `tsgolint` configuration section and re-lints with it.
+func (s *lspServer) pullConfiguration() {
+	s.mu.Lock()
+	supportsConfiguration := s.supportsConfiguration
+	s.mu.Unlock()
+	if !supportsConfiguration {
+		s.scheduleLint()
+		return
+	}
+
+	result, err := s.request(lsproto.MethodWorkspaceConfiguration, lspConfigurationParams{
+		Items: []lspConfigurationItem{{Section: "tsgolint"}},
+	})
+	if err != nil {
+		s.logger.Error("failed to get configuration", "error", err)
+		return
+	}
+
+	var sections []*lspSettings
+	if err := json.Unmarshal(result, &sections); err != nil {
+		s.logger.Error("failed to parse configuration", "error", err)
+		return
+	}
+	if len(sections) > 0 && sections[0] != nil {
+		s.updateSettings(*sections[0])
+	} else {
+		s.scheduleLint()
+	}
+}
+
+func (s *lspServer) updateSettings(settings lspSettings) {
+	s.mu.Lock()
+	s.settings = settings
+	refresh := s.pullDiagnostics && s.supportsDiagnosticRefresh
+	s.mu.Unlock()
+	s.scheduleLint()
+
+	if refresh {
+		// Pulled reports, including workspace ones, are stale with the new rules
+		go func() {
+			if _, err := s.request(lsproto.MethodWorkspaceDiagnosticRefresh, nil); err != nil {
+				s.logger.Error("failed to refresh diagnostics", "error", err)
+			}
+		}()
+	}
+}
+
+func (s *lspServer) setDocument(uri string, version int32, text string) {
+	fileName := uriToFileName(uri)
+	if fileName == "" {
+		return
+	}
+
+	s.mu.Lock()
+	s.documents[fileName] = &lspDocument{uri: uri, version: version, text: text}
+	s.mu.Unlock()
+	s.scheduleLint()
+}
+
+func (s *lspServer) closeDocument(uri string) {
+	fileName := uriToFileName(uri)
+	if fileName == "" {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.documents, fileName)
+	delete(s.lintResults, fileName)
+	pullDiagnostics := s.pullDiagnostics
+	s.lintDone.Broadcast()
+	s.mu.Unlock()
+
+	s.scheduleLint()
+	if pullDiagnostics {
+		return
+	}
+	s.notify(lsproto.MethodTextDocumentPublishDiagnostics, lspPublishDiagnosticsParams{
+		Uri:         uri,
+		Diagnostics: []lspDiagnostic{},
+	})
+}
+
+func (s *lspServer) scheduleLint() {
+	select {
+	case s.lintRequests <- struct{}{}:
+	default:
+	}
+}
+
+func (s *lspServer) lintLoop() {
+	for range s.lintRequests {
+		s.lintOpenDocuments()
+	}
+}
+
+// Lints every open document and publishes the results. All open documents are
+// linted together since an edit in one file may change the types seen by another.
+func (s *lspServer) lintOpenDocuments() {
+	s.lintMu.Lock()
+	defer s.lintMu.Unlock()
+
+	s.mu.Lock()
+	currentDirectory := s.currentDirectory
+	settings := s.settings
+	pullDiagnostics := s.pullDiagnostics
+	documents := s.snapshotDocuments()
+	s.mu.Unlock()
+
+	if len(documents) == 0 {
+		return
+	}
+
+	fileNames := make([]string, 0, len(documents))
+	for fileName := range documents {
+		fileNames = append(fileNames, fileName)
+	}
+	fs := lspFS(documents)
+
+	results, err := lintFiles(fs, currentDirectory, lspWorkload(fs, currentDirectory, fileNames, nil), settings)
+	if err != nil {
+		s.reportLintError(err)
+		// Still record the versions, so that pending pulls don't wait forever
+		results = nil
+	}
+
+	s.mu.Lock()
+	for fileName, document := range documents {
+		// The document may have been closed while linting
+		if _, isOpen := s.documents[fileName]; !isOpen {
+			continue
+		}
+		s.lintResults[fileName] = &lspLintResult{version: document.version, diagnostics: results[fileName]}
+	}
+	s.lintDone.Broadcast()
+	s.mu.Unlock()
+
+	if err != nil || pullDiagnostics {
+		return
+	}
+
+	s.mu.Lock()
+	previouslyPublished := s.publishedFiles
+	s.publishedFiles = make(map[string]struct{})
+	for fileName, result := range results {
+		if _, isDocument := documents[fileName]; !isDocument && len(result.diagnostics) > 0 {
+			s.publishedFiles[fileName] = struct{}{}
+		}
+	}
+	s.mu.Unlock()
+
+	for fileName, document := range documents {
+		s.notify(lsproto.MethodTextDocumentPublishDiagnostics, lspPublishDiagnosticsParams{
+			Uri:         document.uri,
+			Version:     &document.version,
+			Diagnostics: results[fileName].published(),
+		})
+	}
+	for fileName, result := range results {
+		if _, isDocument := documents[fileName]; isDocument {
+			continue
+		}
+		delete(previouslyPublished, fileName)
+		s.notify(lsproto.MethodTextDocumentPublishDiagnostics, lspPublishDiagnosticsParams{
+			Uri:         fileNameToUri(fileName),
+			Diagnostics: result.published(),
+		})
+	}
+	for fileName := range previouslyPublished {
+		if _, isDocument := documents[fileName]; isDocument {
+			continue
+		}
+		s.notify(lsproto.MethodTextDocumentPublishDiagnostics, lspPublishDiagnosticsParams{
+			Uri:         fileNameToUri(fileName),
+			Diagnostics: []lspDiagnostic{},
+		})
+	}
+}
+
+// Must be called with s.mu held.
+func (s *lspServer) snapshotDocuments() map[string]lspDocument {
+	documents := make(map[string]lspDocument, len(s.documents))
+	for fileName, document := range s.documents {
+		documents[fileName] = *document
+	}
+	return documents
+}
+
+// The file system seen by the linter: the disk, with open documents replaced
+// by their unsaved contents.
+func lspFS(documents map[string]lspDocument) vfs.FS {
+	overrides := make(map[string]string, len(documents))
+	for fileName, document := range documents {
+		overrides[fileName] = document.text
+	}
+	return bundled.WrapFS(cachedvfs.From(newOverlayFS(osvfs.FS(), overrides)))
+}
+
+func (s *lspServer) reportLintError(err error) {
+	s.logger.Error("linter failed", "error", err)
+	s.notify(lsproto.MethodWindowLogMessage, lspLogMessageParams{
+		Type:    lsproto.MessageTypeError,
+		Message: "tsgolint: " + err.Error(),
+	})
+}
+
+type lspFileDiagnostics struct {
+	text *lspText
+	// Every diagnostic to publish, including internal ones
+	diagnostics []lspDiagnostic
+	// Rule diagnostics with their fixes and suggestions
+	ruleDiagnostics []lspRuleDiagnostic
+}
+
+// Diagnostics to send to the client; never nil, as LSP requires a list.
+func (d *lspFileDiagnostics) published() []lspDiagnostic {
+	if d == nil || d.diagnostics == nil {
+		return []lspDiagnostic{}
+	}
+	return d.diagnostics
+}
+
+// Groups files by tsconfig the same way headless mode does. Files not part of
+// any tsconfig go to the inferred program if keepUnmatched is nil or returns true.
+func lspWorkload(fs vfs.FS, currentDirectory string, fileNames []string, keepUnmatched func(fileName string) bool) linter.Workload {
+	workload := linter.Workload{
+		Programs:       make(map[string][]string),
+		UnmatchedFiles: []string{},
+	}
+	for file, tsconfig := range utils.NewTsConfigResolver(fs, currentDirectory).FindTsConfigParallel(fileNames) {
+		if tsconfig != "" {
+			workload.Programs[tsconfig] = append(workload.Programs[tsconfig], file)
+		} else if keepUnmatched == nil || keepUnmatched(file) {
+			workload.UnmatchedFiles = append(workload.UnmatchedFiles, file)
+		}
+	}
+	return workload
+}
+
+// Runs the linter on the workload and returns LSP diagnostics keyed by file name.
+func lintFiles(fs vfs.FS, currentDirectory string, workload linter.Workload, settings lspSettings) (result map[string]*lspFileDiagnostics, err error) {
+	defer func() {
+		// The linter panics on inconsistent workloads; the server must survive that.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	rules := lspConfiguredRules(settings)
+
+	var mu sync.Mutex
+	result = make(map[string]*lspFileDiagnostics)
+	fileDiagnosticsOf := func(fileName string, text func() (string, bool)) *lspFileDiagnostics {
+		if fileDiagnostics, ok := result[fileName]; ok {
+			return fileDiagnostics
+		}
+		content, ok := text()
+		if !ok {
+			return nil
+		}
+		fileDiagnostics := &lspFileDiagnostics{text: newLspText(content)}
+		result[fileName] = fileDiagnostics
+		return fileDiagnostics
+	}
+
+	err = linter.RunLinter(linter.RunLinterOptions{
+		CurrentDirectory: currentDirectory,
+		Workload:         workload,
+		Workers:          runtime.GOMAXPROCS(0),
+		FS:               fs,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+			return rules
+		},
+		OnRuleDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			fileDiagnostics := fileDiagnosticsOf(d.SourceFile.FileName(), func() (string, bool) { return d.SourceFile.Text(), true })
+			published := lspDiagnosticFromRuleDiagnostic(d, fileDiagnostics.text)
+			fileDiagnostics.diagnostics = append(fileDiagnostics.diagnostics, published)
+			// Don't keep the whole program alive for code actions
+			d.SourceFile = nil
+			fileDiagnostics.ruleDiagnostics = append(fileDiagnostics.ruleDiagnostics, lspRuleDiagnostic{lsp: published, rule: d})
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			if d.FilePath == nil {
+				utils.Logger(utils.LogSubsystemLinter).Warn(d.Description, "id", d.Id)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			fileName := *d.FilePath
+			fileDiagnostics := fileDiagnosticsOf(fileName, func() (string, bool) { return fs.ReadFile(fileName) })
+			if fileDiagnostics == nil {
+				return
+			}
+			fileDiagnostics.diagnostics = append(fileDiagnostics.diagnostics, lspDiagnosticFromInternalDiagnostic(d, fileDiagnostics.text))
+		},
+		Fixes: linter.Fixes{
+			Fix:            true,
+			FixSuggestions: true,
+		},
+		SuppressProgramDiagnostics:    suppressProgramDiagnostics(),
+		ReportUnusedDisableDirectives: settings.ReportUnusedDisableDirectives,
+	})
+
+	return result, err
+}
+
+func lspConfiguredRules(settings lspSettings) []linter.ConfiguredRule {
+	if len(settings.Rules) == 0 {
+		return utils.Map(allRules, func(r rule.Rule) linter.ConfiguredRule {
+			return configureRule(r, nil)
+		})
+	}
+
+	rules := make([]linter.ConfiguredRule, 0, len(settings.Rules))
+	for _, configured := range settings.Rules {
+		r, ok := allRulesByName[configured.Name]
+		if !ok {
+			utils.Logger(utils.LogSubsystemLinter).Warn("unknown rule in configuration", "rule", configured.Name)
+			continue
+		}
+		rules = append(rules, configureRule(r, configured.Options))
+	}
+	return rules
+}
+
+func lspDiagnosticFromRuleDiagnostic(d rule.RuleDiagnostic, text *lspText) lspDiagnostic {
+	message := d.Message.Description
+	if d.Message.Help != "" {
+		message += "\n" + d.Message.Help
+	}
+	return lspDiagnostic{
+		Range:    text.rangeOf(d.Range.Pos(), d.Range.End()),
+		Severity: lsproto.DiagnosticSeverityWarning,
+		Code:     d.RuleName,
+		Source:   lspSource,
+		Message:  message,
+	}
+}
+
+func lspDiagnosticFromInternalDiagnostic(d diagnostic.Internal, text *lspText) lspDiagnostic {
+	message := d.Description
+	if d.Help != "" {
+		message += "\n" + d.Help
+	}
+	return lspDiagnostic{
+		Range:    text.rangeOf(max(d.Range.Pos(), 0), max(d.Range.End(), 0)),
+		Severity: lsproto.DiagnosticSeverityError,
+		Code:     d.Id,
+		Source:   lspSource,
+		Message:  message,
+	}
+}
+
+func (s *lspServer) send(msg lspMessage) {
+	msg.JSONRPC = "2.0"
+	data, err := json.Marshal(msg)
+	if err != nil {
+		s.logger.Error("failed to serialize message", "error", err)
+		return
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err := s.writer.Write(data); err != nil {
+		s.logger.Error("failed to write message", "error", err)
+	}
+}
+
+func marshalLSPValue(value any) jsontext.Value {
+	data, err := json.Marshal(value)
+	if err != nil {
+		utils.Logger(utils.LogSubsystemLinter).Error("failed to serialize value", "error", err)
+		return jsontext.Value("null")
+	}
+	return jsontext.Value(data)
+}
+
+func (s *lspServer) reply(id jsontext.Value, result any) {
+	s.send(lspMessage{ID: id, Result: marshalLSPValue(result)})
+}
+
+func (s *lspServer) replyError(id jsontext.Value, code lsproto.ErrorCode, message string) {
+	s.send(lspMessage{ID: id, Error: &lspError{Code: int32(code), Message: message}})
+}
+
+func (s *lspServer) notify(method lsproto.Method, params any) {
+	s.send(lspMessage{Method: string(method), Params: marshalLSPValue(params)})
+}
+
+// Sends a request to the client and blocks until it responds. Must not be
+// called from the goroutine reading messages.
+func (s *lspServer) request(method lsproto.Method, params any) (jsontext.Value, error) {
+	id := jsontext.Value(strconv.Itoa(int(s.nextRequestID.Add(1))))
+	response := make(chan *lspMessage, 1)
+
+	s.pendingMu.Lock()
+	s.pendingRequests[string(id)] = response
+	s.pendingMu.Unlock()
+
+	msg := lspMessage{ID: id, Method: string(method)}
+	if params != nil {
+		msg.Params = marshalLSPValue(params)
+	}
+	s.send(msg)
+
+	result := <-response
+	if result.Error != nil {
+		return nil, fmt.Errorf("%s failed: %s", method, result.Error.Message)
+	}
+	return result.Result, nil
+}
+
+func (s *lspServer) handleResponse(msg *lspMessage) {
+	s.pendingMu.Lock()
+	response, ok := s.pendingRequests[string(msg.ID)]
+	delete(s.pendingRequests, string(msg.ID))
+	s.pendingMu.Unlock()
+
+	if !ok {
+		s.logger.Warn("received response for unknown request", "id", msg.ID)
+		return
+	}
+	response <- msg
+}
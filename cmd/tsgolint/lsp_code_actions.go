@@ -0,0 +1,226 @@
+package main
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// Applies every non-conflicting fix in a document, e.g. on save.
+const lspCodeActionKindSourceFixAll = lsproto.CodeActionKindSourceFixAll + ".tsgolint"
+
+// Prefix used by the directives inserted by "Disable ... for this line". It is
+// understood by both typescript-eslint and oxlint.
+const lspDisableDirectiveRulePrefix = "@typescript-eslint/"
+
+// A published rule diagnostic, kept around to answer code action requests.
+type lspRuleDiagnostic struct {
+	lsp  lspDiagnostic
+	rule rule.RuleDiagnostic
+}
+
+// Diagnostics of a document as of the version they were computed for.
+type lspLintResult struct {
+	version int32
+	// nil if there were no diagnostics
+	diagnostics *lspFileDiagnostics
+}
+
+func (s *lspServer) codeActions(params *lspCodeActionParams) []lspCodeAction {
+	fileName := uriToFileName(params.TextDocument.Uri)
+
+	s.mu.Lock()
+	document := s.documents[fileName]
+	result := s.lintResults[fileName]
+	s.mu.Unlock()
+
+	// Offering edits computed for another version would corrupt the document;
+	// the client asks again once fresh diagnostics are published.
+	if document == nil || result == nil || result.version != document.version || result.diagnostics == nil {
+		return []lspCodeAction{}
+	}
+
+	return lspCodeActionsForRange(params.TextDocument.Uri, result.diagnostics.text, result.diagnostics.ruleDiagnostics, params.Range, params.Context.Only)
+}
+
+func lspCodeActionsForRange(uri string, text *lspText, diagnostics []lspRuleDiagnostic, r lsproto.Range, only []lsproto.CodeActionKind) []lspCodeAction {
+	actions := []lspCodeAction{}
+
+	if lspCodeActionKindRequested(lsproto.CodeActionKindQuickFix, only) {
+		start, end := text.offset(r.Start), text.offset(r.End)
+		type ruleLine struct {
+			ruleName string
+			line     uint32
+		}
+		disabledLines := make(map[ruleLine]struct{})
+
+		for _, d := range diagnostics {
+			if d.rule.Range.End() < start || d.rule.Range.Pos() > end {
+				continue
+			}
+
+			if fixes := d.rule.Fixes(); len(fixes) > 0 && lspFixesAreLocal(fixes) {
+				actions = append(actions, lspCodeAction{
+					Title:       "Fix this " + d.rule.RuleName + " problem",
+					Kind:        lsproto.CodeActionKindQuickFix,
+					Diagnostics: []lspDiagnostic{d.lsp},
+					IsPreferred: true,
+					Edit:        lspFixesEdit(uri, text, fixes),
+				})
+			}
+
+			for _, suggestion := range d.rule.GetSuggestions() {
+				if !lspFixesAreLocal(suggestion.Fixes()) {
+					continue
+				}
+				actions = append(actions, lspCodeAction{
+					Title:       suggestion.Message.Description,
+					Kind:        lsproto.CodeActionKindQuickFix,
+					Diagnostics: []lspDiagnostic{d.lsp},
+					Edit:        lspFixesEdit(uri, text, suggestion.Fixes()),
+				})
+			}
+
+			if d.rule.RuleName == linter.UnusedDisableDirectiveRuleName {
+				continue
+			}
+			line := text.position(d.rule.Range.Pos()).Line
+			key := ruleLine{d.rule.RuleName, line}
+			if _, ok := disabledLines[key]; ok {
+				continue
+			}
+			disabledLines[key] = struct{}{}
+			edit, ok := lspDisableForLineEdit(text, int(line), d.rule.RuleName)
+			if !ok {
+				continue
+			}
+			actions = append(actions, lspCodeAction{
+				Title:       "Disable " + d.rule.RuleName + " for this line",
+				Kind:        lsproto.CodeActionKindQuickFix,
+				Diagnostics: []lspDiagnostic{d.lsp},
+				Edit: lspWorkspaceEdit{
+					Changes: map[string][]lspTextEdit{uri: {edit}},
+				},
+			})
+		}
+	}
+
+	if lspCodeActionKindRequested(lspCodeActionKindSourceFixAll, only) {
+		if action, ok := lspFixAllAction(uri, text, diagnostics); ok {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// Reports whether actions of the given kind should be returned. As in the LSP
+// spec, `only` entries match their sub-kinds, e.g. `source.fixAll` matches
+// `source.fixAll.tsgolint`.
+func lspCodeActionKindRequested(kind lsproto.CodeActionKind, only []lsproto.CodeActionKind) bool {
+	if len(only) == 0 {
+		return true
+	}
+	for _, requested := range only {
+		if kind == requested || strings.HasPrefix(string(kind), string(requested)+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Edits of other files are not offered: positions of their fixes cannot be
+// converted without their text.
+func lspFixesAreLocal(fixes []rule.RuleFix) bool {
+	return !slices.ContainsFunc(fixes, func(fix rule.RuleFix) bool { return fix.FileName != "" })
+}
+
+func lspFixesEdit(uri string, text *lspText, fixes []rule.RuleFix) lspWorkspaceEdit {
+	edits := make([]lspTextEdit, len(fixes))
+	for i, fix := range fixes {
+		edits[i] = lspTextEdit{
+			Range:   text.rangeOf(fix.Range.Pos(), fix.Range.End()),
+			NewText: fix.Text,
+		}
+	}
+	return lspWorkspaceEdit{Changes: map[string][]lspTextEdit{uri: edits}}
+}
+
+// Applies the fixes of all diagnostics the same way `--fix` does: fixes
+// overlapping an already applied fix are skipped.
+func lspFixAllAction(uri string, text *lspText, diagnostics []lspRuleDiagnostic) (lspCodeAction, bool) {
+	fixable := make([]rule.RuleDiagnostic, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		if len(d.rule.Fixes()) > 0 {
+			fixable = append(fixable, d.rule)
+		}
+	}
+	if len(fixable) == 0 {
+		return lspCodeAction{}, false
+	}
+
+	code, _, fixed := linter.ApplyRuleFixes(text.text, fixable)
+	if !fixed {
+		return lspCodeAction{}, false
+	}
+
+	return lspCodeAction{
+		Title: "Fix all auto-fixable tsgolint problems",
+		Kind:  lspCodeActionKindSourceFixAll,
+		Edit: lspWorkspaceEdit{
+			Changes: map[string][]lspTextEdit{uri: {{
+				Range:   text.rangeOf(0, len(text.text)),
+				NewText: code,
+			}}},
+		},
+	}, true
+}
+
+// Inserts an `eslint-disable-next-line` comment above the given line, or
+// extends the one already there, before its ` -- ` description if any. Returns
+// false if the comment there already disables every rule: a comment inserted
+// below it would take the line it applies to.
+func lspDisableForLineEdit(text *lspText, line int, ruleName string) (lspTextEdit, bool) {
+	ruleName = lspDisableDirectiveRulePrefix + ruleName
+	lineStart := text.lineStarts[line]
+
+	if line > 0 {
+		previousStart := text.lineStarts[line-1]
+		previousEnd := lineStart
+		for previousEnd > previousStart && (text.text[previousEnd-1] == '\n' || text.text[previousEnd-1] == '\r') {
+			previousEnd--
+		}
+		previous := strings.TrimRight(text.text[previousStart:previousEnd], " \t")
+		previousEnd = previousStart + len(previous)
+		if directive, ok := strings.CutPrefix(strings.TrimLeft(previous, " \t"), "// eslint-disable-next-line"); ok && (directive == "" || directive[0] == ' ' || directive[0] == '\t') {
+			rules, _, _ := strings.Cut(directive, " -- ")
+			if strings.TrimSpace(rules) == "" {
+				return lspTextEdit{}, false
+			}
+			// Before the description, or at the end of the line
+			position := text.position(previousEnd - len(directive) + len(strings.TrimRight(rules, " \t")))
+			return lspTextEdit{
+				Range:   lsproto.Range{Start: position, End: position},
+				NewText: ", " + ruleName,
+			}, true
+		}
+	}
+
+	indentationEnd := lineStart
+	for indentationEnd < len(text.text) && (text.text[indentationEnd] == ' ' || text.text[indentationEnd] == '\t') {
+		indentationEnd++
+	}
+	newLine := "\n"
+	if strings.Contains(text.text, "\r\n") {
+		newLine = "\r\n"
+	}
+
+	position := text.position(lineStart)
+	return lspTextEdit{
+		Range:   lsproto.Range{Start: position, End: position},
+		NewText: text.text[lineStart:indentationEnd] + "// eslint-disable-next-line " + ruleName + newLine,
+	}, true
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+func TestLspCodeActions(t *testing.T) {
+	code := "function f() {\n  const a = b as string;\n  return a as string;\n}\n"
+	text := newLspText(code)
+
+	firstAs := len("function f() {\n  const a = b")
+	secondAs := len("function f() {\n  const a = b as string;\n  return a")
+	diagnostics := []lspRuleDiagnostic{}
+	for _, pos := range []int{firstAs, secondAs} {
+		fixes := []rule.RuleFix{{Range: core.NewTextRange(pos, pos+len(" as string"))}}
+		diagnostics = append(diagnostics, lspRuleDiagnostic{rule: rule.RuleDiagnostic{
+			Range:    core.NewTextRange(pos-1, pos+len(" as string")),
+			RuleName: "no-unnecessary-type-assertion",
+			FixesPtr: &fixes,
+		}})
+	}
+
+	uri := "file:///a.ts"
+	line1 := lsproto.Range{Start: lsproto.Position{Line: 1}, End: lsproto.Position{Line: 1, Character: 30}}
+	actions := lspCodeActionsForRange(uri, text, diagnostics, line1, []lsproto.CodeActionKind{lsproto.CodeActionKindQuickFix})
+	if len(actions) != 2 {
+		t.Fatalf("expected a fix and a disable action, got %+v", actions)
+	}
+	if actions[0].Title != "Fix this no-unnecessary-type-assertion problem" || !actions[0].IsPreferred {
+		t.Errorf("unexpected fix action %+v", actions[0])
+	}
+	disable := actions[1].Edit.Changes[uri]
+	if len(disable) != 1 || disable[0].NewText != "  // eslint-disable-next-line @typescript-eslint/no-unnecessary-type-assertion\n" || disable[0].Range.Start != (lsproto.Position{Line: 1}) {
+		t.Errorf("unexpected disable edit %+v", disable)
+	}
+
+	actions = lspCodeActionsForRange(uri, text, diagnostics, line1, []lsproto.CodeActionKind{lsproto.CodeActionKindSourceFixAll})
+	if len(actions) != 1 || actions[0].Kind != lspCodeActionKindSourceFixAll {
+		t.Fatalf("expected only a fix all action, got %+v", actions)
+	}
+	if got := actions[0].Edit.Changes[uri][0].NewText; got != "function f() {\n  const a = b;\n  return a;\n}\n" {
+		t.Errorf("unexpected fix all result %q", got)
+	}
+}
+
+func TestLspDisableForLineExtendsExistingDirective(t *testing.T) {
+	text := newLspText("// eslint-disable-next-line @typescript-eslint/no-explicit-any\nlet a: any = b;\n")
+	edit, ok := lspDisableForLineEdit(text, 1, "no-unsafe-assignment")
+	if !ok || edit.NewText != ", @typescript-eslint/no-unsafe-assignment" || edit.Range.Start != (lsproto.Position{Line: 0, Character: 62}) {
+		t.Errorf("unexpected edit %+v", edit)
+	}
+}
+
+func TestLspDisableForLineExtendsDirectiveWithDescription(t *testing.T) {
+	text := newLspText("  // eslint-disable-next-line @typescript-eslint/no-explicit-any -- legacy\n  let a: any = b;\n")
+	edit, ok := lspDisableForLineEdit(text, 1, "no-unsafe-assignment")
+	if !ok || edit.NewText != ", @typescript-eslint/no-unsafe-assignment" || edit.Range.Start != (lsproto.Position{Line: 0, Character: 64}) {
+		t.Errorf("unexpected edit %+v", edit)
+	}
+}
+
+func TestLspDisableForLineKeepsDirectiveForAllRules(t *testing.T) {
+	for _, directive := range []string{"// eslint-disable-next-line", "// eslint-disable-next-line -- legacy"} {
+		text := newLspText(directive + "\nlet a: any = b;\n")
+		if edit, ok := lspDisableForLineEdit(text, 1, "no-unsafe-assignment"); ok {
+			t.Errorf("unexpected edit %+v below %q", edit, directive)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"hash/fnv"
+	iofs "io/fs"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs"
+)
+
+// Pull diagnostics (LSP 3.17). Result IDs are derived from the reported
+// diagnostics, so a client sending back the ID of an identical report gets an
+// "unchanged" report without the server remembering what it sent.
+
+func (s *lspServer) documentDiagnostics(params *lspDocumentDiagnosticParams) lspDocumentDiagnosticReport {
+	fileName := uriToFileName(params.TextDocument.Uri)
+
+	var diagnostics *lspFileDiagnostics
+	s.mu.Lock()
+	for {
+		document, isOpen := s.documents[fileName]
+		if !isOpen {
+			break
+		}
+		// Every change schedules a lint which sees it, so this eventually holds.
+		if result := s.lintResults[fileName]; result != nil && result.version == document.version {
+			diagnostics = result.diagnostics
+			break
+		}
+		s.lintDone.Wait()
+	}
+	s.mu.Unlock()
+
+	return lspDiagnosticReport(diagnostics.published(), params.PreviousResultId)
+}
+
+// Reports of the last workspace lint, reused while none of its inputs changed
+type lspWorkspaceReports struct {
+	key uint64
+	// Full reports, sorted by URI
+	items []lspWorkspaceDocumentDiagnosticReport
+}
+
+// Lints every TypeScript file of the workspace which belongs to a tsconfig,
+// plus the open documents. The workspace is only linted again once a file, an
+// open document or the settings changed; files whose diagnostics the client
+// already has get "unchanged" reports.
+func (s *lspServer) workspaceDiagnostics(params *lspWorkspaceDiagnosticParams) (lspWorkspaceDiagnosticReport, error) {
+	s.lintMu.Lock()
+	defer s.lintMu.Unlock()
+
+	s.mu.Lock()
+	currentDirectory := s.currentDirectory
+	settings := s.settings
+	documents := s.snapshotDocuments()
+	s.mu.Unlock()
+
+	fs := lspFS(documents)
+	fileNames, fingerprint := lspWorkspaceFiles(fs, currentDirectory)
+	key := lspWorkspaceKey(fingerprint, currentDirectory, settings, documents)
+	if s.workspaceReports == nil || s.workspaceReports.key != key {
+		items, err := lintWorkspace(fs, currentDirectory, settings, documents, fileNames)
+		if err != nil {
+			s.reportLintError(err)
+			return lspWorkspaceDiagnosticReport{}, err
+		}
+		s.workspaceReports = &lspWorkspaceReports{key: key, items: items}
+	}
+
+	return lspWorkspaceReport(s.workspaceReports.items, params.PreviousResultIds), nil
+}
+
+// Lints the files of the workspace and returns their full reports, sorted by
+// URI.
+func lintWorkspace(fs vfs.FS, currentDirectory string, settings lspSettings, documents map[string]lspDocument, fileNames []string) ([]lspWorkspaceDocumentDiagnosticReport, error) {
+	for fileName := range documents {
+		if !slices.Contains(fileNames, fileName) {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+
+	workload := lspWorkload(fs, currentDirectory, fileNames, func(fileName string) bool {
+		_, isDocument := documents[fileName]
+		return isDocument
+	})
+	results, err := lintFiles(fs, currentDirectory, workload, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []lspWorkspaceDocumentDiagnosticReport
+	reported := make(map[string]struct{})
+	addReport := func(fileName string) {
+		uri := fileNameToUri(fileName)
+		var version *int32
+		if document, isDocument := documents[fileName]; isDocument {
+			uri = document.uri
+			version = &document.version
+		}
+		if _, ok := reported[uri]; ok {
+			return
+		}
+		reported[uri] = struct{}{}
+
+		diagnostics := results[fileName].published()
+		items = append(items, lspWorkspaceDocumentDiagnosticReport{
+			Kind:     lsproto.DocumentDiagnosticReportKindFull,
+			ResultId: lspResultId(diagnostics),
+			Items:    diagnostics,
+			Uri:      uri,
+			Version:  version,
+		})
+	}
+
+	for _, files := range workload.Programs {
+		for _, fileName := range files {
+			addReport(fileName)
+		}
+	}
+	for _, fileName := range workload.UnmatchedFiles {
+		addReport(fileName)
+	}
+	// Files which were not linted themselves, e.g. a tsconfig.json with errors
+	for fileName := range results {
+		addReport(fileName)
+	}
+
+	slices.SortFunc(items, func(a, b lspWorkspaceDocumentDiagnosticReport) int {
+		return strings.Compare(a.Uri, b.Uri)
+	})
+	return items, nil
+}
+
+// Turns the full reports of the workspace into the report for a client which
+// has the given previous results.
+func lspWorkspaceReport(items []lspWorkspaceDocumentDiagnosticReport, previousResultIds []lspPreviousResultId) lspWorkspaceDiagnosticReport {
+	previous := make(map[string]string, len(previousResultIds))
+	for _, previousResultId := range previousResultIds {
+		previous[previousResultId.Uri] = previousResultId.Value
+	}
+
+	report := lspWorkspaceDiagnosticReport{Items: make([]lspWorkspaceDocumentDiagnosticReport, 0, len(items))}
+	for _, item := range items {
+		if previous[item.Uri] == item.ResultId {
+			item.Kind = lsproto.DocumentDiagnosticReportKindUnchanged
+			item.Items = nil
+		}
+		delete(previous, item.Uri)
+		report.Items = append(report.Items, item)
+	}
+	// Files reported previously which are gone, or no longer have a tsconfig
+	for uri := range previous {
+		report.Items = append(report.Items, lspWorkspaceDocumentDiagnosticReport{
+			Kind:  lsproto.DocumentDiagnosticReportKindFull,
+			Items: []lspDiagnostic{},
+			Uri:   uri,
+		})
+	}
+
+	slices.SortFunc(report.Items, func(a, b lspWorkspaceDocumentDiagnosticReport) int {
+		return strings.Compare(a.Uri, b.Uri)
+	})
+	return report
+}
+
+// Identifies the inputs of a workspace lint. Open documents are identified by
+// their version, other files by the fingerprint of `lspWorkspaceFiles`.
+func lspWorkspaceKey(fingerprint uint64, currentDirectory string, settings lspSettings, documents map[string]lspDocument) uint64 {
+	hash := fnv.New64a()
+	hash.Write(strconv.AppendUint(nil, fingerprint, 36))
+	hash.Write([]byte{0})
+	hash.Write([]byte(currentDirectory))
+	hash.Write([]byte{0})
+	hash.Write(marshalLSPValue(settings))
+	for _, fileName := range slices.Sorted(maps.Keys(documents)) {
+		hash.Write([]byte{0})
+		hash.Write([]byte(fileName))
+		hash.Write([]byte{0})
+		hash.Write(strconv.AppendInt(nil, int64(documents[fileName].version), 10))
+	}
+	return hash.Sum64()
+}
+
+func lspDiagnosticReport(diagnostics []lspDiagnostic, previousResultId string) lspDocumentDiagnosticReport {
+	resultId := lspResultId(diagnostics)
+	if resultId == previousResultId {
+		return lspDocumentDiagnosticReport{
+			Kind:     lsproto.DocumentDiagnosticReportKindUnchanged,
+			ResultId: resultId,
+		}
+	}
+	return lspDocumentDiagnosticReport{
+		Kind:     lsproto.DocumentDiagnosticReportKindFull,
+		ResultId: resultId,
+		Items:    diagnostics,
+	}
+}
+
+func lspResultId(diagnostics []lspDiagnostic) string {
+	hash := fnv.New64a()
+	hash.Write(marshalLSPValue(diagnostics))
+	return strconv.FormatUint(hash.Sum64(), 36)
+}
+
+// Lists the TypeScript source files under root, skipping `node_modules` and
+// hidden directories. The fingerprint changes when any of the walked files,
+// e.g. a source file or a tsconfig.json, is added, removed or modified on disk.
+func lspWorkspaceFiles(fs vfs.FS, root string) (fileNames []string, fingerprint uint64) {
+	hash := fnv.New64a()
+	_ = fs.WalkDir(root, func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == "node_modules" || strings.HasPrefix(d.Name(), ".")) {
+				return iofs.SkipDir
+			}
+			return nil
+		}
+		hash.Write([]byte(path))
+		if info, err := d.Info(); err == nil {
+			hash.Write(strconv.AppendInt(nil, info.Size(), 10))
+			hash.Write(strconv.AppendInt(nil, info.ModTime().UnixNano(), 10))
+		}
+		hash.Write([]byte{0})
+		if tspath.FileExtensionIsOneOf(path, tspath.SupportedTSImplementationExtensions) && !tspath.IsDeclarationFileName(path) {
+			fileNames = append(fileNames, path)
+		}
+		return nil
+	})
+	return fileNames, hash.Sum64()
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+)
+
+func TestLspDiagnosticReportResultIds(t *testing.T) {
+	diagnostics := []lspDiagnostic{{Source: lspSource, Code: "no-floating-promises", Message: "a"}}
+
+	full := lspDiagnosticReport(diagnostics, "")
+	if full.Kind != lsproto.DocumentDiagnosticReportKindFull || full.ResultId == "" || len(full.Items) != 1 {
+		t.Fatalf("expected a full report, got %+v", full)
+	}
+
+	unchanged := lspDiagnosticReport([]lspDiagnostic{{Source: lspSource, Code: "no-floating-promises", Message: "a"}}, full.ResultId)
+	if unchanged.Kind != lsproto.DocumentDiagnosticReportKindUnchanged || unchanged.ResultId != full.ResultId || unchanged.Items != nil {
+		t.Errorf("expected an unchanged report, got %+v", unchanged)
+	}
+
+	changed := lspDiagnosticReport([]lspDiagnostic{}, full.ResultId)
+	if changed.Kind != lsproto.DocumentDiagnosticReportKindFull || changed.ResultId == full.ResultId || changed.Items == nil {
+		t.Errorf("expected a full report for changed diagnostics, got %+v", changed)
+	}
+}
+
+func TestLspWorkspaceReport(t *testing.T) {
+	diagnostics := []lspDiagnostic{{Source: lspSource, Code: "no-floating-promises", Message: "a"}}
+	items := []lspWorkspaceDocumentDiagnosticReport{
+		{Kind: lsproto.DocumentDiagnosticReportKindFull, ResultId: lspResultId(diagnostics), Items: diagnostics, Uri: "file:///a.ts"},
+		{Kind: lsproto.DocumentDiagnosticReportKindFull, ResultId: lspResultId(nil), Items: []lspDiagnostic{}, Uri: "file:///b.ts"},
+	}
+
+	report := lspWorkspaceReport(items, []lspPreviousResultId{
+		{Uri: "file:///a.ts", Value: items[0].ResultId},
+		{Uri: "file:///b.ts", Value: "stale"},
+		{Uri: "file:///gone.ts", Value: "gone"},
+	})
+	if len(report.Items) != 3 {
+		t.Fatalf("expected 3 reports, got %+v", report.Items)
+	}
+	if a := report.Items[0]; a.Kind != lsproto.DocumentDiagnosticReportKindUnchanged || a.ResultId != items[0].ResultId || a.Items != nil {
+		t.Errorf("expected an unchanged report for a.ts, got %+v", a)
+	}
+	if b := report.Items[1]; b.Kind != lsproto.DocumentDiagnosticReportKindFull || b.Items == nil {
+		t.Errorf("expected a full report for b.ts, got %+v", b)
+	}
+	if gone := report.Items[2]; gone.Uri != "file:///gone.ts" || gone.Kind != lsproto.DocumentDiagnosticReportKindFull || len(gone.Items) != 0 {
+		t.Errorf("expected an empty report for gone.ts, got %+v", gone)
+	}
+	if items[0].Kind != lsproto.DocumentDiagnosticReportKindFull || items[0].Items == nil {
+		t.Errorf("the cached reports should not be modified, got %+v", items[0])
+	}
+}
+
+func TestLspWorkspaceFilesFingerprint(t *testing.T) {
+	rootDir := t.TempDir()
+	write := func(name string, text string) {
+		path := filepath.Join(rootDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index.ts", "export {};\n")
+	write("tsconfig.json", "{}")
+	write("node_modules/dep/index.ts", "export {};\n")
+
+	fs := osvfs.FS()
+	root := tspath.NormalizePath(rootDir)
+	fileNames, fingerprint := lspWorkspaceFiles(fs, root)
+	if !slices.Equal(fileNames, []string{root + "/index.ts"}) {
+		t.Errorf("unexpected files %v", fileNames)
+	}
+	if _, again := lspWorkspaceFiles(fs, root); again != fingerprint {
+		t.Errorf("the fingerprint of an unchanged workspace should be stable")
+	}
+
+	write("node_modules/dep/index.ts", "export const changed = true;\n")
+	if _, changed := lspWorkspaceFiles(fs, root); changed != fingerprint {
+		t.Errorf("node_modules should not be part of the fingerprint")
+	}
+
+	write("tsconfig.json", `{ "compilerOptions": { "strict": true } }`)
+	if _, changed := lspWorkspaceFiles(fs, root); changed == fingerprint {
+		t.Errorf("editing the tsconfig.json should change the fingerprint")
+	}
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-json-experiment/json/jsontext"
+
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+	"github.com/microsoft/typescript-go/shim/tspath"
+)
+
+// Wire format of the LSP messages handled by `tsgolint lsp`. The generated
+// `lsproto` unions are tailored to typescript-go's own server, so only the
+// envelope fields and the parameters we actually read are declared here; the
+// shared building blocks (positions, ranges, enums, method names) come from
+// `lsproto`.
+
+type lspMessage struct {
+	JSONRPC string         `json:"jsonrpc"`
+	ID      jsontext.Value `json:"id,omitzero"`
+	Method  string         `json:"method,omitzero"`
+	Params  jsontext.Value `json:"params,omitzero"`
+	Result  jsontext.Value `json:"result,omitzero"`
+	Error   *lspError      `json:"error,omitzero"`
+}
+
+func (m *lspMessage) isRequest() bool {
+	return m.Method != "" && len(m.ID) > 0
+}
+
+func (m *lspMessage) isResponse() bool {
+	return m.Method == "" && len(m.ID) > 0
+}
+
+type lspError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+type lspInitializeParams struct {
+	RootUri          *string              `json:"rootUri"`
+	WorkspaceFolders []lspWorkspaceFolder `json:"workspaceFolders"`
+	Capabilities     struct {
+		Workspace struct {
+			Configuration bool `json:"configuration"`
+			Diagnostics   struct {
+				RefreshSupport bool `json:"refreshSupport"`
+			} `json:"diagnostics"`
+		} `json:"workspace"`
+		TextDocument struct {
+			// Present if the client pulls diagnostics
+			Diagnostic *struct{} `json:"diagnostic"`
+		} `json:"textDocument"`
+	} `json:"capabilities"`
+	InitializationOptions *lspSettings `json:"initializationOptions"`
+}
+
+type lspWorkspaceFolder struct {
+	Uri  string `json:"uri"`
+	Name string `json:"name"`
+}
+
+type lspServerCapabilities struct {
+	TextDocumentSync   lspTextDocumentSyncOptions `json:"textDocumentSync"`
+	CodeActionProvider lspCodeActionOptions       `json:"codeActionProvider"`
+	DiagnosticProvider *lspDiagnosticOptions      `json:"diagnosticProvider,omitzero"`
+}
+
+type lspDiagnosticOptions struct {
+	Identifier            string `json:"identifier"`
+	InterFileDependencies bool   `json:"interFileDependencies"`
+	WorkspaceDiagnostics  bool   `json:"workspaceDiagnostics"`
+}
+
+type lspCodeActionOptions struct {
+	CodeActionKinds []lsproto.CodeActionKind `json:"codeActionKinds"`
+}
+
+type lspTextDocumentSyncOptions struct {
+	OpenClose bool                         `json:"openClose"`
+	Change    lsproto.TextDocumentSyncKind `json:"change"`
+}
+
+type lspInitializeResult struct {
+	Capabilities lspServerCapabilities `json:"capabilities"`
+	ServerInfo   lspServerInfo         `json:"serverInfo"`
+}
+
+type lspServerInfo struct {
+	Name string `json:"name"`
+}
+
+type lspTextDocumentIdentifier struct {
+	Uri string `json:"uri"`
+}
+
+type lspDidOpenTextDocumentParams struct {
+	TextDocument struct {
+		Uri     string `json:"uri"`
+		Version int32  `json:"version"`
+		Text    string `json:"text"`
+	} `json:"textDocument"`
+}
+
+type lspDidChangeTextDocumentParams struct {
+	TextDocument struct {
+		Uri     string `json:"uri"`
+		Version int32  `json:"version"`
+	} `json:"textDocument"`
+	// Full document sync: the last change holds the whole text.
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type lspDidCloseTextDocumentParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+}
+
+type lspConfigurationParams struct {
+	Items []lspConfigurationItem `json:"items"`
+}
+
+type lspConfigurationItem struct {
+	ScopeUri string `json:"scopeUri,omitzero"`
+	Section  string `json:"section"`
+}
+
+type lspDiagnostic struct {
+	Range    lsproto.Range              `json:"range"`
+	Severity lsproto.DiagnosticSeverity `json:"severity"`
+	Code     string                     `json:"code,omitzero"`
+	Source   string                     `json:"source"`
+	Message  string                     `json:"message"`
+}
+
+type lspPublishDiagnosticsParams struct {
+	Uri         string          `json:"uri"`
+	Version     *int32          `json:"version,omitzero"`
+	Diagnostics []lspDiagnostic `json:"diagnostics"`
+}
+
+type lspDocumentDiagnosticParams struct {
+	TextDocument     lspTextDocumentIdentifier `json:"textDocument"`
+	PreviousResultId string                    `json:"previousResultId"`
+}
+
+type lspDocumentDiagnosticReport struct {
+	Kind     lsproto.DocumentDiagnosticReportKind `json:"kind"`
+	ResultId string                               `json:"resultId,omitzero"`
+	// Only for full reports
+	Items []lspDiagnostic `json:"items,omitzero"`
+}
+
+type lspWorkspaceDiagnosticParams struct {
+	PreviousResultIds []lspPreviousResultId `json:"previousResultIds"`
+}
+
+type lspPreviousResultId struct {
+	Uri   string `json:"uri"`
+	Value string `json:"value"`
+}
+
+type lspWorkspaceDiagnosticReport struct {
+	Items []lspWorkspaceDocumentDiagnosticReport `json:"items"`
+}
+
+type lspWorkspaceDocumentDiagnosticReport struct {
+	Kind     lsproto.DocumentDiagnosticReportKind `json:"kind"`
+	ResultId string                               `json:"resultId,omitzero"`
+	Items    []lspDiagnostic                      `json:"items,omitzero"`
+	Uri      string                               `json:"uri"`
+	// null for files which are not open
+	Version *int32 `json:"version"`
+}
+
+type lspCodeActionParams struct {
+	TextDocument lspTextDocumentIdentifier `json:"textDocument"`
+	Range        lsproto.Range             `json:"range"`
+	Context      struct {
+		Only []lsproto.CodeActionKind `json:"only"`
+	} `json:"context"`
+}
+
+type lspCodeAction struct {
+	Title       string                 `json:"title"`
+	Kind        lsproto.CodeActionKind `json:"kind"`
+	Diagnostics []lspDiagnostic        `json:"diagnostics,omitzero"`
+	IsPreferred bool                   `json:"isPreferred,omitzero"`
+	Edit        lspWorkspaceEdit       `json:"edit"`
+}
+
+type lspWorkspaceEdit struct {
+	Changes map[string][]lspTextEdit `json:"changes"`
+}
+
+type lspTextEdit struct {
+	Range   lsproto.Range `json:"range"`
+	NewText string        `json:"newText"`
+}
+
+type lspLogMessageParams struct {
+	Type    lsproto.MessageType `json:"type"`
+	Message string              `json:"message"`
+}
+
+// Settings read from `initializationOptions` and the `tsgolint` section of
+// `workspace/configuration`.
+type lspSettings struct {
+	// Rules to run, with their options. All rules run with default options if empty.
+	Rules []headlessRule `json:"rules"`
+	// Report `eslint-disable` comments for tsgolint rules that did not suppress anything
+	ReportUnusedDisableDirectives bool `json:"reportUnusedDisableDirectives"`
+}
+
+func uriToFileName(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "file" {
+		return ""
+	}
+	fileName := parsed.Path
+	// file:///c:/foo -> c:/foo
+	if len(fileName) >= 3 && fileName[0] == '/' && fileName[2] == ':' {
+		fileName = fileName[1:]
+	}
+	return tspath.NormalizePath(fileName)
+}
+
+func fileNameToUri(fileName string) string {
+	if !strings.HasPrefix(fileName, "/") {
+		fileName = "/" + fileName
+	}
+	return (&url.URL{Scheme: "file", Path: fileName}).String()
+}
+
+// lspText converts between byte offsets in a document and LSP positions, which
+// count UTF-16 code units within a line.
+type lspText struct {
+	text       string
+	lineStarts []int
+}
+
+func newLspText(text string) *lspText {
+	lineStarts := []int{0}
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\r':
+			if i+1 < len(text) && text[i+1] == '\n' {
+				i++
+			}
+			lineStarts = append(lineStarts, i+1)
+		case '\n':
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &lspText{text: text, lineStarts: lineStarts}
+}
+
+func (t *lspText) position(offset int) lsproto.Position {
+	offset = min(max(offset, 0), len(t.text))
+	line, found := slices.BinarySearch(t.lineStarts, offset)
+	if !found {
+		line--
+	}
+	character := 0
+	for _, r := range t.text[t.lineStarts[line]:offset] {
+		character += utf16Len(r)
+	}
+	return lsproto.Position{Line: uint32(line), Character: uint32(character)}
+}
+
+func (t *lspText) offset(position lsproto.Position) int {
+	line := int(position.Line)
+	if line >= len(t.lineStarts) {
+		return len(t.text)
+	}
+	offset := t.lineStarts[line]
+	character := int(position.Character)
+	for character > 0 && offset < len(t.text) && t.text[offset] != '\n' && t.text[offset] != '\r' {
+		r, size := utf8.DecodeRuneInString(t.text[offset:])
+		character -= utf16Len(r)
+		offset += size
+	}
+	return offset
+}
+
+func (t *lspText) rangeOf(pos int, end int) lsproto.Range {
+	return lsproto.Range{Start: t.position(pos), End: t.position(end)}
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/lsp/lsproto"
+)
+
+func TestLspTextPositions(t *testing.T) {
+	text := newLspText("const a = 1;\r\nconst 😀 = '😀';\nx")
+
+	cases := []struct {
+		offset   int
+		position lsproto.Position
+	}{
+		{0, lsproto.Position{Line: 0, Character: 0}},
+		{6, lsproto.Position{Line: 0, Character: 6}},
+		{14, lsproto.Position{Line: 1, Character: 0}},
+		// The emoji is 4 bytes in UTF-8 but 2 code units in UTF-16.
+		{24, lsproto.Position{Line: 1, Character: 8}},
+		{len("const a = 1;\r\nconst 😀 = '😀';\n"), lsproto.Position{Line: 2, Character: 0}},
+	}
+
+	for _, c := range cases {
+		if got := text.position(c.offset); got != c.position {
+			t.Errorf("position(%d) = %+v, expected %+v", c.offset, got, c.position)
+		}
+		if got := text.offset(c.position); got != c.offset {
+			t.Errorf("offset(%+v) = %d, expected %d", c.position, got, c.offset)
+		}
+	}
+}
+
+func TestLspUriConversion(t *testing.T) {
+	if got := uriToFileName("file:///home/user/my%20project/index.ts"); got != "/home/user/my project/index.ts" {
+		t.Errorf("unexpected file name %q", got)
+	}
+	if got := uriToFileName("file:///c%3A/project/index.ts"); got != "c:/project/index.ts" {
+		t.Errorf("unexpected file name %q", got)
+	}
+	if got := uriToFileName("untitled:Untitled-1"); got != "" {
+		t.Errorf("expected non-file URIs to be ignored, got %q", got)
+	}
+	if got := fileNameToUri("/home/user/my project/index.ts"); got != "file:///home/user/my%20project/index.ts" {
+		t.Errorf("unexpected URI %q", got)
+	}
+}
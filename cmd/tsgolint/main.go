@@ -4,7 +4,7 @@ import (
 	"bufio"
 	"flag"
 	"fmt"
-	"math"
+	"maps"
 	"os"
 	"runtime"
 	"runtime/pprof"
@@ -14,7 +14,6 @@ import (
 	"strings"
 	"sync"
 	"time"
-	"unicode"
 
 	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
 	"github.com/typescript-eslint/tsgolint/internal/linter"
@@ -229,136 +228,20 @@ func init() {
 	}
 }
 
-const spaces = "                                                                                                    "
-
-func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions tspath.ComparePathsOptions) {
-	diagnosticStart := d.Range.Pos()
-	diagnosticEnd := d.Range.End()
-
-	diagnosticStartLine, diagnosticStartColumn := scanner.GetECMALineAndUTF16CharacterOfPosition(d.SourceFile, diagnosticStart)
-	diagnosticEndline, _ := scanner.GetECMALineAndUTF16CharacterOfPosition(d.SourceFile, diagnosticEnd)
-
-	lineMap := d.SourceFile.ECMALineMap()
-	text := d.SourceFile.Text()
-
-	codeboxStartLine := max(diagnosticStartLine-1, 0)
-	codeboxEndLine := min(diagnosticEndline+1, len(lineMap)-1)
-
-	codeboxStart := scanner.GetECMAPositionOfLineAndUTF16Character(d.SourceFile, codeboxStartLine, 0)
-	codeboxEnd := scanner.GetECMAEndLinePosition(d.SourceFile, codeboxEndLine) + 1
-
-	w.Write([]byte{' ', 0x1b, '[', '7', 'm', 0x1b, '[', '1', 'm', 0x1b, '[', '3', '8', ';', '5', ';', '3', '7', 'm', ' '})
-	w.WriteString(d.RuleName)
-	w.WriteString(" \x1b[0m — ")
-	messageLineStart := 0
-	for i, char := range d.Message.Description {
-		if char == '\n' {
-			w.WriteString(d.Message.Description[messageLineStart : i+1])
-			messageLineStart = i + 1
-			w.WriteString("    \x1b[2m│\x1b[0m")
-			w.WriteString(spaces[:len(d.RuleName)+1])
-		}
-	}
-	if messageLineStart <= len(d.Message.Description) {
-		w.WriteString(d.Message.Description[messageLineStart:len(d.Message.Description)])
-	}
-	w.WriteString("\n  \x1b[2m╭─┴──────────(\x1b[0m \x1b[3m\x1b[38;5;117m")
-	w.WriteString(tspath.ConvertToRelativePath(d.SourceFile.FileName(), comparePathOptions))
-	w.WriteByte(':')
-	w.WriteString(strconv.Itoa(diagnosticStartLine + 1))
-	w.WriteByte(':')
-	w.WriteString(strconv.Itoa(int(diagnosticStartColumn) + 1))
-	w.WriteString("\x1b[0m \x1b[2m)─────\x1b[0m\n")
-
-	indentSize := math.MaxInt
-	line := codeboxStartLine
-	lineIndentCalculated := false
-	lastNonSpaceIndex := -1
-
-	lineStarts := make([]int, 13)
-	lineEnds := make([]int, 13)
-
-	if codeboxEndLine-codeboxStartLine >= len(lineEnds) {
-		w.WriteString("  \x1b[2m│\x1b[0m  Error range is too big. Skipping code block printing.\n  \x1b[2m╰────────────────────────────────\x1b[0m\n\n")
-		return
-	}
-
-	for i, char := range text[codeboxStart:codeboxEnd] {
-		if char == '\n' {
-			if line != codeboxEndLine {
-				lineIndentCalculated = false
-				lineEnds[line-codeboxStartLine] = lastNonSpaceIndex - int(lineMap[line]) + codeboxStart
-				lastNonSpaceIndex = -1
-				line++
-			}
-			continue
-		}
-
-		if !lineIndentCalculated && !unicode.IsSpace(char) {
-			lineIndentCalculated = true
-			lineStarts[line-codeboxStartLine] = i - int(lineMap[line]) + codeboxStart
-			indentSize = min(indentSize, lineStarts[line-codeboxStartLine])
-		}
-
-		if lineIndentCalculated && !unicode.IsSpace(char) {
-			lastNonSpaceIndex = i + 1
-		}
-	}
-	if line == codeboxEndLine {
-		lineEnds[line-codeboxStartLine] = lastNonSpaceIndex - int(lineMap[line]) + codeboxStart
+// Binds the rule to its options.
+func configureRule(r rule.Rule, options any) linter.ConfiguredRule {
+	configured := linter.ConfiguredRule{
+		Name: r.Name,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return r.Run(ctx, options)
+		},
 	}
-
-	diagnosticHighlightActive := false
-	lastLineNumber := strconv.Itoa(codeboxEndLine + 1)
-	for line := codeboxStartLine; line <= codeboxEndLine; line++ {
-		w.WriteString("  \x1b[2m│ ")
-		if line == codeboxEndLine {
-			w.WriteString(lastLineNumber)
-		} else {
-			number := strconv.Itoa(line + 1)
-			if len(number) < len(lastLineNumber) {
-				w.WriteByte(' ')
-			}
-			w.WriteString(number)
-		}
-		w.WriteString(" │\x1b[0m  ")
-
-		lineTextStart := int(lineMap[line]) + indentSize
-		underlineStart := max(lineTextStart, int(lineMap[line])+lineStarts[line-codeboxStartLine])
-		underlineEnd := underlineStart
-		lineTextEnd := max(int(lineMap[line])+lineEnds[line-codeboxStartLine], lineTextStart)
-
-		if diagnosticHighlightActive {
-			underlineEnd = lineTextEnd
-		} else if int(lineMap[line]) <= diagnosticStart && (line == len(lineMap)-1 || diagnosticStart < int(lineMap[line+1])) {
-			underlineStart = min(max(lineTextStart, diagnosticStart), lineTextEnd)
-			underlineEnd = lineTextEnd
-			diagnosticHighlightActive = true
+	if r.RunOnProgram != nil {
+		configured.RunOnProgram = func() rule.ProgramListeners {
+			return r.RunOnProgram(options)
 		}
-		if int(lineMap[line]) <= diagnosticEnd && (line == len(lineMap)-1 || diagnosticEnd < int(lineMap[line+1])) {
-			underlineEnd = min(max(underlineStart, diagnosticEnd), lineTextEnd)
-			diagnosticHighlightActive = false
-		}
-
-		if underlineStart != underlineEnd {
-			w.WriteString(text[lineTextStart:underlineStart])
-			w.Write([]byte{
-				0x1b, '[', '4', 'm',
-				0x1b, '[', '4', ':', '3', 'm',
-				0x1b, '[', '5', '8', ':', '5', ':', '1', '6', '0', 'm',
-				0x1b, '[', '3', '8', ';', '5', ';', '1', '6', '0', 'm',
-				0x1b, '[', '2', '2', ';', '4', '9', 'm',
-			})
-			w.WriteString(text[underlineStart:underlineEnd])
-			w.Write([]byte{0x1b, '[', '0', 'm'})
-			w.WriteString(text[underlineEnd:lineTextEnd])
-		} else if lineTextStart != lineTextEnd {
-			w.WriteString(text[lineTextStart:lineTextEnd])
-		}
-
-		w.WriteByte('\n')
 	}
-	w.WriteString("  \x1b[2m╰────────────────────────────────\x1b[0m\n\n")
+	return configured
 }
 
 const unsupportedCliWarning = "Warning: the `tsgolint` CLI entrypoint is unsupported!\nUse Oxlint type-aware linting instead: https://oxc.rs/docs/guide/usage/linter/type-aware\n\n"
@@ -367,36 +250,61 @@ const usage = unsupportedCliWarning + `✨ tsgolint - speedy TypeScript linter
 
 Usage:
     tsgolint [OPTIONS]
+    tsgolint lsp      Start a language server communicating over stdio
+    tsgolint explain-config FILE
+                      Explain which tsconfig FILE is linted with, and why
 
 Options:
     --tsconfig PATH   Which tsconfig to use. Defaults to tsconfig.json.
 		--list-files      List matched files
+    --report-unused-disable-directives
+                      Report eslint-disable comments that did not suppress anything
+    --fix             Apply fixes and report the problems which remain, as well
+                      as fixes which were skipped because they overlap others
+    --verify-fixes    With --fix, revert fixes which introduce type errors
     --debug OPTIONS   Enable debug output options. Possible values: timings.
+    --trace-events PATH
+                      Write the lint phases to PATH in the Chrome Trace Event
+                      format, to be opened in a browser profiler
     -h, --help        Show help
+
+Environment:
+    NO_COLOR          Disable colored output
+    FORCE_COLOR       Enable colored output even when not writing to a terminal
+    COLUMNS           Width to wrap output at. Defaults to the terminal width.
 `
 
-func parseDebugTimings(options string) (bool, error) {
-	if options == "" {
-		return false, nil
-	}
+type debugOptions struct {
+	timings bool
+	// Report how the tsconfig of each file was found. Headless only.
+	explainConfig bool
+}
 
-	timings := false
-	for _, option := range strings.Split(options, ",") {
-		if option == "" {
-			continue
-		}
+func parseDebugOptions(options string) (debugOptions, error) {
+	var debug debugOptions
+	for option := range strings.SplitSeq(options, ",") {
 		switch option {
+		case "":
 		case "timings":
-			timings = true
+			debug.timings = true
+		case "explain-config":
+			debug.explainConfig = true
 		default:
-			return false, fmt.Errorf("unknown debug option %q", option)
+			return debug, fmt.Errorf("unknown debug option %q", option)
 		}
 	}
+	return debug, nil
+}
+
+// Number of files listed in the timing reports.
+const slowestFilesCount = 10
 
-	return timings, nil
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
 }
 
-func formatRuleTimingTable(records []linter.RuleTimingRecord) string {
+func formatRuleTimingTable(timingStore *linter.RuleTimingStore) string {
+	records := timingStore.Collect()
 	if len(records) == 0 {
 		return ""
 	}
@@ -412,25 +320,74 @@ func formatRuleTimingTable(records []linter.RuleTimingRecord) string {
 
 	var output strings.Builder
 	fmt.Fprintf(&output, "\nRule timings:\n")
-	fmt.Fprintf(&output, "%-*s  %10s  %8s  %*s\n", ruleWidth, "Rule", "Time (ms)", "Relative", callsWidth, "Calls")
-	fmt.Fprintf(&output, "%-*s  %-10s  %-8s  %-*s\n", ruleWidth, strings.Repeat("-", ruleWidth), strings.Repeat("-", 10), strings.Repeat("-", 8), callsWidth, strings.Repeat("-", callsWidth))
+	fmt.Fprintf(&output, "%-*s  %10s  %12s  %8s  %*s\n", ruleWidth, "Rule", "Time (ms)", "Checker (ms)", "Relative", callsWidth, "Calls")
+	fmt.Fprintf(&output, "%-*s  %-10s  %-12s  %-8s  %-*s\n", ruleWidth, strings.Repeat("-", ruleWidth), strings.Repeat("-", 10), strings.Repeat("-", 12), strings.Repeat("-", 8), callsWidth, strings.Repeat("-", callsWidth))
 
 	for _, record := range records {
-		millis := float64(record.Duration) / float64(time.Millisecond)
 		relative := 0.0
 		if total > 0 {
 			relative = float64(record.Duration) / float64(total) * 100
 		}
-		fmt.Fprintf(&output, "%-*s  %10.3f  %7.1f%%  %*d\n", ruleWidth, record.RuleName, millis, relative, callsWidth, record.Calls)
+		fmt.Fprintf(&output, "%-*s  %10.3f  %12.3f  %7.1f%%  %*d\n", ruleWidth, record.RuleName, durationMillis(record.Duration), durationMillis(record.CheckerDuration), relative, callsWidth, record.Calls)
+	}
+
+	if programs := timingStore.CollectPrograms(); len(programs) > 0 {
+		programWidth := len("Program")
+		for _, program := range programs {
+			programWidth = max(programWidth, len(programTimingName(program)))
+		}
+		fmt.Fprintf(&output, "\nProgram timings:\n")
+		fmt.Fprintf(&output, "%-*s  %6s  %11s  %9s  %10s\n", programWidth, "Program", "Files", "Create (ms)", "Bind (ms)", "Check (ms)")
+		fmt.Fprintf(&output, "%-*s  %-6s  %-11s  %-9s  %-10s\n", programWidth, strings.Repeat("-", programWidth), strings.Repeat("-", 6), strings.Repeat("-", 11), strings.Repeat("-", 9), strings.Repeat("-", 10))
+		for _, program := range programs {
+			fmt.Fprintf(&output, "%-*s  %6d  %11.3f  %9.3f  %10.3f\n", programWidth, programTimingName(program), program.Files, durationMillis(program.Create), durationMillis(program.Bind), durationMillis(program.Check))
+		}
 	}
 
+	files := timingStore.CollectFiles()
+	var checkerTotal time.Duration
+	for _, file := range files {
+		checkerTotal += file.CheckerDuration
+	}
+	if len(files) > 0 {
+		slowest := files[:min(len(files), slowestFilesCount)]
+
+		fileWidth := len("File")
+		for _, file := range slowest {
+			fileWidth = max(fileWidth, len(file.FileName))
+		}
+		fmt.Fprintf(&output, "\nSlowest files:\n")
+		fmt.Fprintf(&output, "%-*s  %10s  %12s\n", fileWidth, "File", "Time (ms)", "Checker (ms)")
+		fmt.Fprintf(&output, "%-*s  %-10s  %-12s\n", fileWidth, strings.Repeat("-", fileWidth), strings.Repeat("-", 10), strings.Repeat("-", 12))
+		for _, file := range slowest {
+			fmt.Fprintf(&output, "%-*s  %10.3f  %12.3f\n", fileWidth, file.FileName, durationMillis(file.Duration), durationMillis(file.CheckerDuration))
+		}
+	}
+
+	fmt.Fprintf(&output, "\nType checking: %.3f ms across %d files (before rules)\n", durationMillis(checkerTotal), len(files))
+	typeCacheStats := timingStore.TypeCacheStats()
+	fmt.Fprintf(&output, "Type cache: %d hits, %d misses (%.1f%% hit rate)\n", typeCacheStats.Hits, typeCacheStats.Misses, typeCacheStats.HitRate())
+
 	return output.String()
 }
 
+func programTimingName(program linter.ProgramTimingRecord) string {
+	if program.ConfigFileName == "" {
+		return "(inferred project)"
+	}
+	return program.ConfigFileName
+}
+
 func runMain() int {
 	if len(os.Args) > 1 && os.Args[1] == "headless" {
 		return runHeadless(os.Args[2:])
 	}
+	if len(os.Args) > 1 && os.Args[1] == "lsp" {
+		return runLSP(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == "explain-config" {
+		return runExplainConfig(os.Args[2:])
+	}
 
 	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
 
@@ -440,7 +397,12 @@ func runMain() int {
 		listFiles bool
 		debug     string
 
+		reportUnusedDisableDirectives bool
+		fix                           bool
+		verifyFixes                   bool
+
 		traceOut       string
+		traceEventsOut string
 		cpuprofOut     string
 		singleThreaded bool
 	)
@@ -448,10 +410,14 @@ func runMain() int {
 	flag.StringVar(&tsconfig, "tsconfig", "", "which tsconfig to use")
 	flag.BoolVar(&listFiles, "list-files", false, "list matched files")
 	flag.StringVar(&debug, "debug", "", "enable debug output options")
+	flag.BoolVar(&reportUnusedDisableDirectives, "report-unused-disable-directives", false, "report unused eslint-disable comments")
+	flag.BoolVar(&fix, "fix", false, "apply fixes")
+	flag.BoolVar(&verifyFixes, "verify-fixes", false, "revert fixes introducing type errors; requires --fix")
 	flag.BoolVar(&help, "help", false, "show help")
 	flag.BoolVar(&help, "h", false, "show help")
 
 	flag.StringVar(&traceOut, "trace", "", "file to put trace to")
+	flag.StringVar(&traceEventsOut, "trace-events", "", "file to put Chrome trace events to")
 	flag.StringVar(&cpuprofOut, "cpuprof", "", "file to put cpu profiling to")
 	flag.BoolVar(&singleThreaded, "singleThreaded", false, "run in single threaded mode")
 
@@ -462,15 +428,23 @@ func runMain() int {
 		return 0
 	}
 
-	debugTimings, err := parseDebugTimings(debug)
+	debugOpts, err := parseDebugOptions(debug)
+	if err == nil && debugOpts.explainConfig {
+		err = fmt.Errorf("explain-config is only supported in headless mode, use `tsgolint explain-config FILE` instead")
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error parsing debug options: %v\n", err)
 		return 1
 	}
+	if verifyFixes && !fix {
+		fmt.Fprintf(os.Stderr, "error: --verify-fixes requires --fix\n")
+		return 1
+	}
 
 	fmt.Fprintf(os.Stderr, unsupportedCliWarning)
 
 	enableVirtualTerminalProcessing()
+	style := detectOutputStyle(os.Stdout)
 	timeBefore := time.Now()
 
 	if done, err := recordTrace(traceOut); err != nil {
@@ -479,6 +453,10 @@ func runMain() int {
 	} else {
 		defer done()
 	}
+	var traceEvents *linter.TraceEvents
+	if traceEventsOut != "" {
+		traceEvents = linter.NewTraceEvents()
+	}
 	if done, err := recordCpuprof(cpuprofOut); err != nil {
 		os.Stderr.WriteString(err.Error())
 		return 1
@@ -519,7 +497,8 @@ func runMain() int {
 		UseCaseSensitiveFileNames: host.FS().UseCaseSensitiveFileNames(),
 	}
 
-	program, _, err := utils.CreateProgram(singleThreaded, fs, currentDirectory, configFileName, host, false)
+	var programTimings utils.ProgramTimings
+	program, _, err := utils.CreateProgramWithTimings(singleThreaded, fs, currentDirectory, configFileName, host, false, &programTimings)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error creating TS program: %v", err)
 		return 1
@@ -567,7 +546,7 @@ func runMain() int {
 			if errorsCount == 1 {
 				w.WriteByte('\n')
 			}
-			printDiagnostic(d, w, comparePathOptions)
+			printDiagnostic(d, w, comparePathOptions, style)
 			if w.Available() < 4096 {
 				w.Flush()
 			}
@@ -575,36 +554,67 @@ func runMain() int {
 	})
 
 	var timingStore *linter.RuleTimingStore
-	if debugTimings {
+	if debugOpts.timings {
 		timingStore = linter.NewRuleTimingStore()
 	}
-	err = linter.RunLinterOnProgram(linter.RunLinterOnProgramOptions{
-		LogLevel: utils.GetLogLevel(),
-		Program:  program,
-		Files:    files,
-		Workers:  runtime.GOMAXPROCS(0),
-		GetRulesForFile: func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
-			return utils.Map(allRules, func(r rule.Rule) linter.ConfiguredRule {
-				return linter.ConfiguredRule{
-					Name: r.Name,
-					Run: func(ctx rule.RuleContext) rule.RuleListeners {
-						return r.Run(ctx, nil)
-					},
-				}
-			})
-		},
-		OnDiagnostic:         func(d rule.RuleDiagnostic) { diagnosticsChan <- d },
-		OnInternalDiagnostic: func(d diagnostic.Internal) {},
-		Fixes: linter.Fixes{
-			Fix:            true,
-			FixSuggestions: true,
-		},
-		TypeErrors: linter.TypeErrors{
-			ReportSyntactic: false,
-			ReportSemantic:  false,
-		},
-		TimingStore: timingStore,
-	})
+	getRulesForFile := func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+		return utils.Map(allRules, func(r rule.Rule) linter.ConfiguredRule {
+			return configureRule(r, nil)
+		})
+	}
+
+	var fixedTexts map[string]string
+	var conflicts []linter.FixConflict[rule.RuleDiagnostic]
+	if fix {
+		// Lints the fixed files again, so that the remaining diagnostics are
+		// relative to the text written to disk
+		fixedTexts, err = linter.RunLinterWithFixes(linter.RunLinterOptions{
+			CurrentDirectory: currentDirectory,
+			Workload: linter.Workload{
+				Programs: map[string][]string{
+					configFileName: utils.Map(files, func(file *ast.SourceFile) string { return file.FileName() }),
+				},
+				UnmatchedFiles: []string{},
+			},
+			Workers:              runtime.GOMAXPROCS(0),
+			FS:                   fs,
+			GetRulesForFile:      getRulesForFile,
+			OnRuleDiagnostic:     func(d rule.RuleDiagnostic) { diagnosticsChan <- d },
+			OnInternalDiagnostic: func(d diagnostic.Internal) {},
+			Fixes: linter.Fixes{
+				Fix:            true,
+				FixSuggestions: true,
+			},
+			TimingStore:                   timingStore,
+			TraceEvents:                   traceEvents,
+			ReportUnusedDisableDirectives: reportUnusedDisableDirectives,
+			VerifyFixes:                   verifyFixes,
+			OnFixConflict: func(conflict linter.FixConflict[rule.RuleDiagnostic]) {
+				conflicts = append(conflicts, conflict)
+			},
+		}, linter.DefaultMaxFixIterations)
+	} else {
+		err = linter.RunLinterOnProgram(linter.RunLinterOnProgramOptions{
+			Program:              program,
+			Files:                files,
+			Workers:              runtime.GOMAXPROCS(0),
+			GetRulesForFile:      getRulesForFile,
+			OnDiagnostic:         func(d rule.RuleDiagnostic) { diagnosticsChan <- d },
+			OnInternalDiagnostic: func(d diagnostic.Internal) {},
+			Fixes: linter.Fixes{
+				Fix:            true,
+				FixSuggestions: true,
+			},
+			TypeErrors: linter.TypeErrors{
+				ReportSyntactic: false,
+				ReportSemantic:  false,
+			},
+			TimingStore:                   timingStore,
+			TraceEvents:                   traceEvents,
+			ProgramTimings:                programTimings,
+			ReportUnusedDisableDirectives: reportUnusedDisableDirectives,
+		})
+	}
 
 	close(diagnosticsChan)
 	if err != nil {
@@ -614,9 +624,32 @@ func runMain() int {
 
 	wg.Wait()
 
-	errorsColor := "\x1b[1m"
+	if traceEvents != nil {
+		if err := traceEvents.WriteFile(traceEventsOut); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing trace events: %v\n", err)
+			return 1
+		}
+	}
+
+	for _, fileName := range slices.Sorted(maps.Keys(fixedTexts)) {
+		if err := writeFixedFile(fileName, fixedTexts[fileName]); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing fixes: %v\n", err)
+			return 1
+		}
+	}
+	if len(conflicts) > 0 {
+		w := bufio.NewWriter(os.Stdout)
+		for _, conflict := range conflicts {
+			printFixConflict(conflict, w, comparePathOptions, style)
+		}
+		w.WriteByte('\n')
+		w.Flush()
+	}
+	fixedFilesCount := len(fixedTexts)
+
+	errorsColor := style.sgr("1")
 	if errorsCount == 0 {
-		errorsColor = "\x1b[1;32m"
+		errorsColor = style.sgr("1;32")
 	}
 	errorsText := "errors"
 	if errorsCount == 1 {
@@ -634,26 +667,49 @@ func runMain() int {
 	if !singleThreaded {
 		threadsCount = runtime.GOMAXPROCS(0)
 	}
+	reset, dim := style.sgr("0"), style.sgr("2")
+	bold := func(value any) string {
+		return style.sgr("1") + fmt.Sprint(value) + style.sgr("22") + dim
+	}
 	fmt.Fprintf(
 		os.Stdout,
-		"Found %v%v\x1b[0m %v \x1b[2m(linted \x1b[1m%v\x1b[22m\x1b[2m %v with \x1b[1m%v\x1b[22m\x1b[2m %v in \x1b[1m%v\x1b[22m\x1b[2m using \x1b[1m%v\x1b[22m\x1b[2m threads)\n",
+		"Found %v%v%v %v %v(linted %v %v with %v %v in %v using %v threads)%v\n",
 		errorsColor,
 		errorsCount,
+		reset,
 		errorsText,
-		len(files),
+		dim,
+		bold(len(files)),
 		filesText,
-		len(allRules),
+		bold(len(allRules)),
 		rulesText,
-		time.Since(timeBefore).Round(time.Millisecond),
-		threadsCount,
+		bold(time.Since(timeBefore).Round(time.Millisecond)),
+		bold(threadsCount),
+		reset,
 	)
+	if fix {
+		fixedFilesText := "files"
+		if fixedFilesCount == 1 {
+			fixedFilesText = "file"
+		}
+		fmt.Fprintf(os.Stdout, "Fixed %v%v%v %v\n", style.sgr("1"), fixedFilesCount, reset, fixedFilesText)
+	}
 	if timingStore != nil {
-		os.Stdout.WriteString(formatRuleTimingTable(timingStore.Collect()))
+		os.Stdout.WriteString(formatRuleTimingTable(timingStore))
 	}
 
 	return 0
 }
 
+// Writes the fixed text of a file, keeping its permissions.
+func writeFixedFile(fileName string, text string) error {
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, []byte(text), info.Mode().Perm())
+}
+
 func main() {
 	os.Exit(runMain())
 }
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"bufio"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/linter"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+const spaces = "                                                                                                    "
+
+// Code boxes spanning more lines are skipped.
+const maxCodeboxLines = 13
+
+// Wrapping narrower than this makes output harder to read than not wrapping.
+const minWrapWidth = 20
+
+// Columns taken by a tab when wrapping code lines.
+const tabWidth = 4
+
+// How output is rendered on a stream.
+type outputStyle struct {
+	// Use ANSI escape sequences
+	color bool
+	// Columns to wrap output at, 0 to not wrap
+	width int
+}
+
+// Colors are used when writing to a terminal. `NO_COLOR` disables them and
+// `FORCE_COLOR` (unless "0" or "false") enables them regardless. Output is
+// wrapped at `COLUMNS` if set, otherwise at the terminal width.
+func detectOutputStyle(f *os.File) outputStyle {
+	isTerminal := false
+	if info, err := f.Stat(); err == nil {
+		isTerminal = info.Mode()&os.ModeCharDevice != 0
+	}
+
+	style := outputStyle{color: isTerminal && os.Getenv("TERM") != "dumb"}
+	if os.Getenv("NO_COLOR") != "" {
+		style.color = false
+	}
+	if forceColor, ok := os.LookupEnv("FORCE_COLOR"); ok {
+		style.color = forceColor != "0" && forceColor != "false"
+	}
+
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		style.width = columns
+	} else if isTerminal {
+		style.width = terminalWidth(f)
+	}
+
+	return style
+}
+
+// Returns the escape sequence setting the given SGR attributes, or nothing
+// without colors.
+func (s outputStyle) sgr(attributes string) string {
+	if !s.color {
+		return ""
+	}
+	return "\x1b[" + attributes + "m"
+}
+
+func printDiagnostic(d rule.RuleDiagnostic, w *bufio.Writer, comparePathOptions tspath.ComparePathsOptions, style outputStyle) {
+	diagnosticStart := d.Range.Pos()
+	diagnosticEnd := d.Range.End()
+
+	diagnosticStartLine, diagnosticStartColumn := scanner.GetECMALineAndUTF16CharacterOfPosition(d.SourceFile, diagnosticStart)
+	diagnosticEndline, _ := scanner.GetECMALineAndUTF16CharacterOfPosition(d.SourceFile, diagnosticEnd)
+
+	lineMap := d.SourceFile.ECMALineMap()
+	text := d.SourceFile.Text()
+
+	codeboxStartLine := max(diagnosticStartLine-1, 0)
+	codeboxEndLine := min(diagnosticEndline+1, len(lineMap)-1)
+	// Include labeled ranges as long as the code box stays small enough
+	for _, labeled := range d.LabeledRanges {
+		startLine := min(codeboxStartLine, scanner.GetECMALineOfPosition(d.SourceFile, labeled.Range.Pos()))
+		endLine := max(codeboxEndLine, scanner.GetECMALineOfPosition(d.SourceFile, labeled.Range.End()))
+		if endLine-startLine < maxCodeboxLines {
+			codeboxStartLine, codeboxEndLine = startLine, endLine
+		}
+	}
+
+	codeboxStart := scanner.GetECMAPositionOfLineAndUTF16Character(d.SourceFile, codeboxStartLine, 0)
+	codeboxEnd := scanner.GetECMAEndLinePosition(d.SourceFile, codeboxEndLine) + 1
+
+	if style.color {
+		w.WriteString(" \x1b[7m\x1b[1m\x1b[38;5;37m ")
+	} else {
+		w.WriteString("  ")
+	}
+	w.WriteString(d.RuleName)
+	w.WriteString(" ")
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" — ")
+	messageWidth := 0
+	if style.width > 0 {
+		messageWidth = max(style.width-len(d.RuleName)-6, minWrapWidth)
+	}
+	for i, line := range wrapText(d.Message.Description, messageWidth) {
+		if i > 0 {
+			w.WriteString("\n    ")
+			w.WriteString(style.sgr("2"))
+			w.WriteString("│")
+			w.WriteString(style.sgr("0"))
+			w.WriteString(spaces[:len(d.RuleName)+1])
+		}
+		w.WriteString(line)
+	}
+	w.WriteString("\n  ")
+	w.WriteString(style.sgr("2"))
+	w.WriteString("╭─┴──────────(")
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" ")
+	w.WriteString(style.sgr("3"))
+	w.WriteString(style.sgr("38;5;117"))
+	w.WriteString(tspath.ConvertToRelativePath(d.SourceFile.FileName(), comparePathOptions))
+	w.WriteByte(':')
+	w.WriteString(strconv.Itoa(diagnosticStartLine + 1))
+	w.WriteByte(':')
+	w.WriteString(strconv.Itoa(int(diagnosticStartColumn) + 1))
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" ")
+	w.WriteString(style.sgr("2"))
+	w.WriteString(")─────")
+	w.WriteString(style.sgr("0"))
+	w.WriteString("\n")
+
+	indentSize := math.MaxInt
+	line := codeboxStartLine
+	lineIndentCalculated := false
+	lastNonSpaceIndex := -1
+
+	lineStarts := make([]int, maxCodeboxLines)
+	lineEnds := make([]int, maxCodeboxLines)
+
+	if codeboxEndLine-codeboxStartLine >= len(lineEnds) {
+		w.WriteString("  ")
+		w.WriteString(style.sgr("2"))
+		w.WriteString("│")
+		w.WriteString(style.sgr("0"))
+		w.WriteString("  Error range is too big. Skipping code block printing.\n")
+		style.writeCodeboxEnd(w)
+		return
+	}
+
+	for i, char := range text[codeboxStart:codeboxEnd] {
+		if char == '\n' {
+			if line != codeboxEndLine {
+				lineIndentCalculated = false
+				lineEnds[line-codeboxStartLine] = lastNonSpaceIndex - int(lineMap[line]) + codeboxStart
+				lastNonSpaceIndex = -1
+				line++
+			}
+			continue
+		}
+
+		if !lineIndentCalculated && !unicode.IsSpace(char) {
+			lineIndentCalculated = true
+			lineStarts[line-codeboxStartLine] = i - int(lineMap[line]) + codeboxStart
+			indentSize = min(indentSize, lineStarts[line-codeboxStartLine])
+		}
+
+		if lineIndentCalculated && !unicode.IsSpace(char) {
+			lastNonSpaceIndex = i + 1
+		}
+	}
+	if line == codeboxEndLine {
+		lineEnds[line-codeboxStartLine] = lastNonSpaceIndex - int(lineMap[line]) + codeboxStart
+	}
+
+	diagnosticHighlightActive := false
+	lastLineNumber := strconv.Itoa(codeboxEndLine + 1)
+	for line := codeboxStartLine; line <= codeboxEndLine; line++ {
+		number := strconv.Itoa(line + 1)
+		number = spaces[:len(lastLineNumber)-len(number)] + number
+
+		lineTextStart := int(lineMap[line]) + indentSize
+		underlineStart := max(lineTextStart, int(lineMap[line])+lineStarts[line-codeboxStartLine])
+		underlineEnd := underlineStart
+		lineTextEnd := max(int(lineMap[line])+lineEnds[line-codeboxStartLine], lineTextStart)
+		nextLineStart := len(text)
+		if line != len(lineMap)-1 {
+			nextLineStart = int(lineMap[line+1])
+		}
+
+		if diagnosticHighlightActive {
+			underlineEnd = lineTextEnd
+		} else if int(lineMap[line]) <= diagnosticStart && (line == len(lineMap)-1 || diagnosticStart < nextLineStart) {
+			underlineStart = min(max(lineTextStart, diagnosticStart), lineTextEnd)
+			underlineEnd = lineTextEnd
+			diagnosticHighlightActive = true
+		}
+		if int(lineMap[line]) <= diagnosticEnd && (line == len(lineMap)-1 || diagnosticEnd < nextLineStart) {
+			underlineEnd = min(max(underlineStart, diagnosticEnd), lineTextEnd)
+			diagnosticHighlightActive = false
+		}
+
+		var labels []codeLabel
+		for _, labeled := range d.LabeledRanges {
+			label := codeLabel{
+				start: max(labeled.Range.Pos(), lineTextStart),
+				end:   min(labeled.Range.End(), lineTextEnd),
+			}
+			if label.start >= label.end {
+				continue
+			}
+			if labeled.Range.End() <= nextLineStart {
+				label.text = labeled.Label
+			}
+			labels = append(labels, label)
+		}
+
+		style.writeCodeLine(w, text, codeLine{
+			number:         number,
+			start:          lineTextStart,
+			end:            lineTextEnd,
+			underlineStart: underlineStart,
+			underlineEnd:   underlineEnd,
+			labels:         labels,
+		})
+	}
+	style.writeCodeboxEnd(w)
+}
+
+// A labeled range, clipped to a single line of code.
+type codeLabel struct {
+	start int
+	end   int
+	// Empty unless the labeled range ends on this line
+	text string
+}
+
+type codeLine struct {
+	number         string
+	start          int
+	end            int
+	underlineStart int
+	underlineEnd   int
+	labels         []codeLabel
+}
+
+// Writes a line of the code box, wrapped to the output width. The diagnostic
+// range is underlined with colors, or marked with `^` on the following line
+// without them. Labeled ranges are marked on the following lines.
+func (s outputStyle) writeCodeLine(w *bufio.Writer, text string, line codeLine) {
+	codeWidth := 0
+	if s.width > 0 {
+		// "  │ " + number + " │  "
+		codeWidth = s.width - len(line.number) - 8
+		if codeWidth < minWrapWidth {
+			codeWidth = 0
+		}
+	}
+	blankNumber := spaces[:len(line.number)]
+
+	for i, chunk := range codeLineChunks(text, line.start, line.end, codeWidth) {
+		chunkStart, chunkEnd := chunk[0], chunk[1]
+		if i == 0 {
+			s.writeGutter(w, line.number)
+		} else {
+			s.writeGutter(w, blankNumber)
+		}
+
+		underlineStart := min(max(line.underlineStart, chunkStart), chunkEnd)
+		underlineEnd := min(max(line.underlineEnd, chunkStart), chunkEnd)
+		if s.color && underlineStart < underlineEnd {
+			w.WriteString(text[chunkStart:underlineStart])
+			w.WriteString("\x1b[4m\x1b[4:3m\x1b[58:5:160m\x1b[38;5;160m\x1b[22;49m")
+			w.WriteString(text[underlineStart:underlineEnd])
+			w.WriteString("\x1b[0m")
+			w.WriteString(text[underlineEnd:chunkEnd])
+		} else {
+			w.WriteString(text[chunkStart:chunkEnd])
+		}
+		w.WriteByte('\n')
+
+		if !s.color {
+			s.writeMarkers(w, text, blankNumber, chunkStart, underlineStart, underlineEnd, "^", "", "")
+		}
+		for _, label := range line.labels {
+			labelStart := min(max(label.start, chunkStart), chunkEnd)
+			labelEnd := min(max(label.end, chunkStart), chunkEnd)
+			labelText := ""
+			if label.end <= chunkEnd {
+				labelText = label.text
+			}
+			if s.color {
+				s.writeMarkers(w, text, blankNumber, chunkStart, labelStart, labelEnd, "─", labelText, "38;5;117")
+			} else {
+				s.writeMarkers(w, text, blankNumber, chunkStart, labelStart, labelEnd, "-", labelText, "")
+			}
+		}
+	}
+}
+
+// Writes a line marking text[start:end] of the code line starting at lineStart,
+// followed by a label.
+func (s outputStyle) writeMarkers(w *bufio.Writer, text string, blankNumber string, lineStart int, start int, end int, marker string, label string, attributes string) {
+	if start >= end {
+		return
+	}
+
+	s.writeGutter(w, blankNumber)
+	// Keep tabs so that the markers line up with the code above
+	for _, char := range text[lineStart:start] {
+		if char == '\t' {
+			w.WriteByte('\t')
+		} else {
+			w.WriteByte(' ')
+		}
+	}
+	if attributes != "" {
+		w.WriteString(s.sgr(attributes))
+	}
+	w.WriteString(strings.Repeat(marker, utf8.RuneCountInString(text[start:end])))
+	if label != "" {
+		w.WriteByte(' ')
+		w.WriteString(label)
+	}
+	if attributes != "" {
+		w.WriteString(s.sgr("0"))
+	}
+	w.WriteByte('\n')
+}
+
+func (s outputStyle) writeGutter(w *bufio.Writer, number string) {
+	w.WriteString("  ")
+	w.WriteString(s.sgr("2"))
+	w.WriteString("│ ")
+	w.WriteString(number)
+	w.WriteString(" │")
+	w.WriteString(s.sgr("0"))
+	w.WriteString("  ")
+}
+
+// Prints why `--fix` did not apply the fixes of a diagnostic.
+func printFixConflict(conflict linter.FixConflict[rule.RuleDiagnostic], w *bufio.Writer, comparePathOptions tspath.ComparePathsOptions, style outputStyle) {
+	location := func(d rule.RuleDiagnostic, r core.TextRange) string {
+		location := tspath.ConvertToRelativePath(conflict.FileName, comparePathOptions)
+		if d.SourceFile.FileName() != conflict.FileName {
+			// Fixes of another file; its line map is not at hand
+			return location + "@" + strconv.Itoa(r.Pos())
+		}
+		line, column := scanner.GetECMALineAndUTF16CharacterOfPosition(d.SourceFile, r.Pos())
+		return location + ":" + strconv.Itoa(line+1) + ":" + strconv.Itoa(int(column)+1)
+	}
+
+	w.WriteString("  ")
+	w.WriteString(style.sgr("33"))
+	w.WriteString("Fix conflict")
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" — fix for ")
+	w.WriteString(style.sgr("1"))
+	w.WriteString(conflict.Loser.RuleName)
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" (")
+	w.WriteString(location(conflict.Loser, conflict.LoserRange))
+	w.WriteString(") was not applied: it overlaps the fix for ")
+	w.WriteString(style.sgr("1"))
+	w.WriteString(conflict.Winner.RuleName)
+	w.WriteString(style.sgr("0"))
+	w.WriteString(" (")
+	w.WriteString(location(conflict.Winner, conflict.WinnerRange))
+	w.WriteString(")\n")
+}
+
+func (s outputStyle) writeCodeboxEnd(w *bufio.Writer) {
+	w.WriteString("  ")
+	w.WriteString(s.sgr("2"))
+	w.WriteString("╰────────────────────────────────")
+	w.WriteString(s.sgr("0"))
+	w.WriteString("\n\n")
+}
+
+// Splits text[start:end] into ranges of at most width columns. A width of 0
+// disables wrapping.
+func codeLineChunks(text string, start int, end int, width int) [][2]int {
+	if width <= 0 {
+		return [][2]int{{start, end}}
+	}
+
+	var chunks [][2]int
+	chunkStart := start
+	columns := 0
+	for i, char := range text[start:end] {
+		charWidth := 1
+		if char == '\t' {
+			charWidth = tabWidth
+		}
+		if columns+charWidth > width && start+i > chunkStart {
+			chunks = append(chunks, [2]int{chunkStart, start + i})
+			chunkStart = start + i
+			columns = 0
+		}
+		columns += charWidth
+	}
+	return append(chunks, [2]int{chunkStart, end})
+}
+
+// Splits text into lines, wrapping lines longer than width at spaces. A width
+// of 0 disables wrapping.
+func wrapText(text string, width int) []string {
+	var lines []string
+	for line := range strings.SplitSeq(text, "\n") {
+		if width <= 0 || utf8.RuneCountInString(line) <= width {
+			lines = append(lines, line)
+			continue
+		}
+
+		var current strings.Builder
+		currentWidth := 0
+		for word := range strings.SplitSeq(line, " ") {
+			wordWidth := utf8.RuneCountInString(word)
+			if currentWidth > 0 && currentWidth+1+wordWidth > width {
+				lines = append(lines, current.String())
+				current.Reset()
+				currentWidth = 0
+			}
+			if currentWidth > 0 {
+				current.WriteByte(' ')
+				currentWidth++
+			}
+			current.WriteString(word)
+			currentWidth += wordWidth
+		}
+		lines = append(lines, current.String())
+	}
+	return lines
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDetectOutputStyle(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	t.Setenv("COLUMNS", "")
+	t.Setenv("NO_COLOR", "")
+	// Registers FORCE_COLOR to be restored after the test
+	t.Setenv("FORCE_COLOR", "")
+	os.Unsetenv("FORCE_COLOR")
+	if style := detectOutputStyle(f); style.color || style.width != 0 {
+		t.Errorf("expected plain unwrapped output for files, got %+v", style)
+	}
+
+	t.Setenv("FORCE_COLOR", "1")
+	t.Setenv("COLUMNS", "80")
+	if style := detectOutputStyle(f); !style.color || style.width != 80 {
+		t.Errorf("expected FORCE_COLOR and COLUMNS to be respected, got %+v", style)
+	}
+
+	t.Setenv("FORCE_COLOR", "0")
+	if style := detectOutputStyle(f); style.color {
+		t.Errorf("expected FORCE_COLOR=0 to disable colors, got %+v", style)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	got := wrapText("Unsafe return of a value of type `any`.\nSecond line", 20)
+	expected := []string{"Unsafe return of a", "value of type `any`.", "Second line"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("wrapText() = %q, expected %q", got, expected)
+	}
+
+	if got := wrapText("a b c", 0); !slices.Equal(got, []string{"a b c"}) {
+		t.Errorf("expected no wrapping with width 0, got %q", got)
+	}
+}
+
+func TestWriteCodeLinePlain(t *testing.T) {
+	text := "\tconst value = compute(x);"
+	var output strings.Builder
+	w := bufio.NewWriter(&output)
+	outputStyle{}.writeCodeLine(w, text, codeLine{
+		number:         "3",
+		start:          0,
+		end:            len(text),
+		underlineStart: 15,
+		underlineEnd:   25,
+		labels:         []codeLabel{{start: 7, end: 12, text: "declared here"}},
+	})
+	w.Flush()
+
+	expected := "  │ 3 │  \tconst value = compute(x);\n" +
+		"  │   │  \t              ^^^^^^^^^^\n" +
+		"  │   │  \t      ----- declared here\n"
+	if output.String() != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", output.String(), expected)
+	}
+}
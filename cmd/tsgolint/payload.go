@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/go-json-experiment/json"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
 
 // V1 Headless payload format
@@ -23,11 +24,24 @@ type headlessPayload struct {
 	SourceOverrides map[string]string `json:"source_overrides,omitempty"`
 	ReportSyntactic bool              `json:"report_syntactic,omitempty"`
 	ReportSemantic  bool              `json:"report_semantic,omitempty"`
+	// Report `eslint-disable` comments for tsgolint rules that did not suppress anything
+	ReportUnusedDisableDirectives bool `json:"report_unused_disable_directives,omitempty"`
+	// Config file names or patterns, e.g. `tsconfig.test.json` or
+	// `tsconfig.*.json`, to look for before the usual tsconfig search, for the
+	// files of configs without a `tsconfig`. See TsConfigResolver.SetPreferredConfigs.
+	PreferredTsconfigs []string `json:"preferred_tsconfigs,omitempty"`
+	// Compiler options of the program of the files not included by any
+	// tsconfig, e.g. `{"strict": true, "checkJs": true}`. Replaces the
+	// `-inferred-project-options` flag.
+	InferredProjectOptions *utils.InferredProjectOptions `json:"inferred_project_options,omitempty"`
 }
 
 type headlessConfig struct {
 	FilePaths []string       `json:"file_paths"`
 	Rules     []headlessRule `json:"rules"`
+	// The tsconfig to lint the files with, absolute or relative to the current
+	// directory. The tsconfig is not searched for, and must include every file.
+	Tsconfig string `json:"tsconfig,omitempty"`
 }
 
 type headlessRule struct {
@@ -101,12 +101,14 @@ interface RuleDiagnostic extends BaseDiagnostic {
   fixes?: Array<{
     text: string;
     range: { pos: number; end: number };
+    kind: 'safe' | 'unsafe' | 'layout';
   }>;
   suggestions?: {
     message: { id: string; description: string; help?: string };
     fixes: Array<{
       text: string;
       range: { pos: number; end: number };
+      kind: 'safe' | 'unsafe' | 'layout';
     }>;
   }[];
 }
@@ -626,11 +628,11 @@ console.log(x);
     expect((diagnostics[0] as RuleDiagnostic).suggestions).toStrictEqual(
       [
         {
-          fixes: [{ range: expect.any(Object), text: 'void ' }],
+          fixes: [{ kind: 'safe', range: expect.any(Object), text: 'void ' }],
           message: { description: 'Add void operator to ignore.', id: 'floatingFixVoid' },
         },
         {
-          fixes: [{ range: expect.any(Object), text: 'await ' }],
+          fixes: [{ kind: 'unsafe', range: expect.any(Object), text: 'await ' }],
           message: { description: 'Add await operator.', id: 'floatingFixAwait' },
         },
       ],
@@ -0,0 +1,336 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+)
+
+// Rule name used for diagnostics about disable directives that did not suppress anything.
+const UnusedDisableDirectiveRuleName = "unused-disable-directive"
+
+type disableDirectiveKind uint8
+
+const (
+	disableDirectiveKindDisable disableDirectiveKind = iota
+	disableDirectiveKindEnable
+	disableDirectiveKindDisableLine
+	disableDirectiveKindDisableNextLine
+)
+
+var disableDirectiveKindsByName = map[string]disableDirectiveKind{
+	"disable":           disableDirectiveKindDisable,
+	"enable":            disableDirectiveKindEnable,
+	"disable-line":      disableDirectiveKindDisableLine,
+	"disable-next-line": disableDirectiveKindDisableNextLine,
+}
+
+// Frontends refer to tsgolint rules through one of these plugin prefixes. Bare
+// names are never matched, since core ESLint rules such as `dot-notation` or
+// `require-await` share names with their type-aware counterparts.
+var disableDirectiveRulePrefixes = []string{"@typescript-eslint/", "typescript-eslint/", "typescript/"}
+
+type disableDirectiveRule struct {
+	// Name of the tsgolint rule, or "" if the name refers to a rule that is not
+	// enabled for the file (e.g. a rule handled by the frontend).
+	name      string
+	nameRange core.TextRange
+	used      bool
+}
+
+type disableDirective struct {
+	kind disableDirectiveKind
+	// e.g. "eslint-disable-next-line", used in messages
+	directive string
+	comment   core.TextRange
+	// The line a line directive applies to
+	line int
+	// nil if the directive applies to all rules
+	rules []*disableDirectiveRule
+}
+
+func (d *disableDirective) findRule(ruleName string) *disableDirectiveRule {
+	for _, r := range d.rules {
+		if r.name == ruleName {
+			return r
+		}
+	}
+	return nil
+}
+
+// disableDirectives holds the `eslint-disable` style comments of a single file
+// and tracks which of them suppressed a diagnostic.
+type disableDirectives struct {
+	file *ast.SourceFile
+	// In source order
+	directives []*disableDirective
+}
+
+func parseDisableDirectives(file *ast.SourceFile, enabledRules map[string]struct{}) *disableDirectives {
+	result := &disableDirectives{file: file}
+	text := file.Text()
+
+	seen := make(map[int]struct{})
+	nodeFactory := ast.NewNodeFactory(ast.NodeFactoryHooks{})
+	collect := func(pos int) {
+		for comment := range scanner.GetLeadingCommentRanges(nodeFactory, text, pos) {
+			if _, ok := seen[comment.Pos()]; ok {
+				continue
+			}
+			seen[comment.Pos()] = struct{}{}
+			if d := parseDisableDirective(file, comment, enabledRules); d != nil {
+				result.directives = append(result.directives, d)
+			}
+		}
+	}
+
+	// Every comment in the file is part of the trivia that precedes a token. Scanning
+	// the trivia at the start and end of each node covers all places where
+	// directives may appear without having to rescan the whole file.
+	var visitor ast.Visitor
+	visitor = func(node *ast.Node) bool {
+		collect(node.Pos())
+		node.ForEachChild(visitor)
+		collect(node.End())
+		return false
+	}
+	file.Node.ForEachChild(visitor)
+
+	if len(result.directives) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(result.directives, func(a, b *disableDirective) int {
+		return a.comment.Pos() - b.comment.Pos()
+	})
+	return result
+}
+
+func parseDisableDirective(file *ast.SourceFile, comment ast.CommentRange, enabledRules map[string]struct{}) *disableDirective {
+	text := file.Text()
+	bodyStart := comment.Pos() + 2
+	bodyEnd := comment.End()
+	if comment.Kind == ast.KindMultiLineCommentTrivia {
+		bodyEnd -= 2
+	}
+	if bodyEnd < bodyStart {
+		return nil
+	}
+
+	body := text[bodyStart:bodyEnd]
+	trimmed := strings.TrimLeftFunc(body, unicode.IsSpace)
+	offset := bodyStart + len(body) - len(trimmed)
+
+	var prefix string
+	for _, p := range []string{"eslint-", "oxlint-"} {
+		if strings.HasPrefix(trimmed, p) {
+			prefix = p
+			break
+		}
+	}
+	if prefix == "" {
+		return nil
+	}
+
+	directiveEnd := strings.IndexFunc(trimmed, unicode.IsSpace)
+	if directiveEnd == -1 {
+		directiveEnd = len(trimmed)
+	}
+	directive := trimmed[:directiveEnd]
+	kind, ok := disableDirectiveKindsByName[directive[len(prefix):]]
+	if !ok {
+		return nil
+	}
+
+	result := &disableDirective{
+		kind:      kind,
+		directive: directive,
+		comment:   core.NewTextRange(comment.Pos(), comment.End()),
+	}
+	switch kind {
+	case disableDirectiveKindDisableLine:
+		result.line = scanner.GetECMALineOfPosition(file, comment.Pos())
+	case disableDirectiveKindDisableNextLine:
+		result.line = scanner.GetECMALineOfPosition(file, comment.End()) + 1
+	}
+
+	// Everything after ` -- ` is a free-form description.
+	rulesText := trimmed[directiveEnd:]
+	if idx := strings.Index(rulesText, "--"); idx != -1 && (idx == 0 || unicode.IsSpace(rune(rulesText[idx-1]))) {
+		rulesText = rulesText[:idx]
+	}
+	rulesOffset := offset + directiveEnd
+
+	start := 0
+	for start <= len(rulesText) {
+		end := strings.IndexByte(rulesText[start:], ',')
+		if end == -1 {
+			end = len(rulesText)
+		} else {
+			end += start
+		}
+
+		part := rulesText[start:end]
+		name := strings.TrimSpace(part)
+		if name != "" {
+			namePos := rulesOffset + start + strings.Index(part, name)
+			result.rules = append(result.rules, &disableDirectiveRule{
+				name:      matchDisableDirectiveRuleName(name, enabledRules),
+				nameRange: core.NewTextRange(namePos, namePos+len(name)),
+			})
+		}
+
+		start = end + 1
+	}
+
+	return result
+}
+
+func matchDisableDirectiveRuleName(name string, enabledRules map[string]struct{}) string {
+	for _, prefix := range disableDirectiveRulePrefixes {
+		if ruleName, ok := strings.CutPrefix(name, prefix); ok {
+			if _, enabled := enabledRules[ruleName]; enabled {
+				return ruleName
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// Reports whether the diagnostic is suppressed by a directive, marking every
+// directive responsible for the suppression as used.
+func (d *disableDirectives) suppresses(diagnostic rule.RuleDiagnostic) bool {
+	pos := diagnostic.Range.Pos()
+	line := scanner.GetECMALineOfPosition(d.file, pos)
+
+	suppressed := false
+	markUsed := func(r *disableDirectiveRule) {
+		suppressed = true
+		if r != nil {
+			r.used = true
+		}
+	}
+
+	// The innermost `eslint-disable` block covering the diagnostic
+	active := false
+	var activeRule *disableDirectiveRule
+	for _, directive := range d.directives {
+		var r *disableDirectiveRule
+		if directive.rules != nil {
+			r = directive.findRule(diagnostic.RuleName)
+			if r == nil {
+				continue
+			}
+		}
+
+		switch directive.kind {
+		case disableDirectiveKindDisableLine, disableDirectiveKindDisableNextLine:
+			if directive.line == line {
+				markUsed(r)
+			}
+		case disableDirectiveKindDisable:
+			if directive.comment.Pos() < pos {
+				active, activeRule = true, r
+			}
+		case disableDirectiveKindEnable:
+			if directive.comment.Pos() < pos {
+				active, activeRule = false, nil
+			}
+		}
+	}
+	if active {
+		markUsed(activeRule)
+	}
+
+	return suppressed
+}
+
+// Reports directives that name an enabled rule which did not report anything
+// in the directive's range. Directives without a rule list are never reported,
+// as they may suppress diagnostics of rules that tsgolint does not know about.
+func (d *disableDirectives) reportUnused(fix bool, report func(rule.RuleDiagnostic)) {
+	text := d.file.Text()
+
+	for _, directive := range d.directives {
+		if directive.kind == disableDirectiveKindEnable || directive.rules == nil {
+			continue
+		}
+
+		var unused []*disableDirectiveRule
+		for _, r := range directive.rules {
+			if r.name != "" && !r.used {
+				unused = append(unused, r)
+			}
+		}
+		if len(unused) == 0 {
+			continue
+		}
+
+		names := make([]string, len(unused))
+		for i, r := range unused {
+			names[i] = "'" + text[r.nameRange.Pos():r.nameRange.End()] + "'"
+		}
+
+		var fixes []rule.RuleFix
+		if fix {
+			if len(unused) == len(directive.rules) {
+				fixes = []rule.RuleFix{rule.RuleFixRemoveRange(commentRemovalRange(text, directive.comment)).WithKind(rule.RuleFixKindSafe)}
+			} else {
+				kept := make([]string, 0, len(directive.rules)-len(unused))
+				for _, r := range directive.rules {
+					if r.name == "" || r.used {
+						kept = append(kept, text[r.nameRange.Pos():r.nameRange.End()])
+					}
+				}
+				rulesRange := directive.rules[0].nameRange.WithEnd(directive.rules[len(directive.rules)-1].nameRange.End())
+				fixes = []rule.RuleFix{rule.RuleFixReplaceRange(rulesRange, strings.Join(kept, ", ")).WithKind(rule.RuleFixKindSafe)}
+			}
+		}
+
+		report(rule.RuleDiagnostic{
+			Range:    directive.comment,
+			RuleName: UnusedDisableDirectiveRuleName,
+			Message: rule.RuleMessage{
+				Id:          "unusedDisableDirective",
+				Description: "Unused " + directive.directive + " directive (no problems were reported from " + strings.Join(names, " or ") + ").",
+			},
+			FixesPtr:   &fixes,
+			SourceFile: d.file,
+		})
+	}
+}
+
+// Returns the range to delete to remove a comment. If the comment is the only
+// thing on its line, the whole line is removed; otherwise the whitespace
+// separating it from the preceding code is removed along with it.
+func commentRemovalRange(text string, comment core.TextRange) core.TextRange {
+	start := comment.Pos()
+	for start > 0 && (text[start-1] == ' ' || text[start-1] == '\t') {
+		start--
+	}
+	end := comment.End()
+	for end < len(text) && (text[end] == ' ' || text[end] == '\t') {
+		end++
+	}
+
+	startsLine := start == 0 || text[start-1] == '\n'
+	endsLine := end == len(text) || text[end] == '\n' || text[end] == '\r'
+	if startsLine && endsLine {
+		if strings.HasPrefix(text[end:], "\r\n") {
+			end += 2
+		} else if end < len(text) {
+			end++
+		}
+		return core.NewTextRange(start, end)
+	}
+	if endsLine {
+		return core.NewTextRange(start, comment.End())
+	}
+	return comment
+}
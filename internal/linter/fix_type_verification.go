@@ -0,0 +1,305 @@
+package linter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Id of the internal diagnostic reported for fixes which were reverted because
+// they introduce type errors.
+const TypeErrorFixDiagnosticId = "fix-type-error"
+
+// Fixes are checked again after reverting the ones blamed for new type errors.
+// Once this many rounds did not settle a file, all fixes editing it are reverted.
+const maxFixVerificationRounds = 3
+
+// Holds back the diagnostics of a program until their fixes have been
+// type-checked.
+type fixTypeVerifier struct {
+	program     *compiler.Program
+	mu          sync.Mutex
+	diagnostics map[string][]rule.RuleDiagnostic
+}
+
+func newFixTypeVerifier(program *compiler.Program) *fixTypeVerifier {
+	return &fixTypeVerifier{
+		program:     program,
+		diagnostics: make(map[string][]rule.RuleDiagnostic),
+	}
+}
+
+func (v *fixTypeVerifier) add(d rule.RuleDiagnostic) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	fileName := d.SourceFile.FileName()
+	v.diagnostics[fileName] = append(v.diagnostics[fileName], d)
+}
+
+// A diagnostic tagged with its index among the diagnostics of the program, so
+// that it can be found again after `ApplyRuleFixesToFiles`.
+type indexedDiagnostic struct {
+	rule.RuleDiagnostic
+	idx int
+}
+
+type ownedFix struct {
+	fix   rule.RuleFix
+	owner int
+}
+
+// Applies the fixes of every file, rebuilds the program with the edited files
+// and compares the semantic diagnostics of the program with the original ones.
+// Fixes blamed for new errors are removed and reported, and the remaining ones
+// are checked again. Then all diagnostics are emitted, sorted by file name.
+func (v *fixTypeVerifier) verify(onDiagnostic func(diagnostic rule.RuleDiagnostic), onInternalDiagnostic func(d diagnostic.Internal)) {
+	ctx := core.WithRequestID(context.Background(), "__single_run__")
+
+	fileNames := slices.Sorted(maps.Keys(v.diagnostics))
+	var all []*rule.RuleDiagnostic
+	for _, fileName := range fileNames {
+		for i := range v.diagnostics[fileName] {
+			all = append(all, &v.diagnostics[fileName][i])
+		}
+	}
+	hasFixes := func(d *rule.RuleDiagnostic) bool { return len(d.Fixes()) > 0 }
+
+	// Fixes may also break the files depending on the edited ones
+	var programFiles []string
+	for _, file := range v.program.SourceFiles() {
+		if !file.IsDeclarationFile {
+			programFiles = append(programFiles, file.FileName())
+		}
+	}
+
+	before := make(map[string][]*ast.Diagnostic)
+	for round := 1; slices.ContainsFunc(all, hasFixes); round++ {
+		fixedTexts, appliedFixes := v.applyFixes(fileNames, all)
+		checked := slices.Sorted(maps.Keys(fixedTexts))
+		for _, fileName := range programFiles {
+			if _, ok := fixedTexts[fileName]; !ok {
+				checked = append(checked, fileName)
+			}
+		}
+		unchecked := slices.DeleteFunc(slices.Clone(checked), func(fileName string) bool {
+			_, ok := before[fileName]
+			return ok
+		})
+		if len(unchecked) > 0 {
+			maps.Copy(before, semanticErrors(ctx, v.program, unchecked))
+		}
+		after := semanticErrors(ctx, rebuildProgram(v.program, fixedTexts), checked)
+
+		// A fix set is reverted as a whole, whichever of its files has the error
+		reverted := make(map[int]*ast.Diagnostic)
+		var dependentError *ast.Diagnostic
+		for _, fileName := range checked {
+			introduced := newErrors(before[fileName], after[fileName])
+			if len(introduced) == 0 {
+				continue
+			}
+			if len(appliedFixes[fileName]) == 0 {
+				if dependentError == nil {
+					dependentError = introduced[0]
+				}
+				continue
+			}
+			if round >= maxFixVerificationRounds {
+				for _, f := range appliedFixes[fileName] {
+					if _, ok := reverted[f.owner]; !ok {
+						reverted[f.owner] = introduced[0]
+					}
+				}
+				continue
+			}
+			for _, e := range introduced {
+				if owner := blameFix(appliedFixes[fileName], e.Loc().Pos()); owner >= 0 {
+					if _, ok := reverted[owner]; !ok {
+						reverted[owner] = e
+					}
+				}
+			}
+		}
+		// Errors in files which no fix edited can't be traced to a single fix.
+		// Unless reverting the blamed fixes may solve them, all fixes are reverted.
+		if len(reverted) == 0 && dependentError != nil {
+			for _, fixes := range appliedFixes {
+				for _, f := range fixes {
+					reverted[f.owner] = dependentError
+				}
+			}
+		}
+		if len(reverted) == 0 {
+			break
+		}
+
+		for _, owner := range slices.Sorted(maps.Keys(reverted)) {
+			d := all[owner]
+			onInternalDiagnostic(typeErrorFixDiagnostic(d.SourceFile.FileName(), d, reverted[owner]))
+			d.FixesPtr = &[]rule.RuleFix{}
+		}
+	}
+
+	for _, d := range all {
+		onDiagnostic(*d)
+	}
+}
+
+// Applies the fixes of every file like `ApplyRuleFixesOfFiles`, reading the
+// files from the program. Returns the fixed text of every edited file, and the
+// applied fixes of every file sorted by position and tagged with the index of
+// their diagnostic in all.
+func (v *fixTypeVerifier) applyFixes(fileNames []string, all []*rule.RuleDiagnostic) (map[string]string, map[string][]ownedFix) {
+	fixedTexts := make(map[string]string)
+	appliedFixes := make(map[string][]ownedFix)
+
+	offset := 0
+	for _, fileName := range fileNames {
+		indexed := make([]indexedDiagnostic, len(v.diagnostics[fileName]))
+		for i := range indexed {
+			indexed[i] = indexedDiagnostic{*all[offset+i], offset + i}
+		}
+		offset += len(indexed)
+		if _, ok := fixedTexts[fileName]; ok {
+			continue
+		}
+
+		files := make(map[string]string)
+		readFile := func(name string) {
+			if _, ok := fixedTexts[name]; ok {
+				return
+			}
+			if file := v.program.GetSourceFile(name); file != nil {
+				files[name] = file.Text()
+			}
+		}
+		readFile(fileName)
+		for _, d := range indexed {
+			for _, fix := range d.Fixes() {
+				if fix.FileName != "" {
+					readFile(fix.FileName)
+				}
+			}
+		}
+
+		fixedFiles, unapplied, _, _ := ApplyRuleFixesToFiles(fileName, files, indexed)
+		for _, d := range indexed {
+			if len(d.Fixes()) == 0 || slices.ContainsFunc(unapplied, func(u indexedDiagnostic) bool { return u.idx == d.idx }) {
+				continue
+			}
+			for _, fix := range d.Fixes() {
+				name := fixFileName(fileName, fix)
+				appliedFixes[name] = append(appliedFixes[name], ownedFix{fix, d.idx})
+			}
+		}
+		for name, text := range fixedFiles {
+			if text != files[name] {
+				fixedTexts[name] = text
+			}
+		}
+	}
+
+	for _, fixes := range appliedFixes {
+		slices.SortFunc(fixes, func(a ownedFix, b ownedFix) int {
+			return a.fix.Range.Pos() - b.fix.Range.Pos()
+		})
+	}
+	return fixedTexts, appliedFixes
+}
+
+// Returns the index of the diagnostic whose fix is closest to the given
+// position in the fixed code, or -1 if no fixes were applied.
+func blameFix(fixes []ownedFix, fixedPos int) int {
+	owner, bestDistance := -1, math.MaxInt
+	delta := 0
+	for _, f := range fixes {
+		start := f.fix.Range.Pos() + delta
+		end := start + len(f.fix.Text)
+		distance := 0
+		if fixedPos < start {
+			distance = start - fixedPos
+		} else if fixedPos > end {
+			distance = fixedPos - end
+		}
+		if distance < bestDistance {
+			owner, bestDistance = f.owner, distance
+		}
+		delta += len(f.fix.Text) - f.fix.Range.Len()
+	}
+	return owner
+}
+
+// Creates a program with the same configuration, where the given files have
+// the given contents.
+func rebuildProgram(program *compiler.Program, files map[string]string) *compiler.Program {
+	host := program.Host()
+	fixedHost := utils.NewCompilerHost(host.GetCurrentDirectory(), utils.NewOverlayVFS(host.FS(), files), host.DefaultLibraryPath(), nil, nil)
+	fixed := compiler.NewProgram(compiler.ProgramOptions{
+		Config:                      program.CommandLine(),
+		SingleThreaded:              core.TSFalse,
+		Host:                        fixedHost,
+		UseSourceOfProjectReference: true,
+	})
+	fixed.BindSourceFiles()
+	return fixed
+}
+
+func semanticErrors(ctx context.Context, program *compiler.Program, fileNames []string) map[string][]*ast.Diagnostic {
+	files := make([]*ast.SourceFile, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		if file := program.GetSourceFile(fileName); file != nil {
+			files = append(files, file)
+		}
+	}
+
+	diagnosticsByFile := program.GetSemanticDiagnosticsWithoutNoEmitFiltering(ctx, files)
+	result := make(map[string][]*ast.Diagnostic, len(files))
+	for _, file := range files {
+		result[file.FileName()] = compiler.FilterNoEmitSemanticDiagnostics(diagnosticsByFile[file], program.Options())
+	}
+	return result
+}
+
+// Returns the diagnostics of after which are not in before. Positions are
+// ignored since fixes move code around.
+func newErrors(before []*ast.Diagnostic, after []*ast.Diagnostic) []*ast.Diagnostic {
+	key := func(d *ast.Diagnostic) string {
+		return strconv.Itoa(int(d.Code())) + "\x00" + utils.GetDiagnosticMessage(d)
+	}
+
+	counts := make(map[string]int, len(before))
+	for _, d := range before {
+		counts[key(d)]++
+	}
+
+	var introduced []*ast.Diagnostic
+	for _, d := range after {
+		if k := key(d); counts[k] > 0 {
+			counts[k]--
+		} else {
+			introduced = append(introduced, d)
+		}
+	}
+	return introduced
+}
+
+func typeErrorFixDiagnostic(fileName string, d *rule.RuleDiagnostic, typeError *ast.Diagnostic) diagnostic.Internal {
+	return diagnostic.Internal{
+		Range:       d.Range,
+		Id:          TypeErrorFixDiagnosticId,
+		Description: fmt.Sprintf("The fix for '%s' (%s) introduces a type error and was reverted.", d.RuleName, d.Message.Id),
+		Help:        "TS" + strconv.Itoa(int(typeError.Code())) + ": " + utils.GetDiagnosticMessage(typeError),
+		FilePath:    &fileName,
+	}
+}
@@ -0,0 +1,233 @@
+package linter
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBlameFix(t *testing.T) {
+	// "a as string; b as string;" -> "a; b;"
+	fixes := []ownedFix{
+		{fix: rule.RuleFix{Range: core.NewTextRange(1, 11)}, owner: 0},
+		{fix: rule.RuleFix{Range: core.NewTextRange(14, 24)}, owner: 3},
+	}
+
+	assert.Equal(t, blameFix(fixes, 0), 0)
+	assert.Equal(t, blameFix(fixes, 3), 3)
+	assert.Equal(t, blameFix(fixes, 5), 3)
+	assert.Equal(t, blameFix(nil, 5), -1)
+}
+
+func TestRunLinterOnProgram_VerifyFixes(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := "const x: number = 1;\nconst y: number = 1;\n"
+
+	fs := utils.NewOverlayVFS(cachedBaseFS, map[string]string{filePath: code})
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	// Replaces the initializer of the variable named name
+	replaceInitializer := func(name string, text string) ConfiguredRule {
+		return ConfiguredRule{
+			Name: "replace-" + name,
+			Run: func(ctx rule.RuleContext) rule.RuleListeners {
+				return rule.RuleListeners{
+					ast.KindVariableDeclaration: func(node *ast.Node) {
+						if node.Name().Text() != name {
+							return
+						}
+						ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "replace", Description: "replace"}, func() []rule.RuleFix {
+							return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node.Initializer(), text)}
+						})
+					},
+				}
+			},
+		}
+	}
+
+	var mu sync.Mutex
+	diagnostics := make(map[string]rule.RuleDiagnostic)
+	var internalDiagnostics []diagnostic.Internal
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(filePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				// Type '"a"' is not assignable to type 'number'
+				replaceInitializer("x", `"a"`),
+				replaceInitializer("y", "2"),
+			}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics[d.RuleName] = d
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		},
+		Fixes:       Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:  TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		VerifyFixes: true,
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 2, "both diagnostics should still be reported")
+	assert.Equal(t, len(diagnostics["replace-x"].Fixes()), 0, "the fix introducing a type error should be reverted")
+	assert.Equal(t, len(diagnostics["replace-y"].Fixes()), 1, "the valid fix should be kept")
+
+	assert.Equal(t, len(internalDiagnostics), 1)
+	assert.Equal(t, internalDiagnostics[0].Id, TypeErrorFixDiagnosticId)
+	assert.Equal(t, *internalDiagnostics[0].FilePath, filePath)
+	assert.Assert(t, strings.HasPrefix(internalDiagnostics[0].Help, "TS2322: "), internalDiagnostics[0].Help)
+
+	fixed, _, _ := ApplyRuleFixes(code, []rule.RuleDiagnostic{diagnostics["replace-x"], diagnostics["replace-y"]})
+	assert.Equal(t, fixed, "const x: number = 1;\nconst y: number = 2;\n")
+}
+
+func TestRunLinterOnProgram_VerifyFixesInOtherFiles(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	valuePath := tspath.ResolvePath(rootDir, "verify-value.ts")
+	valueCode := "export const value: number = 1;\n"
+	usagePath := tspath.ResolvePath(rootDir, "verify-usage.ts")
+	usageCode := "import { value } from './verify-value';\nconst z = value;\n"
+
+	fs := utils.NewOverlayVFS(cachedBaseFS, map[string]string{valuePath: valueCode, usagePath: usageCode})
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+	valueFile := program.GetSourceFile(valuePath)
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+	var internalDiagnostics []diagnostic.Internal
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(usagePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: "replace-value",
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					return rule.RuleListeners{
+						ast.KindVariableDeclaration: func(node *ast.Node) {
+							ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "replace", Description: "replace"}, func() []rule.RuleFix {
+								// The fix of this file is valid, but the one of the other file is not
+								pos := strings.Index(valueCode, "1")
+								return []rule.RuleFix{
+									rule.RuleFixReplace(ctx.SourceFile, node.Initializer(), "value + 1"),
+									rule.RuleFixReplaceRange(core.NewTextRange(pos, pos+1), `"a"`).InFile(valueFile),
+								}
+							})
+						},
+					}
+				},
+			}}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		},
+		Fixes:       Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:  TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		VerifyFixes: true,
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 1)
+	assert.Equal(t, len(diagnostics[0].Fixes()), 0, "the fixes of both files should be reverted together")
+
+	assert.Equal(t, len(internalDiagnostics), 1)
+	assert.Equal(t, *internalDiagnostics[0].FilePath, usagePath)
+	assert.Assert(t, strings.HasPrefix(internalDiagnostics[0].Help, "TS2322: "), internalDiagnostics[0].Help)
+}
+
+func TestRunLinterOnProgram_VerifyFixesOfDependencies(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	valuePath := tspath.ResolvePath(rootDir, "verify-value.ts")
+	usagePath := tspath.ResolvePath(rootDir, "verify-usage.ts")
+
+	fs := utils.NewOverlayVFS(cachedBaseFS, map[string]string{
+		valuePath: "export const value = 1;\n",
+		usagePath: "import { value } from './verify-value';\nconst n: number = value;\n",
+	})
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+	var internalDiagnostics []diagnostic.Internal
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(valuePath), program.GetSourceFile(usagePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: "replace-initializer",
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					return rule.RuleListeners{
+						ast.KindVariableDeclaration: func(node *ast.Node) {
+							if node.Name().Text() != "value" {
+								ctx.ReportNode(node, rule.RuleMessage{Id: "report", Description: "report"})
+								return
+							}
+							// Type 'string' is not assignable to type 'number' in the importing file
+							ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "replace", Description: "replace"}, func() []rule.RuleFix {
+								return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node.Initializer(), `"a"`)}
+							})
+						},
+					}
+				},
+			}}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		},
+		Fixes:       Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:  TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		VerifyFixes: true,
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 2)
+	assert.Equal(t, diagnostics[0].SourceFile.FileName(), usagePath, "diagnostics should be sorted by file name")
+	assert.Equal(t, diagnostics[1].SourceFile.FileName(), valuePath)
+	assert.Equal(t, len(diagnostics[1].Fixes()), 0, "the fix breaking the importing file should be reverted")
+
+	assert.Equal(t, len(internalDiagnostics), 1)
+	assert.Equal(t, *internalDiagnostics[0].FilePath, valuePath)
+	assert.Assert(t, strings.HasPrefix(internalDiagnostics[0].Help, "TS2322: "), internalDiagnostics[0].Help)
+}
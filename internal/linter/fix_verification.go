@@ -0,0 +1,132 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/parser"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Id of the internal diagnostic reported for fixes which were dropped because
+// they produce invalid code.
+const InvalidFixDiagnosticId = "invalid-fix"
+
+// A syntax error, without its position which fixes may shift.
+type syntaxErrorKey struct {
+	code    int32
+	message string
+}
+
+// Counts the syntax errors of a file by code and message.
+func syntaxErrors(diagnostics []*ast.Diagnostic) map[syntaxErrorKey]int {
+	errors := make(map[syntaxErrorKey]int, len(diagnostics))
+	for _, d := range diagnostics {
+		errors[syntaxErrorKey{d.Code(), utils.GetDiagnosticMessage(d)}]++
+	}
+	return errors
+}
+
+// Checks that applying the fixes of a file's diagnostics, the way
+// `ApplyRuleFixesToFiles` does, does not introduce syntax errors in the file or
+// in the other files of program which the fixes edit. An error is new if the
+// fixed file has more errors of its code and message than before, so a fix
+// removing an error and adding another one is caught too. The fixes of
+// diagnostics responsible for new syntax errors are removed in place and an
+// internal diagnostic naming the rule and fix is reported for each of them.
+func verifyFixesSyntax(program *compiler.Program, file *ast.SourceFile, diagnostics []rule.RuleDiagnostic, onInternalDiagnostic func(d diagnostic.Internal)) {
+	withFixes := make([]int, 0, len(diagnostics))
+	// The files edited by the fixes
+	files := map[string]*ast.SourceFile{file.FileName(): file}
+	for i, d := range diagnostics {
+		if len(d.Fixes()) == 0 {
+			continue
+		}
+		withFixes = append(withFixes, i)
+		for _, fix := range d.Fixes() {
+			if _, ok := files[fix.FileName]; fix.FileName == "" || ok {
+				continue
+			}
+			// Fixes of files missing from the program are never applied
+			if other := program.GetSourceFile(fix.FileName); other != nil {
+				files[fix.FileName] = other
+			}
+		}
+	}
+	if len(withFixes) == 0 {
+		return
+	}
+
+	texts := make(map[string]string, len(files))
+	baselines := make(map[string]map[syntaxErrorKey]int, len(files))
+	for fileName, f := range files {
+		texts[fileName] = f.Text()
+		baselines[fileName] = syntaxErrors(f.Diagnostics())
+	}
+	introducesSyntaxErrors := func(indices []int) bool {
+		candidates := make([]rule.RuleDiagnostic, len(indices))
+		for i, idx := range indices {
+			candidates[i] = diagnostics[idx]
+		}
+		fixedTexts, _, _, _ := ApplyRuleFixesToFiles(file.FileName(), texts, candidates)
+		for fileName, code := range fixedTexts {
+			f := files[fileName]
+			fixed := parser.ParseSourceFile(f.ParseOptions(), code, core.GetScriptKindFromFileName(fileName))
+			for key, count := range syntaxErrors(fixed.Diagnostics()) {
+				if count > baselines[fileName][key] {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if !introducesSyntaxErrors(withFixes) {
+		return
+	}
+
+	// Find the offending fixes by adding them one at a time, in the order in
+	// which they are applied.
+	slices.SortStableFunc(withFixes, func(a int, b int) int {
+		return diagnostics[a].Fixes()[0].Range.Pos() - diagnostics[b].Fixes()[0].Range.Pos()
+	})
+	accepted := make([]int, 0, len(withFixes))
+	for _, idx := range withFixes {
+		if candidate := append(accepted, idx); !introducesSyntaxErrors(candidate) {
+			accepted = candidate
+			continue
+		}
+
+		d := &diagnostics[idx]
+		onInternalDiagnostic(invalidFixDiagnostic(texts, file, d))
+		d.FixesPtr = &[]rule.RuleFix{}
+	}
+}
+
+// texts are the texts of the files edited by the fixes of d.
+func invalidFixDiagnostic(texts map[string]string, file *ast.SourceFile, d *rule.RuleDiagnostic) diagnostic.Internal {
+	edits := make([]string, len(d.Fixes()))
+	for i, fix := range d.Fixes() {
+		text := texts[fixFileName(file.FileName(), fix)]
+		if fix.FileName == "" {
+			edits[i] = fmt.Sprintf("replace %q with %q", text[fix.Range.Pos():fix.Range.End()], fix.Text)
+		} else {
+			edits[i] = fmt.Sprintf("replace %q with %q in %v", text[fix.Range.Pos():fix.Range.End()], fix.Text, fix.FileName)
+		}
+	}
+
+	fileName := file.FileName()
+	return diagnostic.Internal{
+		Range:       d.Range,
+		Id:          InvalidFixDiagnosticId,
+		Description: fmt.Sprintf("The fix for '%s' (%s) produces invalid syntax and was not applied.", d.RuleName, d.Message.Id),
+		Help:        "Fix: " + strings.Join(edits, ", "),
+		FilePath:    &fileName,
+	}
+}
@@ -0,0 +1,213 @@
+package linter
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/vfs"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Same limit as the rule tester.
+const DefaultMaxFixIterations = 10
+
+// Lints like `RunLinter`, applies the fixes to the files in memory and lints
+// the programs of the fixed files again, until no fixes remain or
+// maxIterations passes were made. The files on disk are left untouched.
+//
+// Only the diagnostics of the last pass over each file are reported. Their
+// fixes, if any, are relative to the returned text of the file. Fixes which
+// overlap others are applied in a later pass; only the conflicts remaining in
+// the last pass are reported to `OnFixConflict`. Returns the final text of
+// every file which was changed by a fix.
+func RunLinterWithFixes(options RunLinterOptions, maxIterations int) (map[string]string, error) {
+	if !options.Fixes.Fix {
+		return nil, fmt.Errorf("fixes must be enabled to apply them")
+	}
+	if maxIterations < 1 {
+		return nil, fmt.Errorf("expected at least one fix iteration, got %d", maxIterations)
+	}
+
+	programOf := make(map[string]string)
+	for configFileName, fileNames := range options.Workload.Programs {
+		for _, fileName := range fileNames {
+			programOf[fileName] = configFileName
+		}
+	}
+
+	texts := make(map[string]string)
+	workload := options.Workload
+	reportedInternal := make(map[internalDiagnosticKey]struct{})
+
+	for iteration := 1; ; iteration++ {
+		var mu sync.Mutex
+		diagnosticsByFile := make(map[string][]rule.RuleDiagnostic)
+		var internalDiagnostics []diagnostic.Internal
+
+		passOptions := options
+		passOptions.Workload = workload
+		passOptions.FS = utils.NewOverlayVFS(options.FS, maps.Clone(texts))
+		// The conflicts of earlier passes are resolved by the later ones
+		if iteration < maxIterations {
+			passOptions.OnFixConflict = nil
+		}
+		passOptions.OnRuleDiagnostic = func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			fileName := d.SourceFile.FileName()
+			diagnosticsByFile[fileName] = append(diagnosticsByFile[fileName], d)
+		}
+		passOptions.OnInternalDiagnostic = func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		}
+		if err := RunLinter(passOptions); err != nil {
+			return nil, err
+		}
+
+		edited := make(map[string]struct{})
+		if iteration < maxIterations {
+			fixedTexts, _, fixedFileNames := ApplyRuleFixesOfFiles(passOptions.FS, diagnosticsByFile, nil)
+			for fileName, text := range fixedTexts {
+				texts[fileName] = text
+				edited[fileName] = struct{}{}
+			}
+			// A fix may edit only files of other programs, leaving the file of
+			// its diagnostic unchanged; the diagnostic is stale all the same.
+			for _, fileName := range fixedFileNames {
+				edited[fileName] = struct{}{}
+			}
+		}
+
+		// Programs with an edited file, or a file whose diagnostics were
+		// fixed, are linted again as a whole, since the diagnostics of
+		// type-aware rules in their other files may depend on the edits.
+		workload = Workload{
+			Programs:       make(map[string][]string),
+			UnmatchedFiles: []string{},
+		}
+		for fileName := range edited {
+			if configFileName, ok := programOf[fileName]; ok {
+				workload.Programs[configFileName] = options.Workload.Programs[configFileName]
+			} else if slices.Contains(options.Workload.UnmatchedFiles, fileName) {
+				workload.UnmatchedFiles = options.Workload.UnmatchedFiles
+			}
+		}
+		relinted := make(map[string]struct{})
+		for _, fileNames := range workload.Programs {
+			for _, fileName := range fileNames {
+				relinted[fileName] = struct{}{}
+			}
+		}
+		for _, fileName := range workload.UnmatchedFiles {
+			relinted[fileName] = struct{}{}
+		}
+
+		for fileName, diagnostics := range diagnosticsByFile {
+			if _, ok := relinted[fileName]; ok {
+				continue
+			}
+			for _, d := range diagnostics {
+				options.OnRuleDiagnostic(d)
+			}
+		}
+		for _, d := range internalDiagnostics {
+			if d.FilePath != nil {
+				if _, ok := relinted[*d.FilePath]; ok {
+					continue
+				}
+			}
+			// Diagnostics of programs are reported again when they are linted
+			// again.
+			key := internalDiagnosticKey{d.Range, d.Id, d.Description, d.Help, ""}
+			if d.FilePath != nil {
+				key.filePath = *d.FilePath
+			}
+			if _, ok := reportedInternal[key]; ok {
+				continue
+			}
+			reportedInternal[key] = struct{}{}
+			options.OnInternalDiagnostic(d)
+		}
+
+		if len(workload.Programs) == 0 && len(workload.UnmatchedFiles) == 0 {
+			return texts, nil
+		}
+	}
+}
+
+type internalDiagnosticKey struct {
+	textRange   core.TextRange
+	id          string
+	description string
+	help        string
+	filePath    string
+}
+
+// Applies the fixes of the diagnostics of several files, reading the files
+// from fs. A file edited by the fix of another file is not fixed itself, since
+// its fixes were computed for its previous text; neither are fixes applied to
+// a file which was already edited. Conflicts between fixes are reported to
+// onFixConflict, if set.
+//
+// Returns the fixed text of every edited file, the diagnostics whose fixes
+// were not applied, and the sorted names of the files with a diagnostic whose
+// fixes were applied.
+func ApplyRuleFixesOfFiles(fs vfs.FS, diagnosticsByFile map[string][]rule.RuleDiagnostic, onFixConflict func(conflict FixConflict[rule.RuleDiagnostic])) (map[string]string, []rule.RuleDiagnostic, []string) {
+	fixedTexts := make(map[string]string)
+	var unapplied []rule.RuleDiagnostic
+	var fixedFileNames []string
+
+	for _, fileName := range slices.Sorted(maps.Keys(diagnosticsByFile)) {
+		diagnostics := diagnosticsByFile[fileName]
+		if _, ok := fixedTexts[fileName]; ok {
+			unapplied = append(unapplied, diagnostics...)
+			continue
+		}
+
+		files := make(map[string]string)
+		readFile := func(name string) {
+			if _, ok := files[name]; ok {
+				return
+			}
+			if _, ok := fixedTexts[name]; ok {
+				return
+			}
+			if text, ok := fs.ReadFile(name); ok {
+				files[name] = text
+			}
+		}
+		readFile(fileName)
+		for _, d := range diagnostics {
+			for _, fix := range d.Fixes() {
+				if fix.FileName != "" {
+					readFile(fix.FileName)
+				}
+			}
+		}
+
+		fixedFiles, fileUnapplied, fixed, conflicts := ApplyRuleFixesToFiles(fileName, files, diagnostics)
+		unapplied = append(unapplied, fileUnapplied...)
+		if fixed {
+			fixedFileNames = append(fixedFileNames, fileName)
+		}
+		if onFixConflict != nil {
+			for _, conflict := range conflicts {
+				onFixConflict(conflict)
+			}
+		}
+		for name, text := range fixedFiles {
+			if text != files[name] {
+				fixedTexts[name] = text
+			}
+		}
+	}
+
+	return fixedTexts, unapplied, fixedFileNames
+}
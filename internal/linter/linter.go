@@ -3,7 +3,8 @@ package linter
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,6 +26,8 @@ import (
 type ConfiguredRule struct {
 	Name string
 	Run  func(ctx rule.RuleContext) rule.RuleListeners
+	// Optional, see `rule.Rule.RunOnProgram`.
+	RunOnProgram func() rule.ProgramListeners
 }
 
 type Workload struct {
@@ -35,6 +38,31 @@ type Workload struct {
 type Fixes struct {
 	Fix            bool
 	FixSuggestions bool
+	// Kinds of fixes and suggestions to generate. All kinds are generated if
+	// empty.
+	Kinds []rule.RuleFixKind
+}
+
+func (f Fixes) allows(fixes []rule.RuleFix) bool {
+	return len(f.Kinds) == 0 || slices.Contains(f.Kinds, rule.RuleFixesKind(fixes))
+}
+
+// Drops fixes whose kind is not allowed. The fixes of a diagnostic are applied
+// together, so they are kept or dropped as a whole.
+func (f Fixes) filterFixes(fixes []rule.RuleFix) []rule.RuleFix {
+	if !f.allows(fixes) {
+		return nil
+	}
+	return fixes
+}
+
+func (f Fixes) filterSuggestions(suggestions []rule.RuleSuggestion) []rule.RuleSuggestion {
+	if len(f.Kinds) == 0 {
+		return suggestions
+	}
+	return slices.DeleteFunc(suggestions, func(s rule.RuleSuggestion) bool {
+		return !f.allows(s.FixesArr)
+	})
 }
 
 type TypeErrors struct {
@@ -49,7 +77,6 @@ type checkerWorkload struct {
 }
 
 type RunLinterOptions struct {
-	LogLevel                   utils.LogLevel
 	CurrentDirectory           string
 	Workload                   Workload
 	Workers                    int
@@ -61,11 +88,25 @@ type RunLinterOptions struct {
 	TypeErrors                 TypeErrors
 	SuppressProgramDiagnostics bool
 	TimingStore                *RuleTimingStore
+	// Optional
+	TraceEvents *TraceEvents
+	// Drop diagnostics suppressed by `eslint-disable` comments and report
+	// the comments that did not suppress anything.
+	ReportUnusedDisableDirectives bool
+	// Type-check the fixed files of each program and revert fixes which
+	// introduce new type errors. Diagnostics are held back until their
+	// program is verified.
+	VerifyFixes bool
+	// Called with the fixes which overlap others, computed for each program
+	// from its fixable diagnostics once it is linted. With `RunLinterWithFixes`,
+	// only the conflicts remaining after the last pass. Optional.
+	OnFixConflict func(conflict FixConflict[rule.RuleDiagnostic])
+	// Compiler options of the program of `Workload.UnmatchedFiles`. Optional.
+	InferredProjectOptions *utils.InferredProjectOptions
 }
 
 // This is same as `RunLinterOptions` but for a single program.
 type RunLinterOnProgramOptions struct {
-	LogLevel             utils.LogLevel
 	Program              *compiler.Program
 	Files                []*ast.SourceFile
 	Workers              int
@@ -75,10 +116,21 @@ type RunLinterOnProgramOptions struct {
 	Fixes                Fixes
 	TypeErrors           TypeErrors
 	TimingStore          *RuleTimingStore
+	// Optional
+	TraceEvents *TraceEvents
+	// Time spent creating Program, recorded in TimingStore and TraceEvents.
+	// Optional.
+	ProgramTimings utils.ProgramTimings
+	// Drop diagnostics suppressed by `eslint-disable` comments and report
+	// the comments that did not suppress anything.
+	ReportUnusedDisableDirectives bool
+	// Type-check the fixed files of each program and revert fixes which
+	// introduce new type errors. Diagnostics are held back until their
+	// program is verified.
+	VerifyFixes bool
 }
 
 func RunLinter(options RunLinterOptions) error {
-	logLevel := options.LogLevel
 	currentDirectory := options.CurrentDirectory
 	workload := options.Workload
 	workers := options.Workers
@@ -90,17 +142,21 @@ func RunLinter(options RunLinterOptions) error {
 	typeErrors := options.TypeErrors
 	suppressProgramDiagnostics := options.SuppressProgramDiagnostics
 	timingStore := options.TimingStore
+	traceEvents := options.TraceEvents
+	reportUnusedDisableDirectives := options.ReportUnusedDisableDirectives
+	verifyFixes := options.VerifyFixes
+
+	logger := utils.Logger(utils.LogSubsystemProgram)
 
 	idx := 0
 	for configFileName, filePaths := range workload.Programs {
-		if logLevel == utils.LogLevelDebug {
-			log.Printf("[%d/%d] Running linter on program: %s", idx+1, len(workload.Programs), configFileName)
-		}
+		logger.Info("creating program", "tsconfig", configFileName, "index", idx+1, "programs", len(workload.Programs), "files", len(filePaths))
 
 		currentDirectory := tspath.GetDirectoryPath(configFileName)
 		host := utils.NewCachedFSCompilerHost(currentDirectory, fs, bundled.LibPath(), nil, nil)
 
-		program, diagnostics, err := utils.CreateProgram(false, fs, currentDirectory, configFileName, host, suppressProgramDiagnostics)
+		var programTimings utils.ProgramTimings
+		program, diagnostics, err := utils.CreateProgramWithTimings(false, fs, currentDirectory, configFileName, host, suppressProgramDiagnostics, &programTimings)
 
 		if err != nil {
 			return err
@@ -114,9 +170,7 @@ func RunLinter(options RunLinterOptions) error {
 			continue
 		}
 
-		if logLevel == utils.LogLevelDebug {
-			log.Printf("Program created with %d source files", len(program.GetSourceFiles()))
-		}
+		logger.Debug("program created", "tsconfig", configFileName, "sourceFiles", len(program.GetSourceFiles()), "create", programTimings.Create, "bind", programTimings.Bind)
 
 		fileSet := make(map[string]struct{}, len(filePaths))
 		for _, f := range filePaths {
@@ -137,39 +191,45 @@ func RunLinter(options RunLinterOptions) error {
 				unmatchedFiles = append(unmatchedFiles, k)
 			}
 			unmatchedFilesString := strings.Join(unmatchedFiles, ", ")
-			log.Println("Unmatched files found:", unmatchedFilesString)
 
 			var programFiles []string
 			for _, k := range program.SourceFiles() {
 				programFiles = append(programFiles, k.FileName())
 			}
-			log.Printf("Program source files (%d): %s", len(programFiles), strings.Join(programFiles, ", "))
+			logger.Error("files not found in program", "tsconfig", configFileName, "files", unmatchedFiles, "sourceFiles", programFiles)
 
 			panic(fmt.Sprintf("Expected file '%s' to be in program '%s'", unmatchedFilesString, configFileName))
 		}
 
+		onDiagnostic, reportFixConflicts := collectFixConflicts(fs, onRuleDiagnostic, options.OnFixConflict)
 		err = RunLinterOnProgram(RunLinterOnProgramOptions{
-			LogLevel:             logLevel,
-			Program:              program,
-			Files:                sourceFiles,
-			Workers:              workers,
-			GetRulesForFile:      getRulesForFile,
-			OnDiagnostic:         onRuleDiagnostic,
-			OnInternalDiagnostic: onInternalDiagnostic,
-			Fixes:                fixState,
-			TypeErrors:           typeErrors,
-			TimingStore:          timingStore,
+			Program:                       program,
+			Files:                         sourceFiles,
+			Workers:                       workers,
+			GetRulesForFile:               getRulesForFile,
+			OnDiagnostic:                  onDiagnostic,
+			OnInternalDiagnostic:          onInternalDiagnostic,
+			Fixes:                         fixState,
+			TypeErrors:                    typeErrors,
+			TimingStore:                   timingStore,
+			TraceEvents:                   traceEvents,
+			ProgramTimings:                programTimings,
+			ReportUnusedDisableDirectives: reportUnusedDisableDirectives,
+			VerifyFixes:                   verifyFixes,
 		})
 		if err != nil {
 			return err
 		}
+		reportFixConflicts()
 
 		idx++
 	}
 
 	{
+		logger.Info("creating inferred program", "files", len(workload.UnmatchedFiles))
 		host := utils.NewCachedFSCompilerHost(currentDirectory, fs, bundled.LibPath(), nil, nil)
-		program, diagnostics, err := utils.CreateInferredProjectProgram(false, fs, currentDirectory, host, workload.UnmatchedFiles)
+		var programTimings utils.ProgramTimings
+		program, diagnostics, err := utils.CreateInferredProjectProgramWithTimings(false, fs, currentDirectory, host, workload.UnmatchedFiles, options.InferredProjectOptions, &programTimings)
 
 		if err != nil {
 			return err
@@ -190,21 +250,26 @@ func RunLinter(options RunLinterOptions) error {
 			files = append(files, sf)
 		}
 
+		onDiagnostic, reportFixConflicts := collectFixConflicts(fs, onRuleDiagnostic, options.OnFixConflict)
 		err = RunLinterOnProgram(RunLinterOnProgramOptions{
-			LogLevel:             logLevel,
-			Program:              program,
-			Files:                files,
-			Workers:              workers,
-			GetRulesForFile:      getRulesForFile,
-			OnDiagnostic:         onRuleDiagnostic,
-			OnInternalDiagnostic: onInternalDiagnostic,
-			Fixes:                fixState,
-			TypeErrors:           typeErrors,
-			TimingStore:          timingStore,
+			Program:                       program,
+			Files:                         files,
+			Workers:                       workers,
+			GetRulesForFile:               getRulesForFile,
+			OnDiagnostic:                  onDiagnostic,
+			OnInternalDiagnostic:          onInternalDiagnostic,
+			Fixes:                         fixState,
+			TypeErrors:                    typeErrors,
+			TimingStore:                   timingStore,
+			TraceEvents:                   traceEvents,
+			ProgramTimings:                programTimings,
+			ReportUnusedDisableDirectives: reportUnusedDisableDirectives,
+			VerifyFixes:                   verifyFixes,
 		})
 		if err != nil {
 			return err
 		}
+		reportFixConflicts()
 	}
 
 	return nil
@@ -222,6 +287,46 @@ type ruleContextBuilder struct {
 	checker      *checker.Checker
 	fixState     Fixes
 	onDiagnostic func(rule.RuleDiagnostic)
+	// Only used to report dropped fixes
+	onInternalDiagnostic func(d diagnostic.Internal)
+	// nil unless unused disable directives are reported and the current file has any
+	directives *disableDirectives
+	// Diagnostics of the current file, held back until its fixes are verified
+	pending []rule.RuleDiagnostic
+	// Code paths of the current file analyzed so far
+	codePaths map[*ast.Node]*rule.CodePath
+	// nil until a rule needs the scopes of the current file
+	scopeManager *rule.ScopeManager
+	typeCache    *rule.TypeCache
+	// Logger of the rules subsystem, fetched once per builder
+	logger *slog.Logger
+}
+
+// Moves the builder to the next file.
+func (b *ruleContextBuilder) setFile(file *ast.SourceFile) {
+	b.file = file
+	clear(b.codePaths)
+	b.scopeManager = nil
+	b.typeCache.Reset(b.program, b.checker)
+}
+
+func (b *ruleContextBuilder) scope() *rule.ScopeManager {
+	if b.scopeManager == nil {
+		b.scopeManager = rule.NewScopeManager(b.file, b.checker)
+	}
+	return b.scopeManager
+}
+
+func (b *ruleContextBuilder) codePath(node *ast.Node) *rule.CodePath {
+	if path, ok := b.codePaths[node]; ok {
+		return path
+	}
+	path := rule.AnalyzeCodePath(node)
+	if b.codePaths == nil {
+		b.codePaths = make(map[*ast.Node]*rule.CodePath)
+	}
+	b.codePaths[node] = path
+	return path
 }
 
 // Calls `onDiagnostic` with the given diagnostic's information, but sets the
@@ -229,13 +334,77 @@ type ruleContextBuilder struct {
 func (b *ruleContextBuilder) emitDiagnostic(d rule.RuleDiagnostic) {
 	d.RuleName = b.ruleName
 	d.SourceFile = b.file
+	if d.FixesPtr != nil {
+		localizeFixes(b.file.FileName(), *d.FixesPtr)
+	}
+	for _, suggestion := range d.GetSuggestions() {
+		localizeFixes(b.file.FileName(), suggestion.FixesArr)
+	}
+	suppressed := b.directives != nil && b.directives.suppresses(d)
+	if b.logger.Enabled(context.Background(), utils.LogLevelDebug) {
+		b.logger.Debug("diagnostic", "rule", d.RuleName, "message", d.Message.Id, "file", b.file.FileName(), "pos", d.Range.Pos(), "suppressed", suppressed)
+	}
+	if suppressed {
+		return
+	}
+	b.report(d)
+}
+
+// Clears the file name of fixes editing the reported file, which is implied.
+func localizeFixes(fileName string, fixes []rule.RuleFix) {
+	for i := range fixes {
+		if fixes[i].FileName == fileName {
+			fixes[i].FileName = ""
+		}
+	}
+}
+
+func (b *ruleContextBuilder) report(d rule.RuleDiagnostic) {
+	if b.fixState.Fix {
+		b.pending = append(b.pending, d)
+		return
+	}
 	b.onDiagnostic(d)
 }
 
+// Emits the diagnostics held back for the current file, without the fixes
+// that would make it unparseable. Must be called after all rules ran on the file.
+func (b *ruleContextBuilder) flushDiagnostics() {
+	if len(b.pending) == 0 {
+		return
+	}
+	verifyFixesSyntax(b.program, b.file, b.pending, b.onInternalDiagnostic)
+	for _, d := range b.pending {
+		b.onDiagnostic(d)
+	}
+	clear(b.pending)
+	b.pending = b.pending[:0]
+}
+
+// Parses the disable directives of the current file. Must be called before
+// any rule runs on the file.
+func (b *ruleContextBuilder) loadDisableDirectives(rules []ConfiguredRule) {
+	enabledRules := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		enabledRules[r.Name] = struct{}{}
+	}
+	b.directives = parseDisableDirectives(b.file, enabledRules)
+}
+
+// Reports the unused directives of the current file, unless they were taken
+// over by `programRules.collectFile`.
+func (b *ruleContextBuilder) reportUnusedDisableDirectives() {
+	if b.directives == nil {
+		return
+	}
+	b.directives.reportUnused(b.fixState.Fix, b.report)
+	b.directives = nil
+}
+
 func (b *ruleContextBuilder) reportDiagnosticWithFixes(d rule.RuleDiagnostic, fixesFn func() []rule.RuleFix) {
 	var fixes []rule.RuleFix
 	if b.fixState.Fix {
-		fixes = fixesFn()
+		fixes = b.fixState.filterFixes(fixesFn())
 	}
 	d.FixesPtr = &fixes
 	b.emitDiagnostic(d)
@@ -244,7 +413,7 @@ func (b *ruleContextBuilder) reportDiagnosticWithFixes(d rule.RuleDiagnostic, fi
 func (b *ruleContextBuilder) reportDiagnosticWithSuggestions(d rule.RuleDiagnostic, suggestionsFn func() []rule.RuleSuggestion) {
 	var suggestions []rule.RuleSuggestion
 	if b.fixState.FixSuggestions {
-		suggestions = suggestionsFn()
+		suggestions = b.fixState.filterSuggestions(suggestionsFn())
 	}
 	d.Suggestions = &suggestions
 	b.emitDiagnostic(d)
@@ -260,7 +429,7 @@ func (b *ruleContextBuilder) reportRange(textRange core.TextRange, msg rule.Rule
 func (b *ruleContextBuilder) reportRangeWithSuggestions(textRange core.TextRange, msg rule.RuleMessage, suggestionsFn func() []rule.RuleSuggestion) {
 	var suggestions []rule.RuleSuggestion
 	if b.fixState.FixSuggestions {
-		suggestions = suggestionsFn()
+		suggestions = b.fixState.filterSuggestions(suggestionsFn())
 	}
 	b.emitDiagnostic(rule.RuleDiagnostic{
 		Range:       textRange,
@@ -279,7 +448,7 @@ func (b *ruleContextBuilder) reportNode(node *ast.Node, msg rule.RuleMessage) {
 func (b *ruleContextBuilder) reportNodeWithFixes(node *ast.Node, msg rule.RuleMessage, fixesFn func() []rule.RuleFix) {
 	var fixes []rule.RuleFix
 	if b.fixState.Fix {
-		fixes = fixesFn()
+		fixes = b.fixState.filterFixes(fixesFn())
 	}
 	b.emitDiagnostic(rule.RuleDiagnostic{
 		Range:    utils.TrimNodeTextRange(b.file, node),
@@ -291,7 +460,7 @@ func (b *ruleContextBuilder) reportNodeWithFixes(node *ast.Node, msg rule.RuleMe
 func (b *ruleContextBuilder) reportNodeWithSuggestions(node *ast.Node, msg rule.RuleMessage, suggestionsFn func() []rule.RuleSuggestion) {
 	var suggestions []rule.RuleSuggestion
 	if b.fixState.FixSuggestions {
-		suggestions = suggestionsFn()
+		suggestions = b.fixState.filterSuggestions(suggestionsFn())
 	}
 	b.emitDiagnostic(rule.RuleDiagnostic{
 		Range:       utils.TrimNodeTextRange(b.file, node),
@@ -302,6 +471,9 @@ func (b *ruleContextBuilder) reportNodeWithSuggestions(node *ast.Node, msg rule.
 
 func newRuleContext(ctxBuilder *ruleContextBuilder) rule.RuleContext {
 	return rule.RuleContext{
+		CodePath:                        ctxBuilder.codePath,
+		Scope:                           ctxBuilder.scope,
+		Types:                           ctxBuilder.typeCache,
 		ReportDiagnostic:                ctxBuilder.emitDiagnostic,
 		ReportDiagnosticWithFixes:       ctxBuilder.reportDiagnosticWithFixes,
 		ReportDiagnosticWithSuggestions: ctxBuilder.reportDiagnosticWithSuggestions,
@@ -365,6 +537,10 @@ func reportTypeScriptDiagnostics(program *compiler.Program, files []*ast.SourceF
 	}
 }
 
+func hasCodePathListeners[L any](registeredListeners map[ast.Kind][]L) bool {
+	return len(registeredListeners[rule.ListenerOnCodePathStart]) > 0 || len(registeredListeners[rule.ListenerOnCodePathEnd]) > 0
+}
+
 func makeSourceFileQueue(files []*ast.SourceFile) chan *ast.SourceFile {
 	queue := make(chan *ast.SourceFile, len(files))
 	for _, file := range files {
@@ -392,7 +568,9 @@ func makeCheckerWorkloadQueue(program *compiler.Program, files []*ast.SourceFile
 	return workloadQueue
 }
 
-func visitLintNodes(file *ast.SourceFile, runListeners func(kind ast.Kind, node *ast.Node)) {
+// Code path listeners are only looked for when codePaths is set, i.e. when a
+// rule registered ListenerOnCodePathStart or ListenerOnCodePathEnd.
+func visitLintNodes(file *ast.SourceFile, codePaths bool, runListeners func(kind ast.Kind, node *ast.Node)) {
 	/* convert.ts -> allowPattern:
 	catch name
 	variabledeclaration name
@@ -407,10 +585,23 @@ func visitLintNodes(file *ast.SourceFile, runListeners func(kind ast.Kind, node
 	(init) binaryexpression(with '=' operator') left
 	*/
 
+	enter := func(node *ast.Node) {
+		runListeners(node.Kind, node)
+		if codePaths && rule.IsCodePathNode(node) {
+			runListeners(rule.ListenerOnCodePathStart, node)
+		}
+	}
+	exit := func(node *ast.Node) {
+		if codePaths && rule.IsCodePathNode(node) {
+			runListeners(rule.ListenerOnCodePathEnd, node)
+		}
+		runListeners(rule.ListenerOnExit(node.Kind), node)
+	}
+
 	var childVisitor ast.Visitor
 	var patternVisitor func(node *ast.Node)
 	patternVisitor = func(node *ast.Node) {
-		runListeners(node.Kind, node)
+		enter(node)
 		kind := rule.ListenerOnAllowPattern(node.Kind)
 		runListeners(kind, node)
 
@@ -432,10 +623,10 @@ func visitLintNodes(file *ast.SourceFile, runListeners func(kind ast.Kind, node
 		}
 
 		runListeners(rule.ListenerOnExit(kind), node)
-		runListeners(rule.ListenerOnExit(node.Kind), node)
+		exit(node)
 	}
 	childVisitor = func(node *ast.Node) bool {
-		runListeners(node.Kind, node)
+		enter(node)
 
 		switch node.Kind {
 		case ast.KindArrayLiteralExpression, ast.KindObjectLiteralExpression:
@@ -454,15 +645,16 @@ func visitLintNodes(file *ast.SourceFile, runListeners func(kind ast.Kind, node
 			}
 		}
 
-		runListeners(rule.ListenerOnExit(node.Kind), node)
+		exit(node)
 
 		return false
 	}
+	runListeners(rule.ListenerOnCodePathStart, file.AsNode())
 	file.Node.ForEachChild(childVisitor)
+	runListeners(rule.ListenerOnCodePathEnd, file.AsNode())
 }
 
 func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
-	logLevel := options.LogLevel
 	program := options.Program
 	files := options.Files
 	workers := options.Workers
@@ -472,16 +664,47 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 	fixState := options.Fixes
 	typeErrors := options.TypeErrors
 	timingStore := options.TimingStore
+	traceEvents := options.TraceEvents
+	reportUnusedDisableDirectives := options.ReportUnusedDisableDirectives
+
+	if traceEvents != nil {
+		if timingStore == nil {
+			// Hot spots are found by the timed linting
+			timingStore = NewRuleTimingStore()
+		}
+		name := programName(program)
+		if programTimings := options.ProgramTimings; !programTimings.Start.IsZero() {
+			traceEvents.Span(TraceMainThread, "program", "create "+name, programTimings.Start, programTimings.Create, nil)
+			traceEvents.Span(TraceMainThread, "program", "bind "+name, programTimings.Start.Add(programTimings.Create), programTimings.Bind, nil)
+		}
+		defer traceEvents.Begin(TraceMainThread, "program", "lint "+name, map[string]any{"files": len(files)})()
+	}
+
+	var fixVerifier *fixTypeVerifier
+	if options.VerifyFixes && fixState.Fix {
+		fixVerifier = newFixTypeVerifier(program)
+		onDiagnostic = fixVerifier.add
+	}
+
+	logger := utils.Logger(utils.LogSubsystemLinter)
+	traceFiles := logger.Enabled(context.Background(), utils.LogLevelTrace)
+	logger.Debug("linting program", "program", programName(program), "files", len(files), "workers", workers)
 
 	reportTypeScriptDiagnostics(program, files, typeErrors, onInternalDiagnostic)
 	workloadQueue := makeCheckerWorkloadQueue(program, files)
+	programRules := newProgramRules(program)
+	// Per worker, only used with timingStore
+	checkDurations := make([]time.Duration, workers)
 
 	wg := core.NewWorkGroup(workers == 1)
-	for range workers {
+	for workerIdx := range workers {
 		wg.Queue(func() {
 			ctxBuilder := &ruleContextBuilder{
-				fixState:     fixState,
-				onDiagnostic: onDiagnostic,
+				fixState:             fixState,
+				onDiagnostic:         onDiagnostic,
+				onInternalDiagnostic: onInternalDiagnostic,
+				typeCache:            rule.NewTypeCache(),
+				logger:               utils.Logger(utils.LogSubsystemRules),
 			}
 
 			// These closures remain valid for the length of linting, as we mutate the fields
@@ -504,13 +727,16 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 					ctx.TypeChecker = w.checker
 
 					for file := range w.queue {
-						if logLevel == utils.LogLevelDebug {
-							log.Print(file.FileName())
+						if traceFiles {
+							utils.LogTrace(logger, "linting file", "file", file.FileName(), "worker", workerIdx+1)
 						}
-						ctxBuilder.file = file
+						ctxBuilder.setFile(file)
 						ctx.SourceFile = file
 
 						rules := getRulesForFile(file)
+						if reportUnusedDisableDirectives {
+							ctxBuilder.loadDisableDirectives(rules)
+						}
 						for _, r := range rules {
 							ctxBuilder.ruleName = r.Name
 							for kind, listener := range r.Run(ctx) {
@@ -531,7 +757,10 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 							}
 						}
 
-						visitLintNodes(file, runListeners)
+						visitLintNodes(file, hasCodePathListeners(registeredListeners), runListeners)
+						programRules.collectFile(rules, ctxBuilder, ctx)
+						ctxBuilder.reportUnusedDisableDirectives()
+						ctxBuilder.flushDiagnostics()
 						// Instead of clearing the map, we clear the slices in-place to avoid re-allocating memory for the listeners on each file.
 						for k := range registeredListeners {
 							registeredListeners[k] = registeredListeners[k][:0]
@@ -549,9 +778,14 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 			}
 			registeredListeners := make(map[ast.Kind][]timedTaggedListener, 20)
 			localTimings := make(map[string]RuleTimingStat, 64)
+			var fileTimings []FileTimingRecord
+			ctxBuilder.typeCache.EnableTiming()
+			thread := workerIdx + 1
+			traceEvents.nameWorker(thread)
 
 			recordTiming := func(stat *RuleTimingStat, duration time.Duration) {
 				stat.Duration += duration
+				stat.CheckerDuration += ctxBuilder.typeCache.TakeCheckerTime()
 				stat.Calls++
 			}
 
@@ -562,19 +796,34 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 				ctx.TypeChecker = w.checker
 
 				for file := range w.queue {
-					if logLevel == utils.LogLevelDebug {
-						log.Print(file.FileName())
+					if traceFiles {
+						utils.LogTrace(logger, "linting file", "file", file.FileName(), "worker", workerIdx+1)
 					}
-					ctxBuilder.file = file
+					fileStart := time.Now()
+					ctxBuilder.setFile(file)
 					ctx.SourceFile = file
 
+					// Check the file upfront, so that the checker work is not attributed to the
+					// first rule querying a type of the file.
+					w.checker.CheckSourceFile(context.Background(), file)
+					checkDuration := time.Since(fileStart)
+					checkDurations[workerIdx] += checkDuration
+					traceEvents.Span(thread, "file", "check", fileStart, checkDuration, nil)
+
 					rules := getRulesForFile(file)
+					if reportUnusedDisableDirectives {
+						ctxBuilder.loadDisableDirectives(rules)
+					}
 					timingStats := make([]RuleTimingStat, len(rules))
 					for ruleIdx, r := range rules {
 						ctxBuilder.ruleName = r.Name
 						start := time.Now()
 						listenersByKind := r.Run(ctx)
-						recordTiming(&timingStats[ruleIdx], time.Since(start))
+						duration := time.Since(start)
+						recordTiming(&timingStats[ruleIdx], duration)
+						if traceEvents != nil && duration >= TraceHotSpotThreshold {
+							traceEvents.Span(thread, "rule", r.Name, start, duration, map[string]any{"listener": "Run"})
+						}
 						for kind, listener := range listenersByKind {
 							listeners, ok := registeredListeners[kind]
 							if !ok {
@@ -590,12 +839,21 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 								ctxBuilder.ruleName = listener.ruleName
 								start := time.Now()
 								listener.fn(node)
-								recordTiming(&timingStats[listener.ruleIdx], time.Since(start))
+								duration := time.Since(start)
+								recordTiming(&timingStats[listener.ruleIdx], duration)
+								if traceEvents != nil && duration >= TraceHotSpotThreshold {
+									traceEvents.Span(thread, "rule", listener.ruleName, start, duration, map[string]any{"listener": listenerName(kind), "pos": node.Pos()})
+								}
 							}
 						}
 					}
 
-					visitLintNodes(file, runListeners)
+					visitLintNodes(file, hasCodePathListeners(registeredListeners), runListeners)
+					for ruleIdx, stat := range programRules.collectFile(rules, ctxBuilder, ctx) {
+						timingStats[ruleIdx].add(stat)
+					}
+					ctxBuilder.reportUnusedDisableDirectives()
+					ctxBuilder.flushDiagnostics()
 					for idx, stat := range timingStats {
 						if stat.Calls == 0 {
 							continue
@@ -608,13 +866,42 @@ func RunLinterOnProgram(options RunLinterOnProgramOptions) error {
 					for k := range registeredListeners {
 						registeredListeners[k] = registeredListeners[k][:0]
 					}
+					fileDuration := time.Since(fileStart)
+					fileTimings = append(fileTimings, FileTimingRecord{
+						FileName:        file.FileName(),
+						Duration:        fileDuration,
+						CheckerDuration: checkDuration,
+					})
+					traceEvents.Span(thread, "file", file.FileName(), fileStart, fileDuration, nil)
 				}
 			}
 
 			timingStore.merge(localTimings)
+			timingStore.mergeFiles(fileTimings)
+			timingStore.mergeTypeCacheStats(ctxBuilder.typeCache.Stats())
 		})
 	}
 	wg.RunAndWait()
 
+	programRules.finish(fixState, reportUnusedDisableDirectives, onDiagnostic, onInternalDiagnostic, timingStore)
+
+	if timingStore != nil {
+		var checkDuration time.Duration
+		for _, d := range checkDurations {
+			checkDuration += d
+		}
+		timingStore.addProgram(ProgramTimingRecord{
+			ConfigFileName: program.Options().ConfigFilePath,
+			Files:          len(files),
+			Create:         options.ProgramTimings.Create,
+			Bind:           options.ProgramTimings.Bind,
+			Check:          checkDuration,
+		})
+	}
+
+	if fixVerifier != nil {
+		fixVerifier.verify(options.OnDiagnostic, onInternalDiagnostic)
+	}
+
 	return nil
 }
@@ -1,12 +1,15 @@
 package linter
 
 import (
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/tspath"
 	"github.com/microsoft/typescript-go/shim/vfs/cachedvfs"
 	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
@@ -56,10 +59,9 @@ function greet(name: string): string {
 	var diagnostics []rule.RuleDiagnostic
 
 	err = RunLinterOnProgram(RunLinterOnProgramOptions{
-		LogLevel: utils.LogLevelNormal,
-		Program:  program,
-		Files:    sourceFiles,
-		Workers:  1,
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
 		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
 			return []ConfiguredRule{
 				{
@@ -139,10 +141,9 @@ function add(a: number, b: number): number {
 	var diagnostics []rule.RuleDiagnostic
 
 	err = RunLinterOnProgram(RunLinterOnProgramOptions{
-		LogLevel: utils.LogLevelNormal,
-		Program:  program,
-		Files:    sourceFiles,
-		Workers:  1,
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
 		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
 			return []ConfiguredRule{
 				{
@@ -228,10 +229,9 @@ func TestRunLinterOnProgram_DiagnosticsEmittedInRun(t *testing.T) {
 	var diagnostics []rule.RuleDiagnostic
 
 	err = RunLinterOnProgram(RunLinterOnProgramOptions{
-		LogLevel: utils.LogLevelNormal,
-		Program:  program,
-		Files:    sourceFiles,
-		Workers:  1,
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
 		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
 			return []ConfiguredRule{
 				{
@@ -311,10 +311,9 @@ function greet() {
 
 	timingStore := NewRuleTimingStore()
 	err = RunLinterOnProgram(RunLinterOnProgramOptions{
-		LogLevel: utils.LogLevelNormal,
-		Program:  program,
-		Files:    sourceFiles,
-		Workers:  1,
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
 		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
 			return []ConfiguredRule{
 				{
@@ -332,7 +331,8 @@ function greet() {
 					Name: ruleB,
 					Run: func(ctx rule.RuleContext) rule.RuleListeners {
 						return rule.RuleListeners{
-							ast.KindFunctionDeclaration: func(*ast.Node) {
+							ast.KindFunctionDeclaration: func(node *ast.Node) {
+								ctx.Types.GetTypeAtLocation(node.Name())
 								time.Sleep(time.Microsecond)
 							},
 						}
@@ -367,4 +367,810 @@ function greet() {
 	assert.Equal(t, recordsByRule[ruleA].Calls, uint64(2), "rule A should count Run plus its variable listener")
 	assert.Equal(t, recordsByRule[ruleB].Calls, uint64(2), "rule B should count Run plus its function listener")
 	assert.Equal(t, recordsByRule[ruleC].Calls, uint64(1), "rule C should count its Run call")
+
+	assert.Equal(t, recordsByRule[ruleA].CheckerDuration, time.Duration(0), "rule A makes no type queries")
+	assert.Assert(t, recordsByRule[ruleB].CheckerDuration > 0, "rule B queries a type")
+	assert.Assert(t, recordsByRule[ruleB].CheckerDuration < recordsByRule[ruleB].Duration, "checker time is part of the rule time")
+
+	files := timingStore.CollectFiles()
+	assert.Equal(t, len(files), 1)
+	assert.Equal(t, files[0].FileName, filePath)
+	assert.Assert(t, files[0].CheckerDuration < files[0].Duration, "checker time is part of the file time")
+
+	programs := timingStore.CollectPrograms()
+	assert.Equal(t, len(programs), 1)
+	assert.Equal(t, programs[0].Files, 1)
+	assert.Equal(t, programs[0].Check, files[0].CheckerDuration)
+}
+
+func TestRunLinterOnProgram_UnusedDisableDirectives(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := "file.ts"
+	filePath := tspath.ResolvePath(rootDir, fileName)
+	code := `// eslint-disable-next-line @typescript-eslint/no-variables
+const x = 1;
+const y = 2; // eslint-disable-line @typescript-eslint/no-variables -- known issue
+// eslint-disable-next-line @typescript-eslint/no-variables
+function a() {}
+// eslint-disable-next-line no-console, @typescript-eslint/no-variables
+function b() {}
+// eslint-disable-next-line @typescript-eslint/unknown-rule
+function c() {}
+`
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: code},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	sourceFiles := []*ast.SourceFile{program.GetSourceFile(filePath)}
+
+	const ruleName = "no-variables"
+	msg := rule.RuleMessage{
+		Id:          "noVariable",
+		Description: "Found a variable statement",
+	}
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: ruleName,
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								ctx.ReportNode(node, msg)
+							},
+						}
+					},
+				},
+			}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic:          func(d diagnostic.Internal) {},
+		Fixes:                         Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:                    TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		ReportUnusedDisableDirectives: true,
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	// Both variable statements are suppressed, the directives above `a` and `b` are unused,
+	// and the directive naming an unknown rule is left alone.
+	assert.Equal(t, len(diagnostics), 2, "expected exactly two diagnostics")
+	for i, d := range diagnostics {
+		assert.Equal(t, d.RuleName, UnusedDisableDirectiveRuleName, "diagnostic %d should be an unused directive", i)
+		assert.Equal(t, d.Message.Id, "unusedDisableDirective", "diagnostic %d should have the unused directive message", i)
+	}
+
+	fixed, _, _ := ApplyRuleFixes(code, diagnostics)
+	assert.Equal(t, fixed, `// eslint-disable-next-line @typescript-eslint/no-variables
+const x = 1;
+const y = 2; // eslint-disable-line @typescript-eslint/no-variables -- known issue
+function a() {}
+// eslint-disable-next-line no-console
+function b() {}
+// eslint-disable-next-line @typescript-eslint/unknown-rule
+function c() {}
+`)
+}
+
+func TestRunLinterOnProgram_UnusedDisableDirectivesOfProgramRules(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `// eslint-disable-next-line @typescript-eslint/program-variables
+const x = 1;
+// eslint-disable-next-line @typescript-eslint/program-variables
+function a() {}
+`
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: code},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	sourceFiles := []*ast.SourceFile{program.GetSourceFile(filePath)}
+
+	msg := rule.RuleMessage{
+		Id:          "noVariable",
+		Description: "Found a variable statement",
+	}
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: "program-variables",
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					return rule.RuleListeners{}
+				},
+				RunOnProgram: func() rule.ProgramListeners {
+					return rule.ProgramListeners{
+						OnProgramExit: func(ctx rule.ProgramContext, collected map[*ast.SourceFile]any) {
+							for _, file := range ctx.Files {
+								for _, statement := range file.Statements.Nodes {
+									if ast.IsVariableStatement(statement) {
+										ctx.ReportNode(file, statement, msg)
+									}
+								}
+							}
+						},
+					}
+				},
+			}}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic:          func(d diagnostic.Internal) {},
+		Fixes:                         Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:                    TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		ReportUnusedDisableDirectives: true,
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	// The directive suppressing the program rule's diagnostic is used, only the
+	// one above `a` is reported.
+	assert.Equal(t, len(diagnostics), 1, "expected exactly one diagnostic")
+	assert.Equal(t, diagnostics[0].RuleName, UnusedDisableDirectiveRuleName)
+
+	fixed, _, _ := ApplyRuleFixes(code, diagnostics)
+	assert.Equal(t, fixed, `// eslint-disable-next-line @typescript-eslint/program-variables
+const x = 1;
+function a() {}
+`)
+}
+
+func TestRunLinterOnProgram_DropsFixesWithSyntaxErrors(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := "file.ts"
+	filePath := tspath.ResolvePath(rootDir, fileName)
+	code := `const x = 1;
+function greet() {}
+`
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: code},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	sourceFiles := []*ast.SourceFile{program.GetSourceFile(filePath)}
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+	var internalDiagnostics []diagnostic.Internal
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: "broken-fix",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "broken", Description: "broken"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "(")}
+								})
+							},
+						}
+					},
+				},
+				{
+					Name: "valid-fix",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindFunctionDeclaration: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "valid", Description: "valid"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "export ")}
+								})
+							},
+						}
+					},
+				},
+			}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		},
+		Fixes:      Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors: TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 2, "both diagnostics should still be reported")
+	assert.Equal(t, len(internalDiagnostics), 1, "expected the broken fix to be reported")
+	assert.Equal(t, internalDiagnostics[0].Id, InvalidFixDiagnosticId)
+
+	fixed, _, _ := ApplyRuleFixes(code, diagnostics)
+	assert.Equal(t, fixed, `const x = 1;
+export function greet() {}
+`)
+}
+
+func TestRunLinterOnProgram_DropsFixesReplacingSyntaxErrorsOrBreakingOtherFiles(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	fooPath := tspath.ResolvePath(rootDir, "foo.ts")
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{
+			// One syntax error, `')' expected`
+			filePath: "let a = (1;\n",
+			fooPath:  "export const b = 1;\n",
+		},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+	var internalDiagnostics []diagnostic.Internal
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(filePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					// Trades the error for `';' expected`
+					Name: "swaps-error",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "swap", Description: "swap"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(8, 10), "1)")}
+								})
+							},
+						}
+					},
+				},
+				{
+					Name: "breaks-other-file",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "break", Description: "break"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(0, 0), "(").InFile(ctx.Program.GetSourceFile(fooPath))}
+								})
+							},
+						}
+					},
+				},
+			}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {
+			mu.Lock()
+			defer mu.Unlock()
+			internalDiagnostics = append(internalDiagnostics, d)
+		},
+		Fixes:      Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors: TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 2, "both diagnostics should still be reported")
+	for _, d := range diagnostics {
+		assert.Equal(t, len(d.Fixes()), 0, "the fix of %v should be dropped", d.RuleName)
+	}
+	assert.Equal(t, len(internalDiagnostics), 2, "expected both fixes to be reported")
+	for _, d := range internalDiagnostics {
+		assert.Equal(t, d.Id, InvalidFixDiagnosticId)
+	}
+}
+
+func TestRunLinterOnProgram_FiltersFixesByKind(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	fileName := "file.ts"
+	filePath := tspath.ResolvePath(rootDir, fileName)
+	code := `const x = 1;
+function greet() {}
+`
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: code},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	sourceFiles := []*ast.SourceFile{program.GetSourceFile(filePath)}
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: "kinds",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "unsafe", Description: "unsafe"}, func() []rule.RuleFix {
+									return []rule.RuleFix{
+										rule.RuleFixInsertBefore(ctx.SourceFile, node, "export ").WithKind(rule.RuleFixKindSafe),
+										rule.RuleFixInsertAfter(node, "\n").WithKind(rule.RuleFixKindUnsafe),
+									}
+								})
+							},
+							ast.KindFunctionDeclaration: func(node *ast.Node) {
+								ctx.ReportNodeWithSuggestions(node, rule.RuleMessage{Id: "layout", Description: "layout"}, func() []rule.RuleSuggestion {
+									return []rule.RuleSuggestion{
+										{
+											Message:  rule.RuleMessage{Id: "safe", Description: "safe"},
+											FixesArr: []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "export ").WithKind(rule.RuleFixKindSafe)},
+										},
+										{
+											Message:  rule.RuleMessage{Id: "unclassified", Description: "unclassified"},
+											FixesArr: []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "declare ")},
+										},
+										{
+											Message:  rule.RuleMessage{Id: "layout", Description: "layout"},
+											FixesArr: []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "\n").WithKind(rule.RuleFixKindLayout)},
+										},
+									}
+								})
+							},
+						}
+					},
+				},
+			}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: true, FixSuggestions: true, Kinds: []rule.RuleFixKind{rule.RuleFixKindSafe}},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 2)
+	for _, d := range diagnostics {
+		switch d.Message.Id {
+		case "unsafe":
+			assert.Equal(t, len(d.Fixes()), 0, "fixes containing an unsafe fix should be dropped")
+		case "layout":
+			suggestions := d.GetSuggestions()
+			assert.Equal(t, len(suggestions), 1, "unclassified fixes should be treated as unsafe")
+			assert.Equal(t, suggestions[0].Message.Id, "safe")
+		}
+	}
+}
+
+func TestRunLinterWithFixes_AppliesFixesUntilStable(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	configPath := tspath.ResolvePath(rootDir, "tsconfig.minimal.json")
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: "let x = 1;\n"},
+	)
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+	var conflicts []FixConflict[rule.RuleDiagnostic]
+
+	options := RunLinterOptions{
+		CurrentDirectory: rootDir,
+		Workload: Workload{
+			Programs:       map[string][]string{configPath: {filePath}},
+			UnmatchedFiles: []string{},
+		},
+		Workers: 1,
+		FS:      fs,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: "prefer-const",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableStatement: func(node *ast.Node) {
+								if node.AsVariableStatement().DeclarationList.Flags&ast.NodeFlagsConst != 0 {
+									return
+								}
+								text := ctx.SourceFile.Text()[utils.TrimNodeTextRange(ctx.SourceFile, node).Pos():node.End()]
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "const", Description: "const"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "const"+text[len("let"):])}
+								})
+							},
+						}
+					},
+				},
+				{
+					Name: "no-one",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindNumericLiteral: func(node *ast.Node) {
+								if node.Text() != "1" {
+									return
+								}
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "one", Description: "one"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "2")}
+								})
+							},
+						}
+					},
+				},
+			}
+		},
+		OnRuleDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+		OnFixConflict: func(conflict FixConflict[rule.RuleDiagnostic]) {
+			conflicts = append(conflicts, conflict)
+		},
+	}
+	fixedFiles, err := RunLinterWithFixes(options, DefaultMaxFixIterations)
+	assert.NilError(t, err, "unexpected error from RunLinterWithFixes")
+
+	assert.DeepEqual(t, fixedFiles, map[string]string{filePath: "const x = 2;\n"})
+	assert.Equal(t, len(diagnostics), 0, "fixed problems should not be reported")
+	assert.Equal(t, len(conflicts), 0, "conflicts resolved by a later pass should not be reported")
+
+	// With a single pass, the conflicting fix of `no-one` remains
+	fixedFiles, err = RunLinterWithFixes(options, 1)
+	assert.NilError(t, err, "unexpected error from RunLinterWithFixes")
+	assert.Equal(t, len(fixedFiles), 0)
+	assert.Equal(t, len(diagnostics), 2)
+	assert.Equal(t, len(conflicts), 1)
+	assert.Equal(t, conflicts[0].Winner.RuleName, "prefer-const")
+	assert.Equal(t, conflicts[0].Loser.RuleName, "no-one")
+}
+
+func TestRunLinterWithFixes_LintsOtherFilesOfTheProgramAgain(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	fooPath := tspath.ResolvePath(rootDir, "foo.ts")
+	configPath := tspath.ResolvePath(rootDir, "tsconfig.minimal.json")
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{
+			filePath: "export const a: any = 1;\n",
+			fooPath:  "import { a } from './file';\nexport const b = a;\n",
+		},
+	)
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	fixedFiles, err := RunLinterWithFixes(RunLinterOptions{
+		CurrentDirectory: rootDir,
+		Workload: Workload{
+			Programs:       map[string][]string{configPath: {filePath, fooPath}},
+			UnmatchedFiles: []string{},
+		},
+		Workers: 1,
+		FS:      fs,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: "no-explicit-any",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindAnyKeyword: func(node *ast.Node) {
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "any", Description: "any"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "number")}
+								})
+							},
+						}
+					},
+				},
+				{
+					// Type-aware: depends on the type of `a` in file.ts
+					Name: "no-unsafe-assignment",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindVariableDeclaration: func(node *ast.Node) {
+								initializer := node.Initializer()
+								if initializer == nil || !ast.IsIdentifier(initializer) {
+									return
+								}
+								if utils.IsTypeFlagSet(ctx.TypeChecker.GetTypeAtLocation(initializer), checker.TypeFlagsAny) {
+									ctx.ReportNode(node, rule.RuleMessage{Id: "unsafe", Description: "unsafe"})
+								}
+							},
+						}
+					},
+				},
+			}
+		},
+		OnRuleDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	}, DefaultMaxFixIterations)
+	assert.NilError(t, err, "unexpected error from RunLinterWithFixes")
+
+	assert.DeepEqual(t, fixedFiles, map[string]string{filePath: "export const a: number = 1;\n"})
+	assert.Equal(t, len(diagnostics), 0, "the diagnostic of foo.ts is stale once `a` is a number")
+}
+
+func TestRunLinterWithFixes_LintsTheProgramOfFixedDiagnosticsAgain(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	fooPath := tspath.ResolvePath(rootDir, "foo.ts")
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{
+			filePath: "import { b } from './foo';\nexport const a = b;\n",
+			fooPath:  "export const b = 1;\n",
+		},
+	)
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	fixedFiles, err := RunLinterWithFixes(RunLinterOptions{
+		CurrentDirectory: rootDir,
+		Workload: Workload{
+			// The fix of the diagnostic of file.ts only edits foo.ts, which is
+			// linted in another program
+			Programs: map[string][]string{
+				tspath.ResolvePath(rootDir, "tsconfig.minimal.json"):  {filePath},
+				tspath.ResolvePath(rootDir, "tsconfig.unstrict.json"): {fooPath},
+			},
+			UnmatchedFiles: []string{},
+		},
+		Workers: 1,
+		FS:      fs,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{
+				{
+					Name: "annotate-imported-file",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return rule.RuleListeners{
+							ast.KindImportDeclaration: func(node *ast.Node) {
+								foo := ctx.Program.GetSourceFile(fooPath)
+								if strings.HasPrefix(foo.Text(), "// imported\n") {
+									return
+								}
+								ctx.ReportNodeWithFixes(node, rule.RuleMessage{Id: "annotate", Description: "annotate"}, func() []rule.RuleFix {
+									return []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(0, 0), "// imported\n").InFile(foo)}
+								})
+							},
+						}
+					},
+				},
+			}
+		},
+		OnRuleDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: true, FixSuggestions: false},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	}, DefaultMaxFixIterations)
+	assert.NilError(t, err, "unexpected error from RunLinterWithFixes")
+
+	assert.DeepEqual(t, fixedFiles, map[string]string{fooPath: "// imported\nexport const b = 1;\n"})
+	assert.Equal(t, len(diagnostics), 0, "the diagnostic of file.ts is fixed once foo.ts is annotated")
+}
+
+func TestRunLinterOnProgram_ProgramListeners(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	fooPath := tspath.ResolvePath(rootDir, "foo.ts")
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{
+			filePath: `export const a = 1;`,
+			fooPath:  "import { a } from './file';\nexport const b = a;\n",
+		},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	sourceFiles := []*ast.SourceFile{program.GetSourceFile(filePath), program.GetSourceFile(fooPath)}
+
+	unusedExport := rule.RuleMessage{
+		Id:          "unusedExport",
+		Description: "Export is never imported",
+	}
+	type fileInfo struct {
+		exports map[string]*ast.Node
+		imports []string
+	}
+
+	var mu sync.Mutex
+	var diagnostics []rule.RuleDiagnostic
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   sourceFiles,
+		Workers: 2,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: "unused-exports",
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					return rule.RuleListeners{}
+				},
+				RunOnProgram: func() rule.ProgramListeners {
+					return rule.ProgramListeners{
+						OnFileExit: func(ctx rule.RuleContext) any {
+							info := fileInfo{exports: make(map[string]*ast.Node)}
+							for _, statement := range ctx.SourceFile.Statements.Nodes {
+								switch {
+								case ast.IsImportDeclaration(statement):
+									for _, element := range statement.AsImportDeclaration().ImportClause.AsImportClause().NamedBindings.AsNamedImports().Elements.Nodes {
+										info.imports = append(info.imports, element.Name().Text())
+									}
+								case ast.IsVariableStatement(statement):
+									for _, declaration := range statement.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes {
+										info.exports[declaration.Name().Text()] = declaration.Name()
+									}
+								}
+							}
+							return info
+						},
+						OnProgramExit: func(ctx rule.ProgramContext, collected map[*ast.SourceFile]any) {
+							imported := make(map[string]struct{})
+							for _, info := range collected {
+								for _, name := range info.(fileInfo).imports {
+									imported[name] = struct{}{}
+								}
+							}
+							for _, file := range ctx.Files {
+								for name, node := range collected[file].(fileInfo).exports {
+									if _, ok := imported[name]; !ok {
+										ctx.ReportNode(file, node, unusedExport)
+									}
+								}
+							}
+						},
+					}
+				},
+			}}
+		},
+		OnDiagnostic: func(d rule.RuleDiagnostic) {
+			mu.Lock()
+			defer mu.Unlock()
+			diagnostics = append(diagnostics, d)
+		},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: false, FixSuggestions: false},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.Equal(t, len(diagnostics), 1, "expected only the export of foo.ts to be reported")
+	assert.Equal(t, diagnostics[0].RuleName, "unused-exports")
+	assert.Equal(t, diagnostics[0].SourceFile.FileName(), fooPath)
+	assert.Equal(t, diagnostics[0].Message.Id, unusedExport.Id)
+}
+
+func TestRunLinterOnProgram_SelectorListeners(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `
+declare const promise: Promise<number>;
+declare function g(value: number): void;
+function f() {
+	promise.then(g);
+	return 1;
+}
+`
+
+	fs := utils.NewOverlayVFS(
+		cachedBaseFS,
+		map[string]string{filePath: code},
+	)
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", host, false)
+	assert.NilError(t, err, "couldn't create program")
+
+	// Listeners in the order they ran
+	var visited []string
+
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(filePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: "selectors",
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					listeners := rule.RuleListeners{
+						ast.KindReturnStatement: func(node *ast.Node) {
+							visited = append(visited, "ReturnStatement")
+						},
+					}
+					listeners.AddSelector("CallExpression > PropertyAccessExpression.expression[name=then]", func(node *ast.Node) {
+						visited = append(visited, "then")
+					})
+					listeners.AddSelector("FunctionDeclaration ReturnStatement", func(node *ast.Node) {
+						visited = append(visited, "FunctionDeclaration ReturnStatement")
+					})
+					listeners.AddSelector("FunctionDeclaration ReturnStatement:exit", func(node *ast.Node) {
+						visited = append(visited, "FunctionDeclaration ReturnStatement:exit")
+					})
+					return listeners
+				},
+			}}
+		},
+		OnDiagnostic:         func(d rule.RuleDiagnostic) {},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		Fixes:                Fixes{Fix: false, FixSuggestions: false},
+		TypeErrors:           TypeErrors{ReportSyntactic: false, ReportSemantic: false},
+	})
+	assert.NilError(t, err, "unexpected error from RunLinterOnProgram")
+
+	assert.DeepEqual(t, visited, []string{
+		"then",
+		"ReturnStatement",
+		"FunctionDeclaration ReturnStatement",
+		"FunctionDeclaration ReturnStatement:exit",
+	})
 }
@@ -0,0 +1,207 @@
+package linter
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// State of the program-wide analyses of the rules of a program, shared by the
+// workers linting its files.
+type programRules struct {
+	program *compiler.Program
+
+	mu sync.Mutex
+	// In the order the rules were first enabled for a file
+	rules  []*programRule
+	byName map[string]*programRule
+	// Disable directives of the files a program rule is enabled for, nil for
+	// files without directives. Their unused directives are only reported
+	// once the program rules reported their diagnostics.
+	directives map[*ast.SourceFile]*disableDirectives
+}
+
+type programRule struct {
+	name      string
+	listeners rule.ProgramListeners
+	files     []*ast.SourceFile
+	collected map[*ast.SourceFile]any
+}
+
+func newProgramRules(program *compiler.Program) *programRules {
+	return &programRules{
+		program:    program,
+		byName:     make(map[string]*programRule),
+		directives: make(map[*ast.SourceFile]*disableDirectives),
+	}
+}
+
+// Returns the analysis of r, creating it when r is first enabled for a file.
+// Since the analysis exists once per program, it uses the options r was
+// configured with for that file.
+func (p *programRules) get(r ConfiguredRule) *programRule {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pr, ok := p.byName[r.Name]; ok {
+		return pr
+	}
+	pr := &programRule{
+		name:      r.Name,
+		listeners: r.RunOnProgram(),
+		collected: make(map[*ast.SourceFile]any),
+	}
+	p.rules = append(p.rules, pr)
+	p.byName[r.Name] = pr
+	return pr
+}
+
+// Runs the OnFileExit listeners of the rules enabled for the current file of
+// ctxBuilder. Must be called after the listeners of the rules ran on the file.
+// If a program rule is enabled for the file, takes over its disable directives
+// from ctxBuilder. Returns the time spent per rule.
+func (p *programRules) collectFile(rules []ConfiguredRule, ctxBuilder *ruleContextBuilder, ctx rule.RuleContext) []RuleTimingStat {
+	var timings []RuleTimingStat
+	for idx, r := range rules {
+		if r.RunOnProgram == nil {
+			continue
+		}
+		if timings == nil {
+			timings = make([]RuleTimingStat, len(rules))
+		}
+		start := time.Now()
+		pr := p.get(r)
+		var result any
+		if pr.listeners.OnFileExit != nil {
+			ctxBuilder.ruleName = r.Name
+			result = pr.listeners.OnFileExit(ctx)
+		}
+		timings[idx] = RuleTimingStat{
+			Duration:        time.Since(start),
+			CheckerDuration: ctxBuilder.typeCache.TakeCheckerTime(),
+			Calls:           1,
+		}
+
+		p.mu.Lock()
+		pr.files = append(pr.files, ctxBuilder.file)
+		pr.collected[ctxBuilder.file] = result
+		p.mu.Unlock()
+	}
+	if timings != nil {
+		p.mu.Lock()
+		p.directives[ctxBuilder.file] = ctxBuilder.directives
+		p.mu.Unlock()
+		ctxBuilder.directives = nil
+	}
+	return timings
+}
+
+// Runs the OnProgramExit listeners. Must be called once all workers are done.
+// Diagnostics are reported to onDiagnostic. Like the diagnostics of the other
+// rules, they are only filtered by the disable directives of the file they
+// are reported in when filterDisabled is set, i.e. when the linter handles
+// the directives itself. The unused directives of the files taken over by
+// collectFile are then reported.
+func (p *programRules) finish(fixState Fixes, filterDisabled bool, onDiagnostic func(rule.RuleDiagnostic), onInternalDiagnostic func(d diagnostic.Internal), timingStore *RuleTimingStore) {
+	if len(p.rules) == 0 {
+		return
+	}
+
+	typeChecker, done := p.program.GetTypeChecker(context.Background())
+	defer done()
+
+	ctxBuilder := &ruleContextBuilder{
+		program:              p.program,
+		checker:              typeChecker,
+		fixState:             fixState,
+		onDiagnostic:         onDiagnostic,
+		onInternalDiagnostic: onInternalDiagnostic,
+		logger:               utils.Logger(utils.LogSubsystemRules),
+	}
+	// Directives of the files the current rule is not enabled for
+	directivesByFile := make(map[*ast.SourceFile]*disableDirectives)
+	var current *programRule
+	report := func(file *ast.SourceFile, emit func()) {
+		ctxBuilder.file = file
+		if filterDisabled {
+			directives, ok := p.directives[file]
+			if _, enabled := current.collected[file]; !ok || !enabled {
+				directives, ok = directivesByFile[file]
+				if !ok {
+					directives = parseDisableDirectives(file, map[string]struct{}{ctxBuilder.ruleName: {}})
+					directivesByFile[file] = directives
+				}
+			}
+			ctxBuilder.directives = directives
+		}
+		emit()
+		ctxBuilder.directives = nil
+		ctxBuilder.flushDiagnostics()
+	}
+
+	// Files in program order, regardless of which worker linted them
+	order := make(map[*ast.SourceFile]int)
+	for idx, file := range p.program.SourceFiles() {
+		order[file] = idx
+	}
+
+	timings := make(map[string]RuleTimingStat)
+	for _, pr := range p.rules {
+		if pr.listeners.OnProgramExit == nil {
+			continue
+		}
+		current = pr
+		ctxBuilder.ruleName = pr.name
+		clear(directivesByFile)
+		slices.SortFunc(pr.files, func(a, b *ast.SourceFile) int {
+			return order[a] - order[b]
+		})
+
+		ctx := rule.ProgramContext{
+			Program:     p.program,
+			TypeChecker: typeChecker,
+			Files:       pr.files,
+			ReportDiagnostic: func(file *ast.SourceFile, d rule.RuleDiagnostic) {
+				report(file, func() { ctxBuilder.emitDiagnostic(d) })
+			},
+			ReportNode: func(file *ast.SourceFile, node *ast.Node, msg rule.RuleMessage) {
+				report(file, func() { ctxBuilder.reportNode(node, msg) })
+			},
+			ReportNodeWithFixes: func(file *ast.SourceFile, node *ast.Node, msg rule.RuleMessage, fixesFn func() []rule.RuleFix) {
+				report(file, func() { ctxBuilder.reportNodeWithFixes(node, msg, fixesFn) })
+			},
+			ReportNodeWithSuggestions: func(file *ast.SourceFile, node *ast.Node, msg rule.RuleMessage, suggestionsFn func() []rule.RuleSuggestion) {
+				report(file, func() { ctxBuilder.reportNodeWithSuggestions(node, msg, suggestionsFn) })
+			},
+		}
+
+		start := time.Now()
+		pr.listeners.OnProgramExit(ctx, pr.collected)
+		timings[pr.name] = RuleTimingStat{Duration: time.Since(start), Calls: 1}
+	}
+
+	if filterDisabled {
+		files := slices.Collect(maps.Keys(p.directives))
+		slices.SortFunc(files, func(a, b *ast.SourceFile) int {
+			return order[a] - order[b]
+		})
+		for _, file := range files {
+			if directives := p.directives[file]; directives != nil {
+				ctxBuilder.file = file
+				directives.reportUnused(fixState.Fix, ctxBuilder.report)
+				ctxBuilder.flushDiagnostics()
+			}
+		}
+	}
+
+	if timingStore != nil {
+		timingStore.merge(timings)
+	}
+}
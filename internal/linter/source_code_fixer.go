@@ -1,9 +1,11 @@
 package linter
 
 import (
+	"maps"
 	"slices"
 	"strings"
 
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 )
 
@@ -11,7 +13,37 @@ type LintMessage interface {
 	Fixes() []rule.RuleFix
 }
 
+// A diagnostic whose fixes were not applied because they overlap the fixes of
+// a diagnostic applied before.
+type FixConflict[M LintMessage] struct {
+	Winner M
+	Loser  M
+	// File in which the fixes overlap
+	FileName string
+	// Ranges spanned by the fixes of each diagnostic in that file
+	WinnerRange core.TextRange
+	LoserRange  core.TextRange
+}
+
+// Applies the fixes of the diagnostics of a single file. Diagnostics with
+// fixes in other files are left unapplied, see `ApplyRuleFixesToFiles`.
 func ApplyRuleFixes[M LintMessage](code string, diagnostics []M) (string, []M, bool) {
+	fixedFiles, unapplied, fixed, _ := ApplyRuleFixesToFiles("", map[string]string{"": code}, diagnostics)
+	if fixedCode, ok := fixedFiles[""]; ok {
+		return fixedCode, unapplied, fixed
+	}
+	return code, unapplied, fixed
+}
+
+// Applies the fixes of diagnostics reported in fileName, which may edit other
+// files as well. files holds the text of every file that may be edited,
+// including fileName. The fixes of a diagnostic are applied all or nothing: the
+// diagnostic is left unapplied if any of its fixes overlaps a fix applied
+// before, in any file, or edits a file missing from files.
+//
+// Returns the fixed text of every edited file, and a conflict for every
+// diagnostic left unapplied because of an overlap.
+func ApplyRuleFixesToFiles[M LintMessage](fileName string, files map[string]string, diagnostics []M) (map[string]string, []M, bool, []FixConflict[M]) {
 	unapplied := []M{}
 	withFixes := []M{}
 
@@ -20,6 +52,9 @@ func ApplyRuleFixes[M LintMessage](code string, diagnostics []M) (string, []M, b
 	for _, diagnostic := range diagnostics {
 		if len(diagnostic.Fixes()) > 0 {
 			slices.SortFunc(diagnostic.Fixes(), func(a rule.RuleFix, b rule.RuleFix) int {
+				if file := strings.Compare(a.FileName, b.FileName); file != 0 {
+					return file
+				}
 				start := a.Range.Pos() - b.Range.Pos()
 				if start == 0 {
 					return a.Range.End() - b.Range.End()
@@ -42,27 +77,105 @@ func ApplyRuleFixes[M LintMessage](code string, diagnostics []M) (string, []M, b
 		return start
 	})
 
-	var builder strings.Builder
+	// Each applied diagnostic covers a span per file from its first to its last
+	// fix. Fixes of other diagnostics may not intersect these spans.
+	appliedSpans := make(map[string][]fixSpan)
+	appliedFixes := make(map[string][]rule.RuleFix)
+	var conflicts []FixConflict[M]
+
+	for idx, diagnostic := range withFixes {
+		spans := fixSpans(fileName, diagnostic.Fixes())
 
-	lastFixEnd := 0
-	for _, diagnostic := range withFixes {
-		fixes := diagnostic.Fixes()
-		if lastFixEnd > fixes[0].Range.Pos() {
+		applicable := true
+		for _, file := range slices.Sorted(maps.Keys(spans)) {
+			span := spans[file]
+			if _, ok := files[file]; !ok {
+				applicable = false
+				break
+			}
+			if i := slices.IndexFunc(appliedSpans[file], span.overlaps); i >= 0 {
+				winner := appliedSpans[file][i]
+				conflicts = append(conflicts, FixConflict[M]{
+					Winner:      withFixes[winner.owner],
+					Loser:       diagnostic,
+					FileName:    file,
+					WinnerRange: core.NewTextRange(winner.pos, winner.end),
+					LoserRange:  core.NewTextRange(span.pos, span.end),
+				})
+				applicable = false
+				break
+			}
+		}
+		if !applicable {
 			unapplied = append(unapplied, diagnostic)
 			continue
 		}
 
-		for _, fix := range fixes {
-			fixed = true
+		for file, span := range spans {
+			span.owner = idx
+			appliedSpans[file] = append(appliedSpans[file], span)
+		}
+		for _, fix := range diagnostic.Fixes() {
+			file := fixFileName(fileName, fix)
+			appliedFixes[file] = append(appliedFixes[file], fix)
+		}
+		fixed = true
+	}
+
+	fixedFiles := make(map[string]string, len(appliedFixes))
+	for file, fixes := range appliedFixes {
+		// Stable, so insertions at the same position keep the order of their
+		// diagnostics.
+		slices.SortStableFunc(fixes, func(a rule.RuleFix, b rule.RuleFix) int {
+			return a.Range.Pos() - b.Range.Pos()
+		})
 
+		code := files[file]
+		var builder strings.Builder
+		lastFixEnd := 0
+		for _, fix := range fixes {
 			builder.WriteString(code[lastFixEnd:fix.Range.Pos()])
 			builder.WriteString(fix.Text)
 
 			lastFixEnd = fix.Range.End()
 		}
+		builder.WriteString(code[lastFixEnd:])
+
+		fixedFiles[file] = builder.String()
 	}
 
-	builder.WriteString(code[lastFixEnd:])
+	return fixedFiles, unapplied, fixed, conflicts
+}
+
+type fixSpan struct {
+	pos int
+	end int
+	// Index of the applied diagnostic
+	owner int
+}
+
+func (s fixSpan) overlaps(other fixSpan) bool {
+	return s.end > other.pos && other.end > s.pos
+}
+
+func fixFileName(fileName string, fix rule.RuleFix) string {
+	if fix.FileName == "" {
+		return fileName
+	}
+	return fix.FileName
+}
 
-	return builder.String(), unapplied, fixed
+func fixSpans(fileName string, fixes []rule.RuleFix) map[string]fixSpan {
+	spans := make(map[string]fixSpan, 1)
+	for _, fix := range fixes {
+		file := fixFileName(fileName, fix)
+		span, ok := spans[file]
+		if !ok {
+			span = fixSpan{pos: fix.Range.Pos(), end: fix.Range.End()}
+		}
+		span.pos = min(span.pos, fix.Range.Pos())
+		span.end = max(span.end, fix.Range.End())
+		spans[file] = span
+	}
+	return spans
 }
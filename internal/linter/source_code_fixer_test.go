@@ -0,0 +1,62 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestApplyRuleFixes_SkipsOverlappingFixes(t *testing.T) {
+	code := "a || b || c"
+	diagnostics := []rule.RuleDiagnostic{
+		{FixesPtr: &[]rule.RuleFix{{Text: "??", Range: core.NewTextRange(7, 9)}}},
+		{FixesPtr: &[]rule.RuleFix{{Text: "(a ?? b)", Range: core.NewTextRange(0, 6)}}},
+		{FixesPtr: &[]rule.RuleFix{{Text: "x", Range: core.NewTextRange(5, 6)}}},
+		{},
+	}
+
+	fixed, unapplied, ok := ApplyRuleFixes(code, diagnostics)
+	assert.Assert(t, ok)
+	assert.Equal(t, fixed, "(a ?? b) ?? c")
+	assert.Equal(t, len(unapplied), 2)
+}
+
+func TestApplyRuleFixesToFiles(t *testing.T) {
+	files := map[string]string{
+		"/a.ts": "export { T } from './b';",
+		"/b.ts": "export type T = 1;",
+	}
+	diagnostics := []rule.RuleDiagnostic{
+		{FixesPtr: &[]rule.RuleFix{
+			{Text: "export type", Range: core.NewTextRange(0, 6)},
+			{Text: "", Range: core.NewTextRange(0, 7), FileName: "/b.ts"},
+		}},
+		// Conflicts with the first diagnostic in /b.ts only
+		{FixesPtr: &[]rule.RuleFix{
+			{Text: "!", Range: core.NewTextRange(24, 24)},
+			{Text: "declare ", Range: core.NewTextRange(0, 7), FileName: "/b.ts"},
+		}},
+		// Edits a file which is not available
+		{FixesPtr: &[]rule.RuleFix{{Text: "", Range: core.NewTextRange(0, 1), FileName: "/c.ts"}}},
+	}
+
+	fixed, unapplied, ok, conflicts := ApplyRuleFixesToFiles("/a.ts", files, diagnostics)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, fixed, map[string]string{
+		"/a.ts": "export type { T } from './b';",
+		"/b.ts": "type T = 1;",
+	})
+	assert.Equal(t, len(unapplied), 2)
+
+	assert.Equal(t, len(conflicts), 1, "a missing file is not a conflict")
+	assert.Equal(t, conflicts[0].FileName, "/b.ts")
+	assert.Equal(t, conflicts[0].Winner.FixesPtr, diagnostics[0].FixesPtr)
+	assert.Equal(t, conflicts[0].Loser.FixesPtr, diagnostics[1].FixesPtr)
+	assert.Equal(t, conflicts[0].WinnerRange, core.NewTextRange(0, 7))
+
+	_, unapplied, _ = ApplyRuleFixes(files["/a.ts"], diagnostics[:1])
+	assert.Equal(t, len(unapplied), 1, "fixes of other files cannot be applied to a single file")
+}
@@ -1,30 +1,63 @@
 package linter
 
 import (
+	"slices"
 	"sort"
 	"sync"
 	"time"
+
+	"github.com/typescript-eslint/tsgolint/internal/rule"
 )
 
 type RuleTimingStat struct {
+	// Including CheckerDuration
 	Duration time.Duration
-	Calls    uint64
+	// Time spent in type checker queries made by the rule, see
+	// `rule.TypeCache.EnableTiming`.
+	CheckerDuration time.Duration
+	Calls           uint64
 }
 
 func (s *RuleTimingStat) add(other RuleTimingStat) {
 	s.Duration += other.Duration
+	s.CheckerDuration += other.CheckerDuration
 	s.Calls += other.Calls
 }
 
 type RuleTimingRecord struct {
-	RuleName string
+	RuleName        string
+	Duration        time.Duration
+	CheckerDuration time.Duration
+	Calls           uint64
+}
+
+type FileTimingRecord struct {
+	FileName string
+	// Wall time spent linting the file, including CheckerDuration
 	Duration time.Duration
-	Calls    uint64
+	// Time spent checking the file before its rules run. Most of the checker
+	// work a rule would otherwise trigger lazily is done there, so that it is
+	// not attributed to the first rule to query a type.
+	CheckerDuration time.Duration
+}
+
+type ProgramTimingRecord struct {
+	// The tsconfig of the program, empty for the inferred project
+	ConfigFileName string
+	// Number of linted files
+	Files  int
+	Create time.Duration
+	Bind   time.Duration
+	// Sum of the CheckerDuration of the linted files
+	Check time.Duration
 }
 
 type RuleTimingStore struct {
-	mu      sync.Mutex
-	timings map[string]RuleTimingStat
+	mu             sync.Mutex
+	timings        map[string]RuleTimingStat
+	files          []FileTimingRecord
+	programs       []ProgramTimingRecord
+	typeCacheStats rule.TypeCacheStats
 }
 
 func NewRuleTimingStore() *RuleTimingStore {
@@ -46,6 +79,35 @@ func (s *RuleTimingStore) merge(localTimings map[string]RuleTimingStat) {
 	}
 }
 
+func (s *RuleTimingStore) mergeFiles(files []FileTimingRecord) {
+	if len(files) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files = append(s.files, files...)
+}
+
+func (s *RuleTimingStore) addProgram(record ProgramTimingRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.programs = append(s.programs, record)
+}
+
+func (s *RuleTimingStore) mergeTypeCacheStats(stats rule.TypeCacheStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.typeCacheStats.Add(stats)
+}
+
+// Returns the hits and misses of the type caches of all workers.
+func (s *RuleTimingStore) TypeCacheStats() rule.TypeCacheStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.typeCacheStats
+}
+
 func (s *RuleTimingStore) Collect() []RuleTimingRecord {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -53,9 +115,10 @@ func (s *RuleTimingStore) Collect() []RuleTimingRecord {
 	records := make([]RuleTimingRecord, 0, len(s.timings))
 	for ruleName, stat := range s.timings {
 		records = append(records, RuleTimingRecord{
-			RuleName: ruleName,
-			Duration: stat.Duration,
-			Calls:    stat.Calls,
+			RuleName:        ruleName,
+			Duration:        stat.Duration,
+			CheckerDuration: stat.CheckerDuration,
+			Calls:           stat.Calls,
 		})
 	}
 
@@ -68,3 +131,26 @@ func (s *RuleTimingStore) Collect() []RuleTimingRecord {
 
 	return records
 }
+
+// Returns the files sorted by decreasing wall time, i.e. the slowest first.
+func (s *RuleTimingStore) CollectFiles() []FileTimingRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := slices.Clone(s.files)
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Duration != records[j].Duration {
+			return records[i].Duration > records[j].Duration
+		}
+		return records[i].FileName < records[j].FileName
+	})
+
+	return records
+}
+
+// Returns the programs in the order they were linted.
+func (s *RuleTimingStore) CollectPrograms() []ProgramTimingRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.programs)
+}
@@ -0,0 +1,162 @@
+package linter
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-json-experiment/json"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+)
+
+// Listener calls and `Run` calls taking at least this long are recorded as
+// spans of their own.
+const TraceHotSpotThreshold = time.Millisecond
+
+// Thread of the spans which are not recorded by a worker.
+const TraceMainThread = 0
+
+// Records spans in the Chrome Trace Event format, which can be opened by
+// browser profilers such as Perfetto or chrome://tracing. Workers of
+// `RunLinterOnProgram` are the threads of the trace, numbered from 1.
+//
+// A nil *TraceEvents records nothing.
+type TraceEvents struct {
+	mu     sync.Mutex
+	start  time.Time
+	events []traceEvent
+	// Threads which have been named
+	threads map[int]struct{}
+}
+
+type traceEvent struct {
+	Name     string `json:"name"`
+	Category string `json:"cat,omitempty"`
+	Phase    string `json:"ph"`
+	// In microseconds since the start of the trace
+	Timestamp float64        `json:"ts"`
+	Duration  float64        `json:"dur,omitzero"`
+	Pid       int            `json:"pid"`
+	Tid       int            `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+type traceEventsFile struct {
+	TraceEvents     []traceEvent `json:"traceEvents"`
+	DisplayTimeUnit string       `json:"displayTimeUnit"`
+}
+
+func NewTraceEvents() *TraceEvents {
+	t := &TraceEvents{
+		start:   time.Now(),
+		threads: make(map[int]struct{}),
+	}
+	t.nameThread(TraceMainThread, "main")
+	return t
+}
+
+func (t *TraceEvents) microseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Microsecond)
+}
+
+// Records a span of thread which started at start and lasted duration. args are
+// shown with the span, and may be nil.
+func (t *TraceEvents) Span(thread int, category string, name string, start time.Time, duration time.Duration, args map[string]any) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, traceEvent{
+		Name:      name,
+		Category:  category,
+		Phase:     "X",
+		Timestamp: t.microseconds(start.Sub(t.start)),
+		Duration:  t.microseconds(duration),
+		Tid:       thread,
+		Args:      args,
+	})
+}
+
+// Starts a span of thread, and returns the function ending it.
+//
+//	defer traceEvents.Begin(linter.TraceMainThread, "tsconfig", "resolve tsconfigs", nil)()
+func (t *TraceEvents) Begin(thread int, category string, name string, args map[string]any) func() {
+	if t == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		t.Span(thread, category, name, start, time.Since(start), args)
+	}
+}
+
+// Names the thread of a worker of `RunLinterOnProgram`, numbered from 1.
+func (t *TraceEvents) nameWorker(thread int) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.threads[thread]; !ok {
+		t.nameThread(thread, "worker "+strconv.Itoa(thread))
+	}
+}
+
+// Must be called with mu held.
+func (t *TraceEvents) nameThread(thread int, name string) {
+	t.threads[thread] = struct{}{}
+	t.events = append(t.events, traceEvent{
+		Name:  "thread_name",
+		Phase: "M",
+		Tid:   thread,
+		Args:  map[string]any{"name": name},
+	})
+}
+
+// Writes the recorded spans to the file at path.
+func (t *TraceEvents) WriteFile(path string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	data, err := json.Marshal(traceEventsFile{
+		TraceEvents:     t.events,
+		DisplayTimeUnit: "ms",
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+var listenerSuffixes = []string{"", ":exit", ":allowPattern", ":allowPattern:exit", ":notAllowPattern", ":notAllowPattern:exit"}
+
+// Returns a readable name of the kind a listener is registered for, e.g.
+// "KindCallExpression:exit".
+func listenerName(kind ast.Kind) string {
+	switch kind {
+	case rule.ListenerOnCodePathStart:
+		return "onCodePathStart"
+	case rule.ListenerOnCodePathEnd:
+		return "onCodePathEnd"
+	}
+	step := rule.ListenerOnExit(0)
+	if idx := int(kind / step); idx < len(listenerSuffixes) {
+		return (kind % step).String() + listenerSuffixes[idx]
+	}
+	return kind.String()
+}
+
+// Returns the tsconfig of program, or "inferred project".
+func programName(program *compiler.Program) string {
+	if configFilePath := program.Options().ConfigFilePath; configFilePath != "" {
+		return configFilePath
+	}
+	return "inferred project"
+}
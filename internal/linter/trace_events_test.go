@@ -0,0 +1,84 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-json-experiment/json"
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/diagnostic"
+	"github.com/typescript-eslint/tsgolint/internal/rule"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestListenerName(t *testing.T) {
+	assert.Equal(t, listenerName(ast.KindCallExpression), "KindCallExpression")
+	assert.Equal(t, listenerName(rule.ListenerOnExit(ast.KindCallExpression)), "KindCallExpression:exit")
+	assert.Equal(t, listenerName(rule.ListenerOnNotAllowPattern(ast.KindArrayLiteralExpression)), "KindArrayLiteralExpression:notAllowPattern")
+	assert.Equal(t, listenerName(rule.ListenerOnCodePathEnd), "onCodePathEnd")
+}
+
+func TestRunLinterOnProgram_TraceEvents(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	fs := utils.NewOverlayVFS(cachedBaseFS, map[string]string{filePath: "const x = 1;"})
+	host := utils.CreateCompilerHost(rootDir, fs)
+
+	var programTimings utils.ProgramTimings
+	program, _, err := utils.CreateProgramWithTimings(true, fs, rootDir, "tsconfig.minimal.json", host, false, &programTimings)
+	assert.NilError(t, err, "couldn't create program")
+
+	const slowRule = "slow-rule"
+	traceEvents := NewTraceEvents()
+	err = RunLinterOnProgram(RunLinterOnProgramOptions{
+		Program: program,
+		Files:   []*ast.SourceFile{program.GetSourceFile(filePath)},
+		Workers: 1,
+		GetRulesForFile: func(sourceFile *ast.SourceFile) []ConfiguredRule {
+			return []ConfiguredRule{{
+				Name: slowRule,
+				Run: func(ctx rule.RuleContext) rule.RuleListeners {
+					return rule.RuleListeners{
+						ast.KindVariableStatement: func(*ast.Node) {
+							time.Sleep(TraceHotSpotThreshold)
+						},
+					}
+				},
+			}}
+		},
+		OnDiagnostic:         func(d rule.RuleDiagnostic) {},
+		OnInternalDiagnostic: func(d diagnostic.Internal) {},
+		TraceEvents:          traceEvents,
+		ProgramTimings:       programTimings,
+	})
+	assert.NilError(t, err)
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	assert.NilError(t, traceEvents.WriteFile(path))
+	data, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	var trace traceEventsFile
+	assert.NilError(t, json.Unmarshal(data, &trace))
+
+	spans := make(map[string]traceEvent)
+	for _, event := range trace.TraceEvents {
+		spans[event.Category+" "+event.Name] = event
+	}
+	assert.Equal(t, spans[" thread_name"].Phase, "M")
+	assert.Equal(t, spans["program create "+programName(program)].Phase, "X")
+	assert.Equal(t, spans["program bind "+programName(program)].Phase, "X")
+	assert.Equal(t, spans["program lint "+programName(program)].Tid, TraceMainThread)
+	assert.Equal(t, spans["file "+filePath].Tid, 1)
+	assert.Equal(t, spans["file check"].Tid, 1)
+
+	hotSpot := spans["rule "+slowRule]
+	assert.Equal(t, hotSpot.Tid, 1)
+	assert.Assert(t, hotSpot.Duration >= float64(TraceHotSpotThreshold/time.Microsecond))
+	assert.Equal(t, hotSpot.Args["listener"], "KindVariableStatement")
+}
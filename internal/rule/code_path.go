@@ -0,0 +1,298 @@
+package rule
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+)
+
+// Code path analysis, similar to ESLint's. A code path is the body of a
+// function, a class static block or a source file; nested functions have code
+// paths of their own. The analysis works on statements: an expression is
+// reachable if the statement containing it is, even if it follows a call which
+// never returns or the short-circuited side of `&&`.
+type CodePath struct {
+	// See IsCodePathNode
+	Node *ast.Node
+	// Statements and clauses of the path, not including nested code paths
+	reachable map[*ast.Node]bool
+	// Reachable return statements, in source order
+	returns []*ast.Node
+	// Iteration statements, in source order
+	loops []*ast.Node
+	// Reachable loops which can only be left by a return, a throw or a jump
+	// to an enclosing statement
+	infiniteLoops map[*ast.Node]struct{}
+	endReachable  bool
+}
+
+// Reports whether node starts a code path: a source file, a class static
+// block, or a function-like declaration with a body.
+func IsCodePathNode(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindSourceFile, ast.KindClassStaticBlockDeclaration:
+		return true
+	}
+	return ast.IsFunctionLikeDeclaration(node) && node.Body() != nil
+}
+
+// Returns the node of the code path node belongs to. The code path of a
+// function is the one of its parameters and body, while the function itself
+// belongs to the enclosing code path. A source file is its own code path node.
+func CodePathNodeOf(node *ast.Node) *ast.Node {
+	for n := node.Parent; n != nil; n = n.Parent {
+		if IsCodePathNode(n) {
+			return n
+		}
+	}
+	return node
+}
+
+// Analyzes the code path of node, which must be a code path node.
+func AnalyzeCodePath(node *ast.Node) *CodePath {
+	path := &CodePath{
+		Node:          node,
+		reachable:     make(map[*ast.Node]bool),
+		infiniteLoops: make(map[*ast.Node]struct{}),
+	}
+	analyzer := codePathAnalyzer{path: path}
+
+	if node.Kind == ast.KindSourceFile {
+		path.endReachable = analyzer.statements(node.AsSourceFile().Statements.Nodes, true)
+	} else if body := node.Body(); body.Kind == ast.KindBlock {
+		path.endReachable = analyzer.statement(body, true)
+	}
+	// The expression body of an arrow function is returned
+	return path
+}
+
+// Reports whether node can be reached from the start of the code path. Nodes
+// of nested code paths are only unreachable if their own path says so.
+func (p *CodePath) IsReachable(node *ast.Node) bool {
+	for n := node; n != nil && n != p.Node; n = n.Parent {
+		if n != node && IsCodePathNode(n) {
+			break
+		}
+		if reachable, ok := p.reachable[n]; ok {
+			return reachable
+		}
+	}
+	return true
+}
+
+// Reports whether every path ends with a return or a throw statement, i.e.
+// the end of the body cannot be reached. Always true for arrow functions with
+// an expression body.
+func (p *CodePath) AllPathsReturn() bool {
+	return !p.endReachable
+}
+
+// Returns the reachable return statements of the code path, in source order.
+func (p *CodePath) ReturnStatements() []*ast.Node {
+	return p.returns
+}
+
+// Returns the `for`, `for-in`, `for-of`, `while` and `do-while` statements of
+// the code path, in source order.
+func (p *CodePath) Loops() []*ast.Node {
+	return p.loops
+}
+
+// Reports whether loop is reachable and can only be left by a return, a throw
+// or a jump to an enclosing statement, e.g. `while (true) {}`.
+func (p *CodePath) IsInfiniteLoop(loop *ast.Node) bool {
+	_, ok := p.infiniteLoops[loop]
+	return ok
+}
+
+// A statement that `break` or `continue` can jump to.
+type jumpTarget struct {
+	labels []string
+	// Unlabeled `break` and `continue` jump to the innermost loop, unlabeled
+	// `break` also to the innermost switch.
+	isLoop          bool
+	isSwitch        bool
+	breakReached    bool
+	continueReached bool
+}
+
+type codePathAnalyzer struct {
+	path *CodePath
+	// Innermost last
+	targets []*jumpTarget
+}
+
+// Analyzes the statements in order, starting with the given reachability.
+// Returns whether the end of the last statement is reachable.
+func (a *codePathAnalyzer) statements(statements []*ast.Node, reachable bool) bool {
+	for _, statement := range statements {
+		reachable = a.statement(statement, reachable)
+	}
+	return reachable
+}
+
+func (a *codePathAnalyzer) statement(node *ast.Node, reachable bool) bool {
+	return a.labeledStatement(node, nil, reachable)
+}
+
+// Analyzes node, which is reachable or not, and returns whether its end is
+// reachable. labels are the labels of the enclosing labeled statements.
+func (a *codePathAnalyzer) labeledStatement(node *ast.Node, labels []string, reachable bool) bool {
+	a.path.reachable[node] = reachable
+
+	switch node.Kind {
+	case ast.KindBlock:
+		return a.statements(node.AsBlock().Statements.Nodes, reachable)
+
+	case ast.KindModuleDeclaration:
+		if body := node.AsModuleDeclaration().Body; body != nil {
+			return a.statement(body, reachable)
+		}
+		return reachable
+
+	case ast.KindModuleBlock:
+		return a.statements(node.AsModuleBlock().Statements.Nodes, reachable)
+
+	case ast.KindLabeledStatement:
+		statement := node.AsLabeledStatement()
+		labels = append(labels, statement.Label.Text())
+		switch statement.Statement.Kind {
+		case ast.KindLabeledStatement, ast.KindSwitchStatement, ast.KindForStatement, ast.KindForInStatement, ast.KindForOfStatement, ast.KindWhileStatement, ast.KindDoStatement:
+			// The labels are jumped to by the inner statement
+			return a.labeledStatement(statement.Statement, labels, reachable)
+		}
+		target := a.push(&jumpTarget{labels: labels})
+		end := a.statement(statement.Statement, reachable)
+		a.pop()
+		return end || target.breakReached
+
+	case ast.KindIfStatement:
+		statement := node.AsIfStatement()
+		thenEnd := a.statement(statement.ThenStatement, reachable)
+		if statement.ElseStatement == nil {
+			return reachable
+		}
+		return a.statement(statement.ElseStatement, reachable) || thenEnd
+
+	case ast.KindReturnStatement:
+		if reachable {
+			a.path.returns = append(a.path.returns, node)
+		}
+		return false
+
+	case ast.KindThrowStatement:
+		return false
+
+	case ast.KindBreakStatement:
+		if target := a.target(node.AsBreakStatement().Label, false); target != nil && reachable {
+			target.breakReached = true
+		}
+		return false
+
+	case ast.KindContinueStatement:
+		if target := a.target(node.AsContinueStatement().Label, true); target != nil && reachable {
+			target.continueReached = true
+		}
+		return false
+
+	case ast.KindWhileStatement:
+		statement := node.AsWhileStatement()
+		target := a.pushLoop(node, labels)
+		a.statement(statement.Statement, reachable)
+		a.pop()
+		return a.loopEnd(node, target, reachable, reachable && !isTrueCondition(statement.Expression))
+
+	case ast.KindDoStatement:
+		statement := node.AsDoStatement()
+		target := a.pushLoop(node, labels)
+		bodyEnd := a.statement(statement.Statement, reachable)
+		a.pop()
+		return a.loopEnd(node, target, reachable, (bodyEnd || target.continueReached) && !isTrueCondition(statement.Expression))
+
+	case ast.KindForStatement:
+		statement := node.AsForStatement()
+		target := a.pushLoop(node, labels)
+		a.statement(statement.Statement, reachable)
+		a.pop()
+		return a.loopEnd(node, target, reachable, reachable && statement.Condition != nil && !isTrueCondition(statement.Condition))
+
+	case ast.KindForInStatement, ast.KindForOfStatement:
+		target := a.pushLoop(node, labels)
+		a.statement(node.AsForInOrOfStatement().Statement, reachable)
+		a.pop()
+		return a.loopEnd(node, target, reachable, reachable)
+
+	case ast.KindSwitchStatement:
+		target := a.push(&jumpTarget{labels: labels, isSwitch: true})
+		// Whether the end of the previous clause falls through
+		fallsThrough := false
+		hasDefault := false
+		for _, clause := range node.AsSwitchStatement().CaseBlock.AsCaseBlock().Clauses.Nodes {
+			hasDefault = hasDefault || clause.Kind == ast.KindDefaultClause
+			a.path.reachable[clause] = reachable || fallsThrough
+			fallsThrough = a.statements(clause.AsCaseOrDefaultClause().Statements.Nodes, reachable || fallsThrough)
+		}
+		a.pop()
+		return fallsThrough || target.breakReached || (reachable && !hasDefault)
+
+	case ast.KindTryStatement:
+		statement := node.AsTryStatement()
+		end := a.statement(statement.TryBlock, reachable)
+		if statement.CatchClause != nil {
+			// Any statement of the try block can throw
+			a.path.reachable[statement.CatchClause] = reachable
+			end = a.statement(statement.CatchClause.AsCatchClause().Block, reachable) || end
+		}
+		if statement.FinallyBlock != nil {
+			// The finally block also runs when the try block returns or throws
+			end = a.statement(statement.FinallyBlock, reachable) && end
+		}
+		return end
+	}
+
+	return reachable
+}
+
+func (a *codePathAnalyzer) push(target *jumpTarget) *jumpTarget {
+	a.targets = append(a.targets, target)
+	return target
+}
+
+func (a *codePathAnalyzer) pushLoop(node *ast.Node, labels []string) *jumpTarget {
+	a.path.loops = append(a.path.loops, node)
+	return a.push(&jumpTarget{labels: labels, isLoop: true})
+}
+
+func (a *codePathAnalyzer) pop() {
+	a.targets = a.targets[:len(a.targets)-1]
+}
+
+// Returns whether the end of loop is reachable, given whether its condition
+// can end it.
+func (a *codePathAnalyzer) loopEnd(loop *ast.Node, target *jumpTarget, reachable bool, conditionEnds bool) bool {
+	end := conditionEnds || target.breakReached
+	if reachable && !end {
+		a.path.infiniteLoops[loop] = struct{}{}
+	}
+	return end
+}
+
+// Returns the statement a `break` or `continue` with the given label, if any,
+// jumps to. Returns nil for jumps out of the code path, which are syntax errors.
+func (a *codePathAnalyzer) target(label *ast.Node, isContinue bool) *jumpTarget {
+	for _, target := range slices.Backward(a.targets) {
+		if label != nil {
+			if slices.Contains(target.labels, label.Text()) {
+				return target
+			}
+		} else if target.isLoop || (target.isSwitch && !isContinue) {
+			return target
+		}
+	}
+	return nil
+}
+
+// `while (true)` and `for (;;)` are only left by jumps, like in the binder.
+func isTrueCondition(node *ast.Node) bool {
+	return ast.SkipParentheses(node).Kind == ast.KindTrueKeyword
+}
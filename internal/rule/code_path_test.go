@@ -0,0 +1,88 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/parser"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAnalyzeCodePath(t *testing.T) {
+	code := `
+function returnsOrThrows() {
+	if (x) { return 1; } else { throw 2; }
+	after();
+}
+function breaksInfiniteLoop() {
+	while (true) { if (x) break; }
+	after();
+}
+function infiniteLoop() {
+	for (;;) {}
+	after();
+}
+function exhaustiveSwitch() {
+	switch (x) { case 1: return; default: return; }
+	after();
+}
+function continuesOuterLoop() {
+	outer: for (const y of z) { while (true) { continue outer; } }
+	after();
+}
+function fallsThrough() {
+	switch (x) { case 1: case 2: break; }
+	after();
+}
+function unreachableNestedFunction() {
+	return;
+	const f = () => { after(); };
+}
+`
+	file := parser.ParseSourceFile(ast.SourceFileParseOptions{FileName: "/file.ts", Path: "/file.ts"}, code, core.ScriptKindTS)
+
+	analyze := func(name string) (*CodePath, *ast.Node) {
+		for _, statement := range file.Statements.Nodes {
+			if statement.Kind == ast.KindFunctionDeclaration && statement.Name().Text() == name {
+				statements := statement.Body().AsBlock().Statements.Nodes
+				return AnalyzeCodePath(statement), statements[len(statements)-1]
+			}
+		}
+		t.Fatalf("no function %q", name)
+		return nil, nil
+	}
+
+	for _, tc := range []struct {
+		name      string
+		reachable bool
+	}{
+		{"returnsOrThrows", false},
+		{"breaksInfiniteLoop", true},
+		{"infiniteLoop", false},
+		{"exhaustiveSwitch", false},
+		{"continuesOuterLoop", true},
+		{"fallsThrough", true},
+	} {
+		path, after := analyze(tc.name)
+		assert.Equal(t, path.IsReachable(after), tc.reachable, tc.name)
+		assert.Equal(t, path.AllPathsReturn(), !tc.reachable, tc.name)
+	}
+
+	path, _ := analyze("infiniteLoop")
+	assert.Equal(t, len(path.Loops()), 1)
+	assert.Assert(t, path.IsInfiniteLoop(path.Loops()[0]))
+
+	path, _ = analyze("breaksInfiniteLoop")
+	assert.Assert(t, !path.IsInfiniteLoop(path.Loops()[0]))
+
+	path, _ = analyze("exhaustiveSwitch")
+	assert.Equal(t, len(path.ReturnStatements()), 2)
+
+	// The body of f belongs to its own code path
+	path, declaration := analyze("unreachableNestedFunction")
+	assert.Assert(t, !path.IsReachable(declaration))
+	arrow := declaration.AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes[0].Initializer()
+	assert.Assert(t, path.IsReachable(arrow.Body().AsBlock().Statements.Nodes[0]))
+}
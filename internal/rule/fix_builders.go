@@ -0,0 +1,240 @@
+package rule
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Fix builders which keep the surrounding code formatted: inserted lines are
+// indented like their neighbours, removed code takes its line, separator and
+// comments along, and replacements are parenthesized only when required.
+
+// Returns the whitespace at the start of the line containing pos.
+func LineIndentation(file *ast.SourceFile, pos int) string {
+	return lineIndentation(file.Text(), pos)
+}
+
+// Inserts text on a new line before node, with the indentation of node. Every
+// line of text is indented.
+func RuleFixInsertLineBefore(file *ast.SourceFile, node *ast.Node, text string) RuleFix {
+	code := file.Text()
+	start := utils.TrimNodeTextRange(file, node).Pos()
+	indentation := lineIndentation(code, start)
+	return RuleFix{
+		Text:  indentLines(code, text, indentation) + newLineOf(code) + indentation,
+		Range: core.NewTextRange(start, start),
+	}
+}
+
+// Inserts text on a new line after node, with the indentation of node. A
+// comment following node on the same line stays there.
+func RuleFixInsertLineAfter(file *ast.SourceFile, node *ast.Node, text string) RuleFix {
+	code := file.Text()
+	indentation := lineIndentation(code, utils.TrimNodeTextRange(file, node).Pos())
+	end := trailingCommentsEnd(code, node.End())
+	return RuleFix{
+		Text:  newLineOf(code) + indentation + indentLines(code, text, indentation),
+		Range: core.NewTextRange(end, end),
+	}
+}
+
+// Removes node. If nothing else is on its lines, the lines are removed, along
+// with its trailing comments and the comments on the lines directly above it.
+func RuleFixRemoveLines(file *ast.SourceFile, node *ast.Node) RuleFix {
+	code := file.Text()
+	r := utils.TrimNodeTextRange(file, node)
+	start, end, _ := removalRange(code, r.Pos(), trailingCommentsEnd(code, r.End()), leadingLineComments(code, node.Pos(), r.Pos()))
+	return RuleFixRemoveRange(core.NewTextRange(start, end))
+}
+
+// Removes the element at index from list along with its comma. An element on
+// lines of its own is removed like `RuleFixRemoveLines` does.
+func RuleFixRemoveListElement(file *ast.SourceFile, list *ast.NodeList, index int) RuleFix {
+	code := file.Text()
+	elements := list.Nodes
+	element := elements[index]
+	r := utils.TrimNodeTextRange(file, element)
+
+	end := r.End()
+	if comma := scanner.SkipTrivia(code, end); comma < len(code) && code[comma] == ',' {
+		end = comma + 1
+	}
+
+	if start, linesEnd, wholeLines := removalRange(code, r.Pos(), trailingCommentsEnd(code, end), leadingLineComments(code, element.Pos(), r.Pos())); wholeLines {
+		return RuleFixRemoveRange(core.NewTextRange(start, linesEnd))
+	}
+
+	switch {
+	case index < len(elements)-1:
+		// `a, b` -> `b`
+		for end < len(code) && isHorizontalWhitespace(code[end]) {
+			end++
+		}
+		return RuleFixRemoveRange(core.NewTextRange(r.Pos(), end))
+	case index > 0:
+		// `a, b` -> `a`, keeping a trailing comma
+		return RuleFixRemoveRange(core.NewTextRange(elements[index-1].End(), r.End()))
+	default:
+		return RuleFixRemoveRange(core.NewTextRange(r.Pos(), end))
+	}
+}
+
+// Reports whether node has to be wrapped in parentheses to be used where an
+// expression of the given precedence is expected.
+func NeedsParentheses(node *ast.Node, precedence ast.OperatorPrecedence) bool {
+	if utils.IsStrongPrecedenceNode(node) {
+		return false
+	}
+	return ast.GetExpressionPrecedence(node) < precedence
+}
+
+// Returns the text of node, wrapped in parentheses if needed to be used where
+// an expression of the given precedence is expected.
+func ParenthesizedText(file *ast.SourceFile, node *ast.Node, precedence ast.OperatorPrecedence) string {
+	text := file.Text()[utils.TrimNodeTextRange(file, node).Pos():node.End()]
+	if NeedsParentheses(node, precedence) {
+		return "(" + text + ")"
+	}
+	return text
+}
+
+// Replaces the target expression with the replacement expression, e.g.
+// `await x` with `x`. The replacement is parenthesized if it binds less tightly
+// than the target did.
+func RuleFixReplaceWithNode(file *ast.SourceFile, target *ast.Node, replacement *ast.Node) RuleFix {
+	return RuleFixReplace(file, target, ParenthesizedText(file, replacement, ast.GetExpressionPrecedence(target)))
+}
+
+func isHorizontalWhitespace(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+func lineStartOf(text string, pos int) int {
+	return strings.LastIndexAny(text[:pos], "\r\n") + 1
+}
+
+// Returns the position of the line break ending the line containing pos.
+func lineEndOf(text string, pos int) int {
+	if i := strings.IndexAny(text[pos:], "\r\n"); i >= 0 {
+		return pos + i
+	}
+	return len(text)
+}
+
+// Returns the position after the line break at pos, if any.
+func skipLineBreak(text string, pos int) int {
+	if strings.HasPrefix(text[pos:], "\r\n") {
+		return pos + 2
+	}
+	if pos < len(text) {
+		return pos + 1
+	}
+	return pos
+}
+
+func lineIndentation(text string, pos int) string {
+	start := lineStartOf(text, pos)
+	end := start
+	for end < len(text) && isHorizontalWhitespace(text[end]) {
+		end++
+	}
+	return text[start:end]
+}
+
+func newLineOf(text string) string {
+	if strings.Contains(text, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// Indents every line of text but the first, which is inserted after existing
+// indentation, and separates the lines with the line breaks of code. Blank
+// lines are left empty.
+func indentLines(code string, text string, indentation string) string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+		if i > 0 && strings.TrimSpace(lines[i]) != "" {
+			lines[i] = indentation + lines[i]
+		}
+	}
+	return strings.Join(lines, newLineOf(code))
+}
+
+// Returns the end of the comments following pos on the same line.
+func trailingCommentsEnd(text string, pos int) int {
+	nodeFactory := ast.NewNodeFactory(ast.NodeFactoryHooks{})
+	for comment := range scanner.GetTrailingCommentRanges(nodeFactory, text, pos) {
+		pos = comment.End()
+	}
+	return pos
+}
+
+// Returns the comments between fullStart and start which begin a line.
+func leadingLineComments(text string, fullStart int, start int) []core.TextRange {
+	nodeFactory := ast.NewNodeFactory(ast.NodeFactoryHooks{})
+	var comments []core.TextRange
+	for comment := range scanner.GetLeadingCommentRanges(nodeFactory, text, fullStart) {
+		if comment.End() > start {
+			break
+		}
+		if lineStart := lineStartOf(text, comment.Pos()); strings.TrimSpace(text[lineStart:comment.Pos()]) == "" {
+			comments = append(comments, core.NewTextRange(comment.Pos(), comment.End()))
+		}
+	}
+	return comments
+}
+
+// Extends the range [start, end) of code to be removed. If it is alone on its
+// lines, the lines are removed with the comments directly above. Otherwise the
+// whitespace separating it from the code on the same line is removed. Reports
+// whether whole lines are removed.
+func removalRange(text string, start int, end int, leadingComments []core.TextRange) (int, int, bool) {
+	lineStart := lineStartOf(text, start)
+	lineEnd := lineEndOf(text, end)
+
+	if strings.TrimSpace(text[lineStart:start]) != "" {
+		// `a; b;` -> `a;`
+		for start > lineStart && isHorizontalWhitespace(text[start-1]) {
+			start--
+		}
+		return start, end, false
+	}
+	if strings.TrimSpace(text[end:lineEnd]) != "" {
+		// `a; b;` -> `b;`
+		for end < lineEnd && isHorizontalWhitespace(text[end]) {
+			end++
+		}
+		return start, end, false
+	}
+
+	start = lineStart
+	for i := len(leadingComments) - 1; i >= 0; i-- {
+		comment := leadingComments[i]
+		if comment.End() > start {
+			continue
+		}
+		// Comments separated by a blank line are not about the removed code
+		between := text[comment.End():start]
+		if strings.Count(between, "\n") > 1 || strings.TrimSpace(between) != "" {
+			break
+		}
+		start = lineStartOf(text, comment.Pos())
+	}
+
+	if lineEnd == len(text) && start > 0 {
+		// Remove the line break before the last line instead of leaving an
+		// empty line at the end
+		start--
+		if start > 0 && text[start-1] == '\r' {
+			start--
+		}
+		return start, lineEnd, true
+	}
+	return start, skipLineBreak(text, lineEnd), true
+}
@@ -0,0 +1,242 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/parser"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestIndentLines(t *testing.T) {
+	assert.Equal(t, indentLines("", "if (a) {\n  b();\n\n}", "    "), "if (a) {\n      b();\n\n    }")
+	assert.Equal(t, indentLines("a();\r\n", "if (a) {\n  b();\n}", "  "), "if (a) {\r\n    b();\r\n  }")
+	assert.Equal(t, lineIndentation("{\n\t  foo();\n}", 5), "\t  ")
+}
+
+func TestRemovalRange(t *testing.T) {
+	remove := func(text string, removed string, leadingComments ...core.TextRange) string {
+		start := len(text) - len(removed)
+		for i := range text {
+			if text[i:min(i+len(removed), len(text))] == removed {
+				start = i
+				break
+			}
+		}
+		from, to, _ := removalRange(text, start, start+len(removed), leadingComments)
+		return text[:from] + text[to:]
+	}
+
+	assert.Equal(t, remove("a();\n  b();\nc();\n", "b();"), "a();\nc();\n")
+	assert.Equal(t, remove("a(); b();\n", "b();"), "a();\n")
+	assert.Equal(t, remove("a(); b();\n", "a();"), "b();\n")
+	assert.Equal(t, remove("a();\r\nb();", "b();"), "a();")
+
+	code := "a();\n\n// unrelated\n\n// about b\n/* more */\nb();\n"
+	comments := []core.TextRange{core.NewTextRange(6, 18), core.NewTextRange(20, 30), core.NewTextRange(31, 41)}
+	assert.Equal(t, remove(code, "b();", comments...), "a();\n\n// unrelated\n\n")
+}
+
+func parseFixTestFile(code string) *ast.SourceFile {
+	return parser.ParseSourceFile(ast.SourceFileParseOptions{FileName: "/file.ts", Path: "/file.ts"}, code, core.ScriptKindTS)
+}
+
+// Returns the outermost node whose text is text.
+func findFixTestNode(t *testing.T, file *ast.SourceFile, text string) *ast.Node {
+	t.Helper()
+	var found *ast.Node
+	var visit ast.Visitor
+	visit = func(node *ast.Node) bool {
+		if found != nil {
+			return true
+		}
+		if r := utils.TrimNodeTextRange(file, node); file.Text()[r.Pos():r.End()] == text {
+			found = node
+			return true
+		}
+		node.ForEachChild(visit)
+		return false
+	}
+	file.Node.ForEachChild(visit)
+	if found == nil {
+		t.Fatalf("no node %q", text)
+	}
+	return found
+}
+
+func applyFixTestFix(file *ast.SourceFile, fix RuleFix) string {
+	code := file.Text()
+	return code[:fix.Range.Pos()] + fix.Text + code[fix.Range.End():]
+}
+
+func TestRuleFixInsertLines(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		code   string
+		node   string
+		text   string
+		after  bool
+		output string
+	}{
+		{
+			name:   "before",
+			code:   "function f() {\n  a();\n}",
+			node:   "a();",
+			text:   "b();",
+			output: "function f() {\n  b();\n  a();\n}",
+		},
+		{
+			name:   "before, multiple lines",
+			code:   "function f() {\n  a();\n}",
+			node:   "a();",
+			text:   "if (x) {\n  b();\n}",
+			output: "function f() {\n  if (x) {\n    b();\n  }\n  a();\n}",
+		},
+		{
+			name:   "before, CRLF",
+			code:   "function f() {\r\n  a();\r\n}",
+			node:   "a();",
+			text:   "if (x) {\n  b();\n}",
+			output: "function f() {\r\n  if (x) {\r\n    b();\r\n  }\r\n  a();\r\n}",
+		},
+		{
+			name:   "after",
+			code:   "function f() {\n  a();\n}",
+			node:   "a();",
+			text:   "b();",
+			after:  true,
+			output: "function f() {\n  a();\n  b();\n}",
+		},
+		{
+			name:   "after, keeping a trailing comment",
+			code:   "a(); // about a\nc();",
+			node:   "a();",
+			text:   "b();",
+			after:  true,
+			output: "a(); // about a\nb();\nc();",
+		},
+		{
+			name:   "after, CRLF",
+			code:   "\ta();\r\nc();",
+			node:   "a();",
+			text:   "if (x) {\n  b();\n}",
+			after:  true,
+			output: "\ta();\r\n\tif (x) {\r\n\t  b();\r\n\t}\r\nc();",
+		},
+	} {
+		file := parseFixTestFile(tc.code)
+		node := findFixTestNode(t, file, tc.node)
+		fix := RuleFixInsertLineBefore(file, node, tc.text)
+		if tc.after {
+			fix = RuleFixInsertLineAfter(file, node, tc.text)
+		}
+		assert.Equal(t, applyFixTestFix(file, fix), tc.output, tc.name)
+	}
+}
+
+func TestRuleFixRemoveLines(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		code   string
+		node   string
+		output string
+	}{
+		{
+			name:   "own line",
+			code:   "a();\n  b();\nc();\n",
+			node:   "b();",
+			output: "a();\nc();\n",
+		},
+		{
+			name:   "with comments",
+			code:   "a();\n// about b\nb(); // also about b\nc();\n",
+			node:   "b();",
+			output: "a();\nc();\n",
+		},
+		{
+			name:   "keeping comments separated by a blank line",
+			code:   "a();\n// unrelated\n\nb();\n",
+			node:   "b();",
+			output: "a();\n// unrelated\n\n",
+		},
+		{
+			name:   "last line",
+			code:   "a();\r\nb();",
+			node:   "b();",
+			output: "a();",
+		},
+		{
+			name:   "shared line",
+			code:   "a(); b();\n",
+			node:   "b();",
+			output: "a();\n",
+		},
+	} {
+		file := parseFixTestFile(tc.code)
+		fix := RuleFixRemoveLines(file, findFixTestNode(t, file, tc.node))
+		assert.Equal(t, applyFixTestFix(file, fix), tc.output, tc.name)
+	}
+}
+
+func TestRuleFixRemoveListElement(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		code   string
+		index  int
+		output string
+	}{
+		{name: "first", code: "f(a, b, c);", index: 0, output: "f(b, c);"},
+		{name: "middle", code: "f(a, b, c);", index: 1, output: "f(a, c);"},
+		{name: "last", code: "f(a, b, c);", index: 2, output: "f(a, b);"},
+		{name: "only", code: "f(a);", index: 0, output: "f();"},
+		{name: "trailing comma", code: "f(a, b,);", index: 1, output: "f(a,);"},
+		{name: "own line", code: "f(\n  a,\n  // about b\n  b,\n  c,\n);", index: 1, output: "f(\n  a,\n  c,\n);"},
+		{name: "own line, last", code: "f(\n  a,\n  b\n);", index: 1, output: "f(\n  a,\n);"},
+	} {
+		file := parseFixTestFile(tc.code)
+		call := findFixTestNode(t, file, "f"+tc.code[1:len(tc.code)-1])
+		fix := RuleFixRemoveListElement(file, call.AsCallExpression().Arguments, tc.index)
+		assert.Equal(t, applyFixTestFix(file, fix), tc.output, tc.name)
+	}
+}
+
+func TestNeedsParentheses(t *testing.T) {
+	for _, tc := range []struct {
+		code       string
+		precedence ast.OperatorPrecedence
+		expected   bool
+	}{
+		{"a + b", ast.OperatorPrecedenceMultiplicative, true},
+		{"a * b", ast.OperatorPrecedenceAdditive, false},
+		{"a || b", ast.OperatorPrecedenceLogicalOR, false},
+		{"a ?? b", ast.OperatorPrecedenceMember, true},
+		{"a.b", ast.OperatorPrecedenceMember, false},
+		{"f()", ast.OperatorPrecedenceLeftHandSide, false},
+		{"(a, b)", ast.OperatorPrecedenceMember, false},
+	} {
+		file := parseFixTestFile("x = " + tc.code + ";")
+		node := findFixTestNode(t, file, tc.code)
+		assert.Equal(t, NeedsParentheses(node, tc.precedence), tc.expected, tc.code)
+	}
+}
+
+func TestRuleFixReplaceWithNode(t *testing.T) {
+	for _, tc := range []struct {
+		code        string
+		target      string
+		replacement string
+		output      string
+	}{
+		{"x = await f();", "await f()", "f()", "x = f();"},
+		{"x = g(a || b) * c;", "g(a || b)", "a || b", "x = (a || b) * c;"},
+		{"x = g(a * b) + c;", "g(a * b)", "a * b", "x = (a * b) + c;"},
+		{"x = !g(a);", "!g(a)", "g(a)", "x = g(a);"},
+	} {
+		file := parseFixTestFile(tc.code)
+		fix := RuleFixReplaceWithNode(file, findFixTestNode(t, file, tc.target), findFixTestNode(t, file, tc.replacement))
+		assert.Equal(t, applyFixTestFix(file, fix), tc.output, tc.code)
+	}
+}
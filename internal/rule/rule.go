@@ -29,11 +29,50 @@ func ListenerOnNotAllowPattern(kind ast.Kind) ast.Kind {
 	return kind + lastOnAllowPatternOnExitTokenKind
 }
 
+// Called with the code path node (see IsCodePathNode) when a code path is
+// entered and left, including the source file. Code paths are entered after
+// the listeners of their node kind ran, and left before the listeners of
+// `ListenerOnExit(kind)` run.
+const (
+	ListenerOnCodePathStart ast.Kind = 7000
+	ListenerOnCodePathEnd   ast.Kind = 7001
+)
+
 type RuleListeners map[ast.Kind](func(node *ast.Node))
 
 type Rule struct {
 	Name string
 	Run  func(ctx RuleContext, options any) RuleListeners
+	// Optional. Creates the program-wide analysis of the rule, once per
+	// program, for rules which have to look at several files at once.
+	RunOnProgram func(options any) ProgramListeners
+}
+
+// Program-wide analysis of a rule, e.g. exports which are never imported.
+// Information is collected from each file when it is linted, and checked
+// once all files of the program were linted.
+type ProgramListeners struct {
+	// Called after the listeners of the rule ran on a file, with the context
+	// of the file. Files are linted concurrently by several workers, so it
+	// must not modify shared state. Its result is passed to OnProgramExit.
+	// Optional.
+	OnFileExit func(ctx RuleContext) any
+	// Called once all files were linted, on a single goroutine, with the
+	// results of OnFileExit.
+	OnProgramExit func(ctx ProgramContext, collected map[*ast.SourceFile]any)
+}
+
+type ProgramContext struct {
+	Program     *compiler.Program
+	TypeChecker *checker.Checker
+	// Files of the program linted with the rule enabled, in program order.
+	// Other files of the program, e.g. declaration files or files linted in a
+	// separate run, are only available through Program.
+	Files                     []*ast.SourceFile
+	ReportDiagnostic          func(file *ast.SourceFile, diagnostic RuleDiagnostic)
+	ReportNode                func(file *ast.SourceFile, node *ast.Node, msg RuleMessage)
+	ReportNodeWithFixes       func(file *ast.SourceFile, node *ast.Node, msg RuleMessage, fixesFn func() []RuleFix)
+	ReportNodeWithSuggestions func(file *ast.SourceFile, node *ast.Node, msg RuleMessage, suggestionsFn func() []RuleSuggestion)
 }
 
 type RuleMessage struct {
@@ -42,9 +81,89 @@ type RuleMessage struct {
 	Help        string
 }
 
+// Describes what applying a fix can change. The zero value is
+// RuleFixKindUnsafe, so fixes are considered unsafe unless a rule classifies
+// them with `WithKind` or `RuleFixesWithKind`.
+type RuleFixKind uint8
+
+const (
+	// The fix can change runtime behavior, e.g. replacing `||` with `??`.
+	RuleFixKindUnsafe RuleFixKind = iota
+	// The fix preserves runtime behavior.
+	RuleFixKindSafe
+	// The fix only changes formatting, such as whitespace or parentheses.
+	RuleFixKindLayout
+)
+
+func (k RuleFixKind) String() string {
+	switch k {
+	case RuleFixKindSafe:
+		return "safe"
+	case RuleFixKindUnsafe:
+		return "unsafe"
+	case RuleFixKindLayout:
+		return "layout"
+	}
+	return "unknown"
+}
+
+func ParseRuleFixKind(s string) (RuleFixKind, bool) {
+	switch s {
+	case "safe":
+		return RuleFixKindSafe, true
+	case "unsafe":
+		return RuleFixKindUnsafe, true
+	case "layout":
+		return RuleFixKindLayout, true
+	}
+	return 0, false
+}
+
 type RuleFix struct {
 	Text  string
 	Range core.TextRange
+	Kind  RuleFixKind
+	// File edited by the fix, empty for the file the diagnostic is reported
+	// in. The fixes of a diagnostic are applied together, across all files.
+	FileName string
+}
+
+func (f RuleFix) WithKind(kind RuleFixKind) RuleFix {
+	f.Kind = kind
+	return f
+}
+
+// Makes the fix edit another file than the one the diagnostic is reported in,
+// e.g. `RuleFixInsertBefore(other, node, "export ").InFile(other)`.
+func (f RuleFix) InFile(file *ast.SourceFile) RuleFix {
+	f.FileName = file.FileName()
+	return f
+}
+
+// Marks every fix of the slice with the given kind.
+func RuleFixesWithKind(kind RuleFixKind, fixes []RuleFix) []RuleFix {
+	for i := range fixes {
+		fixes[i].Kind = kind
+	}
+	return fixes
+}
+
+// Returns the kind of a set of fixes which are applied together: unsafe if any
+// fix is unsafe, layout if all fixes are layout-only, and safe otherwise.
+func RuleFixesKind(fixes []RuleFix) RuleFixKind {
+	if len(fixes) == 0 {
+		return RuleFixKindSafe
+	}
+	kind := RuleFixKindLayout
+	for _, fix := range fixes {
+		switch fix.Kind {
+		case RuleFixKindUnsafe:
+			return RuleFixKindUnsafe
+		case RuleFixKindSafe:
+			kind = RuleFixKindSafe
+		}
+	}
+	return kind
 }
 
 type RuleLabeledRange struct {
@@ -127,6 +246,14 @@ type RuleContext struct {
 	ReportNode                      func(node *ast.Node, msg RuleMessage)
 	ReportNodeWithFixes             func(node *ast.Node, msg RuleMessage, fixesFn func() []RuleFix)
 	ReportNodeWithSuggestions       func(node *ast.Node, msg RuleMessage, suggestionsFn func() []RuleSuggestion)
+	// Returns the analysis of the code path of a code path node, which is
+	// shared by all rules linting the file. See `CodePathNodeOf`.
+	CodePath func(node *ast.Node) *CodePath
+	// Returns the variables and references of the file, resolved on demand
+	// and shared by all rules linting the file.
+	Scope func() *ScopeManager
+	// Memoized type queries, shared by all rules linting the file
+	Types *TypeCache
 }
 
 func ReportNodeWithFixesOrSuggestions(ctx RuleContext, node *ast.Node, fix bool, msg RuleMessage, suggestionMsg RuleMessage, fixes ...RuleFix) {
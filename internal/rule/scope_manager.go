@@ -0,0 +1,250 @@
+package rule
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+)
+
+// Symbols which are variables in the sense of ESLint's scope manager, as
+// opposed to properties, methods or enum members.
+const scopeVariableFlags = ast.SymbolFlagsVariable | ast.SymbolFlagsFunction | ast.SymbolFlagsClass | ast.SymbolFlagsEnum | ast.SymbolFlagsInterface | ast.SymbolFlagsTypeAlias | ast.SymbolFlagsTypeParameter | ast.SymbolFlagsAlias | ast.SymbolFlagsModule
+
+// Index of the variables declared in a file and of their references, similar
+// to ESLint's scope manager. Names are resolved by the type checker, so
+// references to globals and to declarations of other files are not indexed,
+// except through the imports of the file.
+//
+// Identifiers are resolved when queried. Only listing the variables or the
+// references of a variable resolves every identifier of the file.
+type ScopeManager struct {
+	file    *ast.SourceFile
+	checker *checker.Checker
+	indexed bool
+	// In the order of their first declaration once indexed
+	variables []*Variable
+	// By first declaration
+	byDeclaration map[*ast.Node]*Variable
+	// By identifier of a declaration name or a reference, nil for identifiers
+	// resolved to no variable
+	byIdentifier map[*ast.Node]*Variable
+	references   map[*ast.Node]*Reference
+}
+
+type Variable struct {
+	Name   string
+	Symbol *ast.Symbol
+	// Declarations in the file, in source order
+	Declarations []*ast.Node
+	// The function, block, module or source file the variable is scoped to
+	Scope *ast.Node
+
+	manager    *ScopeManager
+	references []*Reference
+}
+
+type ReferenceFlags uint8
+
+const (
+	ReferenceFlagsRead ReferenceFlags = 1 << iota
+	ReferenceFlagsWrite
+	// e.g. `x += 1` or `x++`
+	ReferenceFlagsReadWrite = ReferenceFlagsRead | ReferenceFlagsWrite
+)
+
+type Reference struct {
+	Identifier *ast.Node
+	Variable   *Variable
+	Flags      ReferenceFlags
+}
+
+func (r *Reference) IsRead() bool {
+	return r.Flags&ReferenceFlagsRead != 0
+}
+
+func (r *Reference) IsWrite() bool {
+	return r.Flags&ReferenceFlagsWrite != 0
+}
+
+// Reports whether the reference is in another function than the scope of its
+// variable, as in ESLint: the variable is captured by a closure. Every
+// reference from inside a function to a module level variable is a closure,
+// not only the ones from functions nested in other functions.
+func (r *Reference) IsClosure() bool {
+	return enclosingFunction(r.Identifier.Parent) != enclosingFunction(r.Variable.Scope)
+}
+
+// In source order. Declarations are not references, even if they have an
+// initializer.
+func (v *Variable) References() []*Reference {
+	v.manager.index()
+	return v.references
+}
+
+// The linter creates one scope manager per file on first use; rules use
+// `RuleContext.Scope`.
+func NewScopeManager(file *ast.SourceFile, typeChecker *checker.Checker) *ScopeManager {
+	return &ScopeManager{
+		file:          file,
+		checker:       typeChecker,
+		byDeclaration: make(map[*ast.Node]*Variable),
+		byIdentifier:  make(map[*ast.Node]*Variable),
+		references:    make(map[*ast.Node]*Reference),
+	}
+}
+
+// Resolves every identifier of the file, collecting the references of the
+// variables in source order.
+func (m *ScopeManager) index() {
+	if m.indexed {
+		return
+	}
+	m.indexed = true
+
+	var visit ast.Visitor
+	visit = func(node *ast.Node) bool {
+		if node.Kind == ast.KindIdentifier {
+			m.resolve(node)
+			if reference := m.references[node]; reference != nil {
+				reference.Variable.references = append(reference.Variable.references, reference)
+			}
+			return false
+		}
+		node.ForEachChild(visit)
+		return false
+	}
+	m.file.Node.ForEachChild(visit)
+
+	slices.SortStableFunc(m.variables, func(a, b *Variable) int {
+		return a.Declarations[0].Pos() - b.Declarations[0].Pos()
+	})
+}
+
+func (m *ScopeManager) resolve(identifier *ast.Node) *Variable {
+	if variable, ok := m.byIdentifier[identifier]; ok {
+		return variable
+	}
+
+	var variable *Variable
+	parent := identifier.Parent
+	if ast.IsShorthandPropertyAssignment(parent) && parent.Name() == identifier {
+		// `{ x }` declares a property and references the variable
+		variable = m.addReference(identifier, checker.Checker_GetShorthandAssignmentValueSymbol(m.checker, parent))
+	} else if ast.IsDeclarationName(identifier) {
+		variable = m.variableOf(m.checker.GetSymbolAtLocation(identifier))
+	} else if !ast.IsIdentifierName(identifier) {
+		// Not a property name
+		variable = m.addReference(identifier, m.checker.GetSymbolAtLocation(identifier))
+	}
+	m.byIdentifier[identifier] = variable
+	return variable
+}
+
+func (m *ScopeManager) addReference(identifier *ast.Node, symbol *ast.Symbol) *Variable {
+	variable := m.variableOf(symbol)
+	if variable == nil {
+		return nil
+	}
+
+	flags := ReferenceFlagsRead
+	if ast.IsWriteOnlyAccess(identifier) {
+		flags = ReferenceFlagsWrite
+	} else if ast.IsWriteAccess(identifier) {
+		flags = ReferenceFlagsReadWrite
+	}
+
+	m.references[identifier] = &Reference{
+		Identifier: identifier,
+		Variable:   variable,
+		Flags:      flags,
+	}
+	return variable
+}
+
+// Returns the variable of symbol, or nil if symbol is not a variable declared
+// in the file. The local and the exported symbol of an exported declaration
+// are the same variable.
+func (m *ScopeManager) variableOf(symbol *ast.Symbol) *Variable {
+	if symbol == nil || symbol.Flags&scopeVariableFlags == 0 {
+		return nil
+	}
+
+	var declarations []*ast.Node
+	for _, declaration := range symbol.Declarations {
+		if ast.GetSourceFileOfNode(declaration) == m.file {
+			declarations = append(declarations, declaration)
+		}
+	}
+	if len(declarations) == 0 {
+		return nil
+	}
+	slices.SortFunc(declarations, func(a, b *ast.Node) int {
+		return a.Pos() - b.Pos()
+	})
+
+	if variable, ok := m.byDeclaration[declarations[0]]; ok {
+		return variable
+	}
+	variable := &Variable{
+		Name:         symbol.Name,
+		Symbol:       symbol,
+		Declarations: declarations,
+		Scope:        scopeOf(declarations[0]),
+		manager:      m,
+	}
+	m.byDeclaration[declarations[0]] = variable
+	m.variables = append(m.variables, variable)
+	return variable
+}
+
+// Returns the variables declared in the file, in the order of their first
+// declaration.
+func (m *ScopeManager) Variables() []*Variable {
+	m.index()
+	return m.variables
+}
+
+// Returns the variable identifier declares or references, or nil if it is not
+// a variable declared in the file.
+func (m *ScopeManager) Resolve(identifier *ast.Node) *Variable {
+	return m.resolve(identifier)
+}
+
+// Returns the reference of identifier, or nil if it does not reference a
+// variable declared in the file.
+func (m *ScopeManager) Reference(identifier *ast.Node) *Reference {
+	m.resolve(identifier)
+	return m.references[identifier]
+}
+
+// Returns the symbol of the same name, meaning the same kind of entity, which
+// variable shadows in the enclosing scopes, or nil if there is none. Variables
+// of the source file are not considered to shadow globals.
+func (m *ScopeManager) Shadowed(variable *Variable) *ast.Symbol {
+	if variable.Scope.Parent == nil {
+		return nil
+	}
+	meaning := variable.Symbol.Flags & (ast.SymbolFlagsValue | ast.SymbolFlagsType | ast.SymbolFlagsNamespace)
+	if variable.Symbol.Flags&ast.SymbolFlagsAlias != 0 {
+		meaning = ast.SymbolFlagsValue | ast.SymbolFlagsType | ast.SymbolFlagsNamespace
+	}
+	return m.checker.ResolveName(variable.Name, variable.Scope.Parent, meaning, false)
+}
+
+// `var` declarations and parameters are scoped to their function, other
+// declarations to their block.
+func scopeOf(declaration *ast.Node) *ast.Node {
+	if (ast.IsVariableDeclaration(declaration) || declaration.Kind == ast.KindBindingElement) && !ast.IsBlockOrCatchScoped(declaration) {
+		return ast.FindAncestor(declaration.Parent, func(node *ast.Node) bool {
+			return ast.IsFunctionLikeOrClassStaticBlockDeclaration(node) || node.Kind == ast.KindModuleBlock || node.Kind == ast.KindSourceFile
+		})
+	}
+	return ast.GetEnclosingBlockScopeContainer(declaration)
+}
+
+// Returns the innermost function or class static block containing node,
+// including node itself, or nil at the top level.
+func enclosingFunction(node *ast.Node) *ast.Node {
+	return ast.FindAncestor(node, ast.IsFunctionLikeOrClassStaticBlockDeclaration)
+}
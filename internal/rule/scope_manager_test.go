@@ -0,0 +1,98 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestScopeManager(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `
+export let count = 0;
+function increment(step: number) {
+	count += step;
+	const inner = () => count;
+	return inner;
+}
+function shadow() {
+	const count = 1;
+	return { count };
+}
+console.log(increment);
+`
+	fs := utils.NewOverlayVFSForFile(filePath, code)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", utils.CreateCompilerHost(rootDir, fs), false)
+	assert.NilError(t, err, "couldn't create program")
+	typeChecker, done := program.GetTypeChecker(t.Context())
+	defer done()
+
+	m := NewScopeManager(program.GetSourceFile(filePath), typeChecker)
+
+	var names []string
+	variables := make(map[string][]*Variable)
+	for _, variable := range m.Variables() {
+		names = append(names, variable.Name)
+		variables[variable.Name] = append(variables[variable.Name], variable)
+	}
+	assert.DeepEqual(t, names, []string{"count", "increment", "step", "inner", "shadow", "count"})
+
+	count := variables["count"][0]
+	assert.Equal(t, count.Scope.Kind, ast.KindSourceFile)
+	assert.Equal(t, len(count.References()), 2)
+	assert.Equal(t, count.References()[0].Flags, ReferenceFlagsReadWrite)
+	// `increment` is another function than the SourceFile scope of `count`
+	assert.Assert(t, count.References()[0].IsClosure())
+	assert.Equal(t, count.References()[1].Flags, ReferenceFlagsRead)
+	assert.Assert(t, count.References()[1].IsClosure())
+	assert.Assert(t, m.Shadowed(count) == nil)
+
+	shadowing := variables["count"][1]
+	assert.Equal(t, shadowing.Scope.Kind, ast.KindBlock)
+	// The shorthand property
+	assert.Equal(t, len(shadowing.References()), 1)
+	assert.Assert(t, m.Shadowed(shadowing) != nil)
+
+	assert.Equal(t, len(variables["increment"][0].References()), 1)
+	assert.Assert(t, !variables["increment"][0].References()[0].IsClosure())
+	assert.Assert(t, !variables["step"][0].References()[0].IsClosure())
+	assert.Equal(t, variables["step"][0].Scope.Kind, ast.KindFunctionDeclaration)
+}
+
+func TestScopeManager_ResolvesLazily(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `
+let value = 1;
+value = 2;
+console.log(value);
+`
+	fs := utils.NewOverlayVFSForFile(filePath, code)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", utils.CreateCompilerHost(rootDir, fs), false)
+	assert.NilError(t, err, "couldn't create program")
+	typeChecker, done := program.GetTypeChecker(t.Context())
+	defer done()
+
+	file := program.GetSourceFile(filePath)
+	m := NewScopeManager(file, typeChecker)
+
+	call := file.Statements.Nodes[2].AsExpressionStatement().Expression.AsCallExpression()
+	read := call.Arguments.Nodes[0]
+	reference := m.Reference(read)
+	assert.Assert(t, reference != nil)
+	assert.Equal(t, reference.Flags, ReferenceFlagsRead)
+	assert.Assert(t, m.Resolve(call.Expression.Expression()) == nil, "console is a global")
+	assert.Assert(t, !m.indexed, "queries should only resolve their identifier")
+
+	variable := reference.Variable
+	assert.Equal(t, len(variable.References()), 2)
+	assert.Equal(t, variable.References()[0].Flags, ReferenceFlagsWrite)
+	assert.Assert(t, variable.References()[1] == reference, "the queried reference should be reused")
+	assert.Assert(t, m.indexed)
+}
@@ -0,0 +1,576 @@
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+)
+
+// Selectors describe the nodes a listener runs on, like ESLint's ESQuery
+// selectors but with the node kinds of the TypeScript AST:
+//
+//	CallExpression > PropertyAccessExpression.expression[name=then]
+//	BinaryExpression[operator="??"][left.kind=Identifier]
+//	FunctionDeclaration ReturnStatement:exit
+//
+// Supported are the descendant (` `) and child (`>`) combinators, `*`,
+// attributes (`[path]`, `[path=value]`, `[path!=value]`, `[path=/regexp/]`),
+// fields (`.expression`: the node is that child of its parent), `:not(...)`,
+// `:matches(...)` and `:is(...)` with lists of compound selectors, and a
+// trailing `:exit`.
+//
+// Attribute paths name children (expression, name, left, right, operand,
+// initializer, body, type, argumentExpression) followed by kind, text or
+// operator. A child without a property compares as its text, or as its kind if
+// it has no text.
+//
+// The subject of a selector, its rightmost part, must name node kinds, so
+// listeners are dispatched by kind like the other listeners.
+type Selector struct {
+	source string
+	// Kinds of the subject
+	kinds  []ast.Kind
+	onExit bool
+	// The subject is last
+	compounds []*compoundSelector
+	// combinators[i] is between compounds[i] and compounds[i+1], ' ' or '>'
+	combinators []byte
+}
+
+type compoundSelector struct {
+	// Empty for any kind
+	kindName   string
+	kind       ast.Kind
+	field      string
+	attributes []*attributeSelector
+	not        []*compoundSelector
+	matches    []*compoundSelector
+}
+
+type attributeSelector struct {
+	path []string
+	// Empty if the attribute only has to exist
+	operator string
+	value    string
+	regexp   *regexp.Regexp
+}
+
+var parsedSelectors sync.Map
+
+// Adds a listener for the nodes matched by selector, after the listeners
+// already registered for their kind. Selectors are parsed once and cached, so
+// this is cheap to call in `Rule.Run`. Panics if selector is invalid.
+//
+// The listener is chained onto l[kind], so assigning l[kind] afterwards
+// replaces it. Assign listeners by kind first, then add the selectors.
+func (l RuleListeners) AddSelector(selector string, listener func(node *ast.Node)) {
+	var s *Selector
+	if parsed, ok := parsedSelectors.Load(selector); ok {
+		s = parsed.(*Selector)
+	} else {
+		var err error
+		s, err = ParseSelector(selector)
+		if err != nil {
+			panic(err)
+		}
+		parsedSelectors.Store(selector, s)
+	}
+
+	for _, kind := range s.kinds {
+		if s.onExit {
+			kind = ListenerOnExit(kind)
+		}
+		previous := l[kind]
+		l[kind] = func(node *ast.Node) {
+			if previous != nil {
+				previous(node)
+			}
+			if s.Match(node) {
+				listener(node)
+			}
+		}
+	}
+}
+
+func ParseSelector(source string) (*Selector, error) {
+	p := selectorParser{source: source}
+	s := &Selector{source: source}
+
+	p.skipSpaces()
+	for {
+		compound, err := p.compound(true)
+		if err != nil {
+			return nil, err
+		}
+		s.compounds = append(s.compounds, compound)
+
+		hadSpaces := p.skipSpaces()
+		if p.eof() {
+			break
+		}
+		if p.exit {
+			return nil, p.errorf("`:exit` must end the selector")
+		}
+		if p.peek() == '>' {
+			p.pos++
+			p.skipSpaces()
+			s.combinators = append(s.combinators, '>')
+		} else if hadSpaces {
+			s.combinators = append(s.combinators, ' ')
+		} else {
+			return nil, p.errorf("unexpected %q", p.peek())
+		}
+	}
+	s.onExit = p.exit
+
+	for _, compound := range s.compounds {
+		if err := compound.resolveKinds(); err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", source, err)
+		}
+	}
+	subject := s.compounds[len(s.compounds)-1]
+	s.kinds = subject.subjectKinds()
+	if len(s.kinds) == 0 {
+		return nil, fmt.Errorf("invalid selector %q: the subject must name node kinds, e.g. `CallExpression` or `:matches(Identifier, ThisKeyword)`", source)
+	}
+	return s, nil
+}
+
+func (s *Selector) String() string {
+	return s.source
+}
+
+// Reports whether node matches the selector, looking at its ancestors for
+// combinators. Does not consider `:exit`.
+func (s *Selector) Match(node *ast.Node) bool {
+	return s.matchAt(len(s.compounds)-1, node)
+}
+
+func (s *Selector) matchAt(index int, node *ast.Node) bool {
+	if node == nil || !s.compounds[index].match(node) {
+		return false
+	}
+	if index == 0 {
+		return true
+	}
+	if s.combinators[index-1] == '>' {
+		return s.matchAt(index-1, node.Parent)
+	}
+	for ancestor := node.Parent; ancestor != nil; ancestor = ancestor.Parent {
+		if s.matchAt(index-1, ancestor) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *compoundSelector) match(node *ast.Node) bool {
+	if c.kindName != "" && node.Kind != c.kind {
+		return false
+	}
+	if c.field != "" && (node.Parent == nil || selectorChild(node.Parent, c.field) != node) {
+		return false
+	}
+	for _, attribute := range c.attributes {
+		if !attribute.match(node) {
+			return false
+		}
+	}
+	for _, not := range c.not {
+		if not.match(node) {
+			return false
+		}
+	}
+	if len(c.matches) > 0 {
+		for _, matches := range c.matches {
+			if matches.match(node) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (c *compoundSelector) resolveKinds() error {
+	if c.kindName != "" {
+		kind, ok := kindsByName()[c.kindName]
+		if !ok {
+			return fmt.Errorf("unknown node kind %q", c.kindName)
+		}
+		c.kind = kind
+	}
+	for _, nested := range [][]*compoundSelector{c.not, c.matches} {
+		for _, compound := range nested {
+			if err := compound.resolveKinds(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the kinds a node matched by c can have, or nil if it can have any.
+func (c *compoundSelector) subjectKinds() []ast.Kind {
+	if c.kindName != "" {
+		return []ast.Kind{c.kind}
+	}
+	var kinds []ast.Kind
+	for _, matches := range c.matches {
+		matchesKinds := matches.subjectKinds()
+		if matchesKinds == nil {
+			return nil
+		}
+		kinds = append(kinds, matchesKinds...)
+	}
+	return kinds
+}
+
+var kindsByName = sync.OnceValue(func() map[string]ast.Kind {
+	kinds := make(map[string]ast.Kind, int(ast.KindCount))
+	for kind := range ast.KindCount {
+		kinds[strings.TrimPrefix(kind.String(), "Kind")] = kind
+	}
+	return kinds
+})
+
+func (a *attributeSelector) match(node *ast.Node) bool {
+	value, ok := selectorAttribute(node, a.path)
+	switch {
+	case a.operator == "":
+		return ok
+	case !ok:
+		return a.operator == "!="
+	case a.regexp != nil:
+		return a.regexp.MatchString(value) == (a.operator == "=")
+	default:
+		return (value == a.value) == (a.operator == "=")
+	}
+}
+
+func selectorAttribute(node *ast.Node, path []string) (string, bool) {
+	for i, name := range path {
+		if i == len(path)-1 {
+			switch name {
+			case "kind":
+				return strings.TrimPrefix(node.Kind.String(), "Kind"), true
+			case "text":
+				return selectorText(node)
+			case "operator":
+				return selectorOperator(node)
+			}
+		}
+		node = selectorChild(node, name)
+		if node == nil {
+			return "", false
+		}
+	}
+	if text, ok := selectorText(node); ok {
+		return text, true
+	}
+	return strings.TrimPrefix(node.Kind.String(), "Kind"), true
+}
+
+// Returns the child of node with the given name, or nil if node has no such
+// child.
+func selectorChild(node *ast.Node, name string) *ast.Node {
+	switch name {
+	case "expression":
+		switch node.Kind {
+		case ast.KindCallExpression, ast.KindNewExpression, ast.KindPropertyAccessExpression, ast.KindElementAccessExpression,
+			ast.KindParenthesizedExpression, ast.KindAwaitExpression, ast.KindNonNullExpression, ast.KindAsExpression,
+			ast.KindSatisfiesExpression, ast.KindTypeAssertionExpression, ast.KindSpreadElement, ast.KindSpreadAssignment,
+			ast.KindExpressionStatement, ast.KindReturnStatement, ast.KindThrowStatement, ast.KindVoidExpression,
+			ast.KindTypeOfExpression, ast.KindDeleteExpression, ast.KindIfStatement, ast.KindWhileStatement,
+			ast.KindDoStatement, ast.KindSwitchStatement, ast.KindForInStatement, ast.KindForOfStatement,
+			ast.KindTemplateSpan, ast.KindComputedPropertyName, ast.KindDecorator, ast.KindExpressionWithTypeArguments:
+			return node.Expression()
+		}
+	case "name":
+		switch node.Kind {
+		case ast.KindPropertyAccessExpression, ast.KindFunctionDeclaration, ast.KindFunctionExpression, ast.KindClassDeclaration,
+			ast.KindClassExpression, ast.KindMethodDeclaration, ast.KindMethodSignature, ast.KindPropertyDeclaration,
+			ast.KindPropertySignature, ast.KindPropertyAssignment, ast.KindShorthandPropertyAssignment, ast.KindGetAccessor,
+			ast.KindSetAccessor, ast.KindVariableDeclaration, ast.KindParameter, ast.KindBindingElement,
+			ast.KindInterfaceDeclaration, ast.KindTypeAliasDeclaration, ast.KindEnumDeclaration, ast.KindEnumMember,
+			ast.KindModuleDeclaration, ast.KindImportSpecifier, ast.KindExportSpecifier, ast.KindTypeParameter:
+			return node.Name()
+		}
+	case "left":
+		switch node.Kind {
+		case ast.KindBinaryExpression:
+			return node.AsBinaryExpression().Left
+		case ast.KindQualifiedName:
+			return node.AsQualifiedName().Left
+		}
+	case "right":
+		switch node.Kind {
+		case ast.KindBinaryExpression:
+			return node.AsBinaryExpression().Right
+		case ast.KindQualifiedName:
+			return node.AsQualifiedName().Right
+		}
+	case "operand":
+		switch node.Kind {
+		case ast.KindPrefixUnaryExpression:
+			return node.AsPrefixUnaryExpression().Operand
+		case ast.KindPostfixUnaryExpression:
+			return node.AsPostfixUnaryExpression().Operand
+		}
+	case "initializer":
+		switch node.Kind {
+		case ast.KindVariableDeclaration, ast.KindParameter, ast.KindPropertyDeclaration, ast.KindPropertyAssignment,
+			ast.KindBindingElement, ast.KindEnumMember:
+			return node.Initializer()
+		}
+	case "body":
+		if ast.IsFunctionLikeDeclaration(node) || node.Kind == ast.KindModuleDeclaration || node.Kind == ast.KindClassStaticBlockDeclaration {
+			return node.Body()
+		}
+	case "type":
+		switch node.Kind {
+		case ast.KindVariableDeclaration, ast.KindParameter, ast.KindPropertyDeclaration, ast.KindPropertySignature,
+			ast.KindAsExpression, ast.KindSatisfiesExpression, ast.KindTypeAssertionExpression, ast.KindTypeAliasDeclaration:
+			return node.Type()
+		}
+		if ast.IsFunctionLikeDeclaration(node) {
+			return node.Type()
+		}
+	case "argumentExpression":
+		if node.Kind == ast.KindElementAccessExpression {
+			return node.AsElementAccessExpression().ArgumentExpression
+		}
+	}
+	return nil
+}
+
+func selectorText(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindIdentifier, ast.KindPrivateIdentifier, ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindBigIntLiteral,
+		ast.KindNoSubstitutionTemplateLiteral, ast.KindRegularExpressionLiteral:
+		return node.Text(), true
+	}
+	return "", false
+}
+
+func selectorOperator(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindBinaryExpression:
+		return scanner.TokenToString(node.AsBinaryExpression().OperatorToken.Kind), true
+	case ast.KindPrefixUnaryExpression:
+		return scanner.TokenToString(node.AsPrefixUnaryExpression().Operator), true
+	case ast.KindPostfixUnaryExpression:
+		return scanner.TokenToString(node.AsPostfixUnaryExpression().Operator), true
+	}
+	return "", false
+}
+
+type selectorParser struct {
+	source string
+	pos    int
+	// Whether `:exit` was parsed
+	exit bool
+}
+
+func (p *selectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid selector %q at %d: %s", p.source, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *selectorParser) eof() bool {
+	return p.pos >= len(p.source)
+}
+
+func (p *selectorParser) peek() byte {
+	return p.source[p.pos]
+}
+
+func (p *selectorParser) skipSpaces() bool {
+	start := p.pos
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t' || p.peek() == '\n') {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) expect(c byte) error {
+	if p.eof() || p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+func isSelectorIdentifierChar(c byte, first bool) bool {
+	return c == '_' || c == '$' || c == '-' && !first || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' && !first
+}
+
+func (p *selectorParser) identifier() (string, error) {
+	start := p.pos
+	for !p.eof() && isSelectorIdentifierChar(p.peek(), p.pos == start) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("expected a name")
+	}
+	return p.source[start:p.pos], nil
+}
+
+func (p *selectorParser) compound(allowExit bool) (*compoundSelector, error) {
+	c := &compoundSelector{}
+	start := p.pos
+
+	if !p.eof() && p.peek() == '*' {
+		p.pos++
+	} else if !p.eof() && isSelectorIdentifierChar(p.peek(), true) {
+		c.kindName, _ = p.identifier()
+	}
+
+	for !p.eof() {
+		switch p.peek() {
+		case '[':
+			attribute, err := p.attribute()
+			if err != nil {
+				return nil, err
+			}
+			c.attributes = append(c.attributes, attribute)
+			continue
+		case '.':
+			p.pos++
+			field, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			c.field = field
+			continue
+		case ':':
+			p.pos++
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			switch name {
+			case "exit":
+				if !allowExit {
+					return nil, p.errorf("`:exit` must end the selector")
+				}
+				p.exit = true
+			case "not", "matches", "is":
+				list, err := p.compoundList()
+				if err != nil {
+					return nil, err
+				}
+				if name == "not" {
+					c.not = append(c.not, list...)
+				} else if c.matches == nil {
+					c.matches = list
+				} else {
+					return nil, p.errorf("only one `:%s(...)` is supported per compound selector", name)
+				}
+			default:
+				return nil, p.errorf("unknown pseudo-class `:%s`", name)
+			}
+			if p.exit && !p.eof() && p.peek() != ' ' {
+				return nil, p.errorf("`:exit` must end the selector")
+			}
+			continue
+		}
+		break
+	}
+
+	if p.pos == start {
+		if p.eof() {
+			return nil, p.errorf("expected a selector")
+		}
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return c, nil
+}
+
+// Parses `(a, b)`.
+func (p *selectorParser) compoundList() ([]*compoundSelector, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var list []*compoundSelector
+	for {
+		p.skipSpaces()
+		compound, err := p.compound(false)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, compound)
+		p.skipSpaces()
+		if !p.eof() && p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		return list, p.expect(')')
+	}
+}
+
+// Parses `[path]`, `[path=value]`, `[path!=value]` or `[path=/regexp/]`.
+func (p *selectorParser) attribute() (*attributeSelector, error) {
+	a := &attributeSelector{}
+	p.pos++ // [
+	p.skipSpaces()
+	for {
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		a.path = append(a.path, name)
+		if p.eof() || p.peek() != '.' {
+			break
+		}
+		p.pos++
+	}
+	p.skipSpaces()
+
+	switch {
+	case strings.HasPrefix(p.source[p.pos:], "!="):
+		a.operator = "!="
+		p.pos += 2
+	case strings.HasPrefix(p.source[p.pos:], "="):
+		a.operator = "="
+		p.pos++
+	}
+
+	if a.operator != "" {
+		p.skipSpaces()
+		if p.eof() {
+			return nil, p.errorf("expected a value")
+		}
+		switch quote := p.peek(); quote {
+		case '"', '\'', '/':
+			end := strings.IndexByte(p.source[p.pos+1:], quote)
+			if end < 0 {
+				return nil, p.errorf("unterminated value")
+			}
+			a.value = p.source[p.pos+1 : p.pos+1+end]
+			p.pos += end + 2
+			if quote == '/' {
+				re, err := regexp.Compile(a.value)
+				if err != nil {
+					return nil, p.errorf("invalid regexp: %v", err)
+				}
+				a.regexp = re
+			}
+		default:
+			start := p.pos
+			for !p.eof() && p.peek() != ']' && p.peek() != ' ' {
+				p.pos++
+			}
+			a.value = p.source[start:p.pos]
+		}
+		p.skipSpaces()
+	}
+
+	if err := p.expect(']'); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
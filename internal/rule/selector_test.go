@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/parser"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseSelector(t *testing.T) {
+	s, err := ParseSelector("CallExpression > PropertyAccessExpression.expression[name=then]:exit")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, s.kinds, []ast.Kind{ast.KindPropertyAccessExpression})
+	assert.Assert(t, s.onExit)
+	assert.DeepEqual(t, s.combinators, []byte{'>'})
+	assert.Equal(t, s.compounds[1].field, "expression")
+	assert.DeepEqual(t, s.compounds[1].attributes[0].path, []string{"name"})
+	assert.Equal(t, s.compounds[1].attributes[0].value, "then")
+
+	s, err = ParseSelector(`:matches(Identifier, ThisKeyword):not([text=/^_/])`)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, s.kinds, []ast.Kind{ast.KindIdentifier, ast.KindThisKeyword})
+
+	for _, invalid := range []string{
+		"",
+		"*",
+		"[name=foo]",
+		"NoSuchKind",
+		"CallExpression:exit > Identifier",
+		"CallExpression[name",
+		"CallExpression:first-child",
+		"CallExpression,Identifier",
+	} {
+		_, err := ParseSelector(invalid)
+		assert.Assert(t, err != nil, "expected %q to be invalid", invalid)
+	}
+}
+
+func TestSelectorMatch(t *testing.T) {
+	code := `
+promise.then(() => {});
+other.then;
+a ?? b;
+this.x ?? b;
+`
+	file := parser.ParseSourceFile(ast.SourceFileParseOptions{FileName: "/file.ts", Path: "/file.ts"}, code, core.ScriptKindTS)
+
+	matches := func(selector string) []string {
+		s, err := ParseSelector(selector)
+		assert.NilError(t, err)
+		var matched []string
+		var visit ast.Visitor
+		visit = func(node *ast.Node) bool {
+			if node.Kind == s.kinds[0] && s.Match(node) {
+				matched = append(matched, file.Text()[node.Pos():node.End()])
+			}
+			node.ForEachChild(visit)
+			return false
+		}
+		file.Node.ForEachChild(visit)
+		return matched
+	}
+
+	assert.DeepEqual(t, matches("CallExpression > PropertyAccessExpression.expression[name=then]"), []string{"\npromise.then"})
+	assert.DeepEqual(t, matches(`BinaryExpression[operator="??"][left.kind=Identifier]`), []string{"\na ?? b"})
+	assert.DeepEqual(t, matches(`ExpressionStatement ArrowFunction`), []string{"() => {}"})
+	assert.DeepEqual(t, matches(`PropertyAccessExpression:not([expression.kind=ThisKeyword])[name!=then]`), []string(nil))
+}
@@ -0,0 +1,162 @@
+package rule
+
+import (
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// Memoizes the type queries rules repeat on the same nodes and types. One
+// cache is shared by all rules linting a file, and reset for the next file.
+type TypeCache struct {
+	program *compiler.Program
+	checker *checker.Checker
+
+	typeAtLocation            map[*ast.Node]*checker.Type
+	constrainedTypeAtLocation map[*ast.Node]*checker.Type
+	unionTypeParts            map[*checker.Type][]*checker.Type
+	thenable                  map[thenableQuery]bool
+	promiseLike               map[*checker.Type]bool
+
+	stats TypeCacheStats
+
+	// See EnableTiming
+	timed bool
+	// Nesting of the queries being computed, so that queries made by other
+	// queries are not measured twice
+	depth       int
+	checkerTime time.Duration
+}
+
+type thenableQuery struct {
+	node *ast.Node
+	t    *checker.Type
+}
+
+type TypeCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+func (s *TypeCacheStats) Add(other TypeCacheStats) {
+	s.Hits += other.Hits
+	s.Misses += other.Misses
+}
+
+// Returns the percentage of queries answered from the cache.
+func (s TypeCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses) * 100
+}
+
+func NewTypeCache() *TypeCache {
+	return &TypeCache{
+		typeAtLocation:            make(map[*ast.Node]*checker.Type),
+		constrainedTypeAtLocation: make(map[*ast.Node]*checker.Type),
+		unionTypeParts:            make(map[*checker.Type][]*checker.Type),
+		thenable:                  make(map[thenableQuery]bool),
+		promiseLike:               make(map[*checker.Type]bool),
+	}
+}
+
+// Drops the cached queries, e.g. when moving to the next file. The statistics
+// are kept.
+func (c *TypeCache) Reset(program *compiler.Program, typeChecker *checker.Checker) {
+	c.program = program
+	c.checker = typeChecker
+	clear(c.typeAtLocation)
+	clear(c.constrainedTypeAtLocation)
+	clear(c.unionTypeParts)
+	clear(c.thenable)
+	clear(c.promiseLike)
+}
+
+func (c *TypeCache) Stats() TypeCacheStats {
+	return c.stats
+}
+
+// Measures the time spent in the type checker, i.e. computing the queries which
+// miss the cache and the uncached queries, see type_cache_checker.go.
+func (c *TypeCache) EnableTiming() {
+	c.timed = true
+}
+
+// Returns the time spent in the type checker since the last call, see
+// EnableTiming.
+func (c *TypeCache) TakeCheckerTime() time.Duration {
+	checkerTime := c.checkerTime
+	c.checkerTime = 0
+	return checkerTime
+}
+
+func memoize[K comparable, V any](c *TypeCache, cache map[K]V, key K, compute func() V) V {
+	if value, ok := cache[key]; ok {
+		c.stats.Hits++
+		return value
+	}
+	c.stats.Misses++
+
+	value := timed(c, compute)
+	cache[key] = value
+	return value
+}
+
+// Measures the time of a type checker query, see EnableTiming.
+func timed[V any](c *TypeCache, query func() V) V {
+	if !c.timed || c.depth > 0 {
+		return query()
+	}
+	start := time.Now()
+	c.depth++
+	value := query()
+	c.depth--
+	c.checkerTime += time.Since(start)
+	return value
+}
+
+func (c *TypeCache) GetTypeAtLocation(node *ast.Node) *checker.Type {
+	return memoize(c, c.typeAtLocation, node, func() *checker.Type {
+		return c.checker.GetTypeAtLocation(node)
+	})
+}
+
+// See `utils.GetConstrainedTypeAtLocation`.
+func (c *TypeCache) GetConstrainedTypeAtLocation(node *ast.Node) *checker.Type {
+	return memoize(c, c.constrainedTypeAtLocation, node, func() *checker.Type {
+		nodeType := c.GetTypeAtLocation(node)
+		if constraint := checker.Checker_getBaseConstraintOfType(c.checker, nodeType); constraint != nil {
+			return constraint
+		}
+		return nodeType
+	})
+}
+
+// See `utils.UnionTypeParts`. The returned slice is shared and must not be
+// modified.
+func (c *TypeCache) UnionTypeParts(t *checker.Type) []*checker.Type {
+	return memoize(c, c.unionTypeParts, t, func() []*checker.Type {
+		return utils.UnionTypeParts(t)
+	})
+}
+
+// See `utils.IsThenableType`.
+func (c *TypeCache) IsThenableType(node *ast.Node, t *checker.Type) bool {
+	if t == nil {
+		t = c.GetTypeAtLocation(node)
+	}
+	return memoize(c, c.thenable, thenableQuery{node, t}, func() bool {
+		return utils.IsThenableType(c.checker, node, t)
+	})
+}
+
+// See `utils.IsPromiseLike`.
+func (c *TypeCache) IsPromiseLike(t *checker.Type) bool {
+	return memoize(c, c.promiseLike, t, func() bool {
+		return utils.IsPromiseLike(c.program, c.checker, t)
+	})
+}
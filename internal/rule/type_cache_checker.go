@@ -0,0 +1,300 @@
+package rule
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+)
+
+// The type checker queries which are not worth caching. Rules make them
+// through the cache anyway, so that their time is measured, see EnableTiming.
+
+func (c *TypeCache) GetAccessedPropertyName(access *ast.Node) (string, bool) {
+	var ok bool
+	name := timed(c, func() string {
+		var name string
+		name, ok = checker.Checker_getAccessedPropertyName(c.checker, access)
+		return name
+	})
+	return name, ok
+}
+
+func (c *TypeCache) GetAliasedSymbol(symbol *ast.Symbol) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return c.checker.GetAliasedSymbol(symbol)
+	})
+}
+
+func (c *TypeCache) GetApparentType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getApparentType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetApparentTypeOfContextualType(node *ast.Node, contextFlags checker.ContextFlags) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getApparentTypeOfContextualType(c.checker, node, contextFlags)
+	})
+}
+
+func (c *TypeCache) GetAwaitedType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getAwaitedType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetBaseConstraintOfType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getBaseConstraintOfType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetBaseTypeOfLiteralType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getBaseTypeOfLiteralType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetBaseTypes(t *checker.Type) []*checker.Type {
+	return timed(c, func() []*checker.Type {
+		return checker.Checker_getBaseTypes(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetCallSignatures(t *checker.Type) []*checker.Signature {
+	return timed(c, func() []*checker.Signature {
+		return utils.GetCallSignatures(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetConstraintOfTypeParameter(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return c.checker.GetConstraintOfTypeParameter(t)
+	})
+}
+
+func (c *TypeCache) GetConstructSignatures(t *checker.Type) []*checker.Signature {
+	return timed(c, func() []*checker.Signature {
+		return utils.GetConstructSignatures(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetContextFreeTypeOfExpression(node *ast.Node) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getContextFreeTypeOfExpression(c.checker, node)
+	})
+}
+
+func (c *TypeCache) GetContextualType(node *ast.Node, contextFlags checker.ContextFlags) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getContextualType(c.checker, node, contextFlags)
+	})
+}
+
+func (c *TypeCache) GetContextualTypeForArgumentAtIndex(callTarget *ast.Node, argIndex int) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getContextualTypeForArgumentAtIndex(c.checker, callTarget, argIndex)
+	})
+}
+
+func (c *TypeCache) GetDeclarationOfAliasSymbol(symbol *ast.Symbol) *ast.Node {
+	return timed(c, func() *ast.Node {
+		return checker.Checker_getDeclarationOfAliasSymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) GetDeclaredTypeOfSymbol(symbol *ast.Symbol) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getDeclaredTypeOfSymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) GetExportSymbolOfSymbol(symbol *ast.Symbol) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return c.checker.GetExportSymbolOfSymbol(symbol)
+	})
+}
+
+func (c *TypeCache) GetImmediateAliasedSymbol(symbol *ast.Symbol) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return checker.Checker_getImmediateAliasedSymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) GetIndexInfosOfType(t *checker.Type) []*checker.IndexInfo {
+	return timed(c, func() []*checker.IndexInfo {
+		return checker.Checker_getIndexInfosOfType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetIndexTypeOfType(t *checker.Type, keyType *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getIndexTypeOfType(c.checker, t, keyType)
+	})
+}
+
+func (c *TypeCache) GetNonNullableType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_GetNonNullableType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetNumberIndexType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return utils.GetNumberIndexType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetPropertiesOfType(t *checker.Type) []*ast.Symbol {
+	return timed(c, func() []*ast.Symbol {
+		return checker.Checker_getPropertiesOfType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetPropertyOfType(t *checker.Type, name string) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return checker.Checker_getPropertyOfType(c.checker, t, name)
+	})
+}
+
+func (c *TypeCache) GetResolvedSignature(node *ast.Node) *checker.Signature {
+	return timed(c, func() *checker.Signature {
+		return checker.Checker_getResolvedSignature(c.checker, node, nil, checker.CheckModeNormal)
+	})
+}
+
+func (c *TypeCache) GetReturnTypeOfSignature(signature *checker.Signature) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getReturnTypeOfSignature(c.checker, signature)
+	})
+}
+
+func (c *TypeCache) GetShorthandAssignmentValueSymbol(location *ast.Node) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return checker.Checker_GetShorthandAssignmentValueSymbol(c.checker, location)
+	})
+}
+
+func (c *TypeCache) GetSignatureFromDeclaration(declaration *ast.Node) *checker.Signature {
+	return timed(c, func() *checker.Signature {
+		return c.checker.GetSignatureFromDeclaration(declaration)
+	})
+}
+
+func (c *TypeCache) GetStringIndexType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return c.checker.GetStringIndexType(t)
+	})
+}
+
+func (c *TypeCache) GetSymbolAtLocation(node *ast.Node) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return c.checker.GetSymbolAtLocation(node)
+	})
+}
+
+func (c *TypeCache) GetSymbolsInScope(location *ast.Node, meaning ast.SymbolFlags) []*ast.Symbol {
+	return timed(c, func() []*ast.Symbol {
+		return c.checker.GetSymbolsInScope(location, meaning)
+	})
+}
+
+func (c *TypeCache) GetTypeArguments(t *checker.Type) []*checker.Type {
+	return timed(c, func() []*checker.Type {
+		return checker.Checker_getTypeArguments(c.checker, t)
+	})
+}
+
+func (c *TypeCache) GetTypeFromTypeNode(node *ast.Node) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getTypeFromTypeNode(c.checker, node)
+	})
+}
+
+func (c *TypeCache) GetTypeOfPropertyOfType(t *checker.Type, name string) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getTypeOfPropertyOfType(c.checker, t, name)
+	})
+}
+
+func (c *TypeCache) GetTypeOfSymbol(symbol *ast.Symbol) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getTypeOfSymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) GetTypeOfSymbolAtLocation(symbol *ast.Symbol, location *ast.Node) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return c.checker.GetTypeOfSymbolAtLocation(symbol, location)
+	})
+}
+
+func (c *TypeCache) GetTypePredicateOfSignature(signature *checker.Signature) *checker.TypePredicate {
+	return timed(c, func() *checker.TypePredicate {
+		return c.checker.GetTypePredicateOfSignature(signature)
+	})
+}
+
+func (c *TypeCache) GetWidenedType(t *checker.Type) *checker.Type {
+	return timed(c, func() *checker.Type {
+		return checker.Checker_getWidenedType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) IsArrayOrTupleType(t *checker.Type) bool {
+	return timed(c, func() bool {
+		return checker.Checker_isArrayOrTupleType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) IsArrayType(t *checker.Type) bool {
+	return timed(c, func() bool {
+		return checker.Checker_isArrayType(c.checker, t)
+	})
+}
+
+func (c *TypeCache) IsDeprecatedDeclaration(declaration *ast.Node) bool {
+	return timed(c, func() bool {
+		return checker.Checker_IsDeprecatedDeclaration(c.checker, declaration)
+	})
+}
+
+func (c *TypeCache) IsDeprecatedSymbol(symbol *ast.Symbol) bool {
+	return timed(c, func() bool {
+		return checker.Checker_isDeprecatedSymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) IsReadonlySymbol(symbol *ast.Symbol) bool {
+	return timed(c, func() bool {
+		return checker.Checker_isReadonlySymbol(c.checker, symbol)
+	})
+}
+
+func (c *TypeCache) IsTypeAssignableTo(source *checker.Type, target *checker.Type) bool {
+	return timed(c, func() bool {
+		return checker.Checker_isTypeAssignableTo(c.checker, source, target)
+	})
+}
+
+func (c *TypeCache) ResolveAlias(symbol *ast.Symbol) (*ast.Symbol, bool) {
+	var ok bool
+	resolved := timed(c, func() *ast.Symbol {
+		var resolved *ast.Symbol
+		resolved, ok = c.checker.ResolveAlias(symbol)
+		return resolved
+	})
+	return resolved, ok
+}
+
+func (c *TypeCache) ResolveName(name string, location *ast.Node, meaning ast.SymbolFlags, excludeGlobals bool) *ast.Symbol {
+	return timed(c, func() *ast.Symbol {
+		return c.checker.ResolveName(name, location, meaning, excludeGlobals)
+	})
+}
+
+func (c *TypeCache) TypeToString(t *checker.Type) string {
+	return timed(c, func() string {
+		return c.checker.TypeToString(t)
+	})
+}
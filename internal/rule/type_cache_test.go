@@ -0,0 +1,76 @@
+package rule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTypeCache(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `
+declare const value: Promise<number>;
+value;
+`
+	fs := utils.NewOverlayVFSForFile(filePath, code)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", utils.CreateCompilerHost(rootDir, fs), false)
+	assert.NilError(t, err, "couldn't create program")
+	typeChecker, done := program.GetTypeChecker(t.Context())
+	defer done()
+
+	file := program.GetSourceFile(filePath)
+	expression := file.Statements.Nodes[1].AsExpressionStatement().Expression
+	assert.Equal(t, expression.Kind, ast.KindIdentifier)
+
+	cache := NewTypeCache()
+	cache.Reset(program, typeChecker)
+
+	first := cache.GetTypeAtLocation(expression)
+	assert.Assert(t, cache.GetTypeAtLocation(expression) == first)
+	assert.Assert(t, cache.IsThenableType(expression, nil))
+	assert.Assert(t, cache.IsPromiseLike(first))
+	assert.Assert(t, cache.IsPromiseLike(first))
+	parts := cache.UnionTypeParts(first)
+	assert.Assert(t, len(parts) == 1 && parts[0] == first)
+	assert.Assert(t, &cache.UnionTypeParts(first)[0] == &parts[0], "the parts should be memoized")
+	assert.DeepEqual(t, cache.Stats(), TypeCacheStats{Hits: 4, Misses: 4})
+
+	cache.Reset(program, typeChecker)
+	cache.GetTypeAtLocation(expression)
+	assert.DeepEqual(t, cache.Stats(), TypeCacheStats{Hits: 4, Misses: 5})
+	assert.Equal(t, cache.Stats().HitRate(), 4.0/9.0*100)
+}
+
+func TestTypeCache_TimesUncachedQueries(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `
+declare const value: Promise<number>;
+value;
+`
+	fs := utils.NewOverlayVFSForFile(filePath, code)
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", utils.CreateCompilerHost(rootDir, fs), false)
+	assert.NilError(t, err, "couldn't create program")
+	typeChecker, done := program.GetTypeChecker(t.Context())
+	defer done()
+
+	file := program.GetSourceFile(filePath)
+	expression := file.Statements.Nodes[1].AsExpressionStatement().Expression
+
+	cache := NewTypeCache()
+	cache.Reset(program, typeChecker)
+	cache.EnableTiming()
+
+	symbol := cache.GetSymbolAtLocation(expression)
+	assert.Assert(t, symbol != nil && symbol.Name == "value")
+	assert.Assert(t, cache.TakeCheckerTime() > 0, "uncached queries should be timed")
+	assert.Equal(t, cache.TakeCheckerTime(), time.Duration(0))
+	assert.DeepEqual(t, cache.Stats(), TypeCacheStats{}, "uncached queries are not cache hits or misses")
+}
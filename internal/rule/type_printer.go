@@ -0,0 +1,171 @@
+package rule
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/parser"
+)
+
+// The checker prints types which are not accessible at the enclosing
+// declaration as `import("module").Name`.
+var importTypePattern = regexp.MustCompile(`(typeof )?import\("([^"]+)"\)\.([A-Za-z_$][\w$]*)`)
+
+type typeImport struct {
+	moduleSpecifier string
+	name            string
+}
+
+// Prints types as TypeScript code to be inserted at a location of the linted
+// file. Types declared in modules the file does not import are printed with
+// their name, and `ImportFixes` adds the `import type` specifiers for them.
+//
+//	printer := rule.NewTypePrinter(ctx, node)
+//	text, ok := printer.Print(t)
+//	if !ok {
+//		return nil
+//	}
+//	return append([]rule.RuleFix{rule.RuleFixInsertAfter(node.Name(), ": "+text)}, printer.ImportFixes()...)
+type TypePrinter struct {
+	file     *ast.SourceFile
+	checker  *checker.Checker
+	location *ast.Node
+	// In the order the types were printed
+	imports []typeImport
+}
+
+func NewTypePrinter(ctx RuleContext, location *ast.Node) *TypePrinter {
+	return &TypePrinter{
+		file:     ctx.SourceFile,
+		checker:  ctx.TypeChecker,
+		location: location,
+	}
+}
+
+// Returns t as type syntax which is valid at the printer's location, or false
+// if it has no such syntax, e.g. for anonymous classes.
+func (p *TypePrinter) Print(t *checker.Type) (string, bool) {
+	text := p.checker.TypeToStringEx(t, p.location, checker.TypeFormatFlagsNoTruncation|checker.TypeFormatFlagsUseAliasDefinedOutsideCurrentScope)
+	text = rewriteImportTypes(text, p.addImport)
+
+	declaration := "type T = " + text + ";"
+	if len(parser.ParseSourceFile(p.file.ParseOptions(), declaration, core.GetScriptKindFromFileName(p.file.FileName())).Diagnostics()) > 0 {
+		return "", false
+	}
+	return text, true
+}
+
+// Records that name is imported from moduleSpecifier, unless the name is
+// already taken at the printer's location.
+func (p *TypePrinter) addImport(moduleSpecifier string, name string) bool {
+	for _, imported := range p.imports {
+		if imported.name == name {
+			return imported.moduleSpecifier == moduleSpecifier
+		}
+	}
+	if name == "default" || p.checker.ResolveName(name, p.location, ast.SymbolFlagsAll, false) != nil {
+		return false
+	}
+	p.imports = append(p.imports, typeImport{moduleSpecifier, name})
+	return true
+}
+
+// Replaces `import("module").Name` in text with `Name` when addImport accepts
+// importing it. `typeof import(...)` refers to values and is kept.
+func rewriteImportTypes(text string, addImport func(moduleSpecifier string, name string) bool) string {
+	return importTypePattern.ReplaceAllStringFunc(text, func(match string) string {
+		groups := importTypePattern.FindStringSubmatch(match)
+		if groups[1] != "" || !addImport(groups[2], groups[3]) {
+			return match
+		}
+		return groups[3]
+	})
+}
+
+// Returns the fixes importing the names used by the printed types. Names are
+// added to an existing named import of their module, or to a new
+// `import type` declaration after the last import. Type imports are erased, so
+// the fixes are safe.
+func (p *TypePrinter) ImportFixes() []RuleFix {
+	if len(p.imports) == 0 {
+		return nil
+	}
+
+	var modules []string
+	namesByModule := make(map[string][]string)
+	for _, imported := range p.imports {
+		if _, ok := namesByModule[imported.moduleSpecifier]; !ok {
+			modules = append(modules, imported.moduleSpecifier)
+		}
+		namesByModule[imported.moduleSpecifier] = append(namesByModule[imported.moduleSpecifier], imported.name)
+	}
+
+	quote := `"`
+	var lastImport *ast.Node
+	for _, statement := range p.file.Statements.Nodes {
+		if ast.IsImportDeclaration(statement) {
+			lastImport = statement
+			if specifier := statement.AsImportDeclaration().ModuleSpecifier; ast.IsStringLiteral(specifier) {
+				quote = p.file.Text()[specifier.End()-1 : specifier.End()]
+			}
+		}
+	}
+
+	var fixes []RuleFix
+	var declarations []string
+	for _, module := range modules {
+		names := namesByModule[module]
+		if fix, ok := p.addToNamedImports(module, names); ok {
+			fixes = append(fixes, fix)
+			continue
+		}
+		declarations = append(declarations, "import type { "+strings.Join(names, ", ")+" } from "+quote+module+quote+";")
+	}
+
+	if len(declarations) > 0 {
+		text := strings.Join(declarations, "\n")
+		if lastImport != nil {
+			fixes = append(fixes, RuleFixInsertLineAfter(p.file, lastImport, text))
+		} else {
+			fixes = append(fixes, RuleFixInsertLineBefore(p.file, p.file.Statements.Nodes[0], text))
+		}
+	}
+	return RuleFixesWithKind(RuleFixKindSafe, fixes)
+}
+
+// Adds names to the first `import { ... } from "module"` of the file.
+func (p *TypePrinter) addToNamedImports(moduleSpecifier string, names []string) (RuleFix, bool) {
+	for _, statement := range p.file.Statements.Nodes {
+		if !ast.IsImportDeclaration(statement) {
+			continue
+		}
+		declaration := statement.AsImportDeclaration()
+		if !ast.IsStringLiteral(declaration.ModuleSpecifier) || declaration.ModuleSpecifier.Text() != moduleSpecifier || declaration.ImportClause == nil {
+			continue
+		}
+		namedBindings := declaration.ImportClause.AsImportClause().NamedBindings
+		if namedBindings == nil || !ast.IsNamedImports(namedBindings) {
+			continue
+		}
+		elements := namedBindings.AsNamedImports().Elements.Nodes
+		if len(elements) == 0 {
+			continue
+		}
+
+		prefix := "type "
+		if ast.IsTypeOnlyImportDeclaration(declaration.ImportClause) {
+			prefix = ""
+		}
+		var text strings.Builder
+		for _, name := range names {
+			text.WriteString(", ")
+			text.WriteString(prefix)
+			text.WriteString(name)
+		}
+		return RuleFixInsertAfter(elements[len(elements)-1], text.String()), true
+	}
+	return RuleFix{}, false
+}
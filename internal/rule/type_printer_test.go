@@ -0,0 +1,78 @@
+package rule
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/bundled"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/typescript-eslint/tsgolint/internal/rules/fixtures"
+	"github.com/typescript-eslint/tsgolint/internal/utils"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRewriteImportTypes(t *testing.T) {
+	var imported []string
+	addImport := func(moduleSpecifier string, name string) bool {
+		if name == "Taken" {
+			return false
+		}
+		imported = append(imported, moduleSpecifier+":"+name)
+		return true
+	}
+
+	assert.Equal(t,
+		rewriteImportTypes(`Promise<import("./foo").Foo[]> | import("bar").Taken | typeof import("./baz").baz`, addImport),
+		`Promise<Foo[]> | import("bar").Taken | typeof import("./baz").baz`,
+	)
+	assert.DeepEqual(t, imported, []string{"./foo:Foo"})
+}
+
+func TestTypePrinter(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	filePath := tspath.ResolvePath(rootDir, "file.ts")
+	code := `import { foo, baz } from "./types";
+const a = foo;
+const b = baz();
+`
+	fs := utils.NewOverlayVFS(bundled.WrapFS(osvfs.FS()), map[string]string{
+		filePath:                                code,
+		tspath.ResolvePath(rootDir, "types.ts"): "export interface Foo { a: number }\nexport const foo: Foo = { a: 1 };\nexport { baz } from './other';\n",
+		tspath.ResolvePath(rootDir, "other.ts"): "export interface Baz { b: string }\nexport declare function baz(): Baz;\n",
+	})
+	program, _, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.minimal.json", utils.CreateCompilerHost(rootDir, fs), false)
+	assert.NilError(t, err, "couldn't create program")
+	typeChecker, done := program.GetTypeChecker(t.Context())
+	defer done()
+
+	file := program.GetSourceFile(filePath)
+	initializer := func(idx int) *ast.Node {
+		return file.Statements.Nodes[idx].AsVariableStatement().DeclarationList.AsVariableDeclarationList().Declarations.Nodes[0].Initializer()
+	}
+
+	printer := NewTypePrinter(RuleContext{SourceFile: file, TypeChecker: typeChecker}, file.Statements.Nodes[1])
+	// Foo is added to the named import of ./types, Baz needs an import of its own
+	text, ok := printer.Print(typeChecker.GetTypeAtLocation(initializer(1)))
+	assert.Assert(t, ok)
+	assert.Equal(t, text, "Foo")
+	text, ok = printer.Print(typeChecker.GetTypeAtLocation(initializer(2)))
+	assert.Assert(t, ok)
+	assert.Equal(t, text, "Baz")
+
+	fixes := printer.ImportFixes()
+	slices.SortFunc(fixes, func(a, b RuleFix) int {
+		return b.Range.Pos() - a.Range.Pos()
+	})
+	fixed := code
+	for _, fix := range fixes {
+		fixed = fixed[:fix.Range.Pos()] + fix.Text + fixed[fix.Range.End():]
+	}
+	assert.Equal(t, fixed, `import { foo, baz, type Foo } from "./types";
+import type { Baz } from "./other";
+const a = foo;
+const b = baz();
+`)
+}
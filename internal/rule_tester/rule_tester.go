@@ -42,11 +42,15 @@ type InvalidTestCaseError struct {
 	EndLine     int
 	EndColumn   int
 	Suggestions []InvalidTestCaseSuggestion
+	// The kind of the diagnostic's fixes, e.g. "safe". Not checked when empty.
+	FixKind string
 }
 
 type InvalidTestCaseSuggestion struct {
 	MessageId string
 	Output    string
+	// The kind of the suggestion's fixes, e.g. "unsafe". Not checked when empty.
+	Kind string
 }
 
 type InvalidTestCase struct {
@@ -113,19 +117,22 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 		files := []*ast.SourceFile{sourceFile}
 
 		err = linter.RunLinterOnProgram(linter.RunLinterOnProgramOptions{
-			LogLevel: utils.LogLevelNormal,
-			Program:  program,
-			Files:    files,
-			Workers:  1,
+			Program: program,
+			Files:   files,
+			Workers: 1,
 			GetRulesForFile: func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
-				return []linter.ConfiguredRule{
-					{
-						Name: "test",
-						Run: func(ctx rule.RuleContext) rule.RuleListeners {
-							return r.Run(ctx, options)
-						},
+				configured := linter.ConfiguredRule{
+					Name: "test",
+					Run: func(ctx rule.RuleContext) rule.RuleListeners {
+						return r.Run(ctx, options)
 					},
 				}
+				if r.RunOnProgram != nil {
+					configured.RunOnProgram = func() rule.ProgramListeners {
+						return r.RunOnProgram(options)
+					}
+				}
+				return []linter.ConfiguredRule{configured}
 			},
 			OnDiagnostic: func(diagnostic rule.RuleDiagnostic) {
 				diagnosticsMu.Lock()
@@ -234,6 +241,11 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 				if expected.EndColumn != 0 && expected.EndColumn != endColumn {
 					t.Errorf("Error end column should be %v. Got %v", expected.EndColumn, endColumn)
 				}
+				if expected.FixKind != "" {
+					if kind := rule.RuleFixesKind(diagnostic.Fixes()).String(); expected.FixKind != kind {
+						t.Errorf("Error fix kind should be %v. Got %v", expected.FixKind, kind)
+					}
+				}
 
 				suggestionsCount := 0
 				if diagnostic.Suggestions != nil {
@@ -250,6 +262,11 @@ func RunRuleTester(rootDir string, tsconfigPath string, t *testing.T, r *rule.Ru
 							output, _, _ := linter.ApplyRuleFixes(testCase.Code, []rule.RuleSuggestion{suggestion})
 
 							assert.Equal(t, expectedSuggestion.Output, output, "Expected code after suggestion fix")
+							if expectedSuggestion.Kind != "" {
+								if kind := rule.RuleFixesKind(suggestion.Fixes()).String(); expectedSuggestion.Kind != kind {
+									t.Errorf("Suggestion fix kind should be %v. Got %v", expectedSuggestion.Kind, kind)
+								}
+							}
 						}
 					}
 				}
@@ -100,7 +100,7 @@ var AwaitThenableRule = rule.Rule{
 		return rule.RuleListeners{
 			ast.KindAwaitExpression: func(node *ast.Node) {
 				awaitArgument := node.AsAwaitExpression().Expression
-				awaitArgumentType := ctx.TypeChecker.GetTypeAtLocation(awaitArgument)
+				awaitArgumentType := ctx.Types.GetTypeAtLocation(awaitArgument)
 				certainty := utils.NeedsToBeAwaited(ctx.TypeChecker, awaitArgument, awaitArgumentType)
 
 				if certainty == utils.TypeAwaitableNever {
@@ -113,7 +113,7 @@ var AwaitThenableRule = rule.Rule{
 						return []rule.RuleSuggestion{{
 							Message: buildRemoveAwaitMessage(),
 							FixesArr: []rule.RuleFix{
-								rule.RuleFixRemoveRange(awaitTokenRange),
+								rule.RuleFixRemoveRange(awaitTokenRange).WithKind(rule.RuleFixKindUnsafe),
 							},
 						}}
 					})
@@ -140,8 +140,8 @@ var AwaitThenableRule = rule.Rule{
 							continue
 						}
 
-						t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, element)
-						if isAlwaysNonAwaitableType(ctx.TypeChecker, element, t) {
+						t := ctx.Types.GetConstrainedTypeAtLocation(element)
+						if isAlwaysNonAwaitableType(ctx, element, t) {
 							argumentRange := utils.TrimNodeTextRange(ctx.SourceFile, argument)
 							elementRange := utils.TrimNodeTextRange(ctx.SourceFile, element)
 							ctx.ReportDiagnostic(buildAwaitThenableDiagnostic(
@@ -159,8 +159,8 @@ var AwaitThenableRule = rule.Rule{
 					return
 				}
 
-				t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, argument)
-				if isInvalidPromiseAggregatorInput(ctx.TypeChecker, argument, t) {
+				t := ctx.Types.GetConstrainedTypeAtLocation(argument)
+				if isInvalidPromiseAggregatorInput(ctx, argument, t) {
 					argRange := utils.TrimNodeTextRange(ctx.SourceFile, argument)
 					ctx.ReportDiagnostic(buildAwaitThenableDiagnostic(
 						argRange,
@@ -175,12 +175,12 @@ var AwaitThenableRule = rule.Rule{
 					return
 				}
 
-				exprType := ctx.TypeChecker.GetTypeAtLocation(stmt.Expression)
+				exprType := ctx.Types.GetTypeAtLocation(stmt.Expression)
 				if utils.IsTypeAnyType(exprType) {
 					return
 				}
 
-				for _, typePart := range utils.UnionTypeParts(exprType) {
+				for _, typePart := range ctx.Types.UnionTypeParts(exprType) {
 					if utils.GetWellKnownSymbolPropertyOfType(typePart, "asyncIterator", ctx.TypeChecker) != nil {
 						return
 					}
@@ -198,7 +198,7 @@ var AwaitThenableRule = rule.Rule{
 						return []rule.RuleSuggestion{{
 							Message: buildConvertToOrdinaryForMessage(),
 							FixesArr: []rule.RuleFix{
-								rule.RuleFixRemove(ctx.SourceFile, stmt.AwaitModifier),
+								rule.RuleFixRemove(ctx.SourceFile, stmt.AwaitModifier).WithKind(rule.RuleFixKindUnsafe),
 							},
 						}}
 					},
@@ -216,12 +216,12 @@ var AwaitThenableRule = rule.Rule{
 					if init == nil {
 						continue
 					}
-					initType := ctx.TypeChecker.GetTypeAtLocation(init)
+					initType := ctx.Types.GetTypeAtLocation(init)
 					if utils.IsTypeAnyType(initType) {
 						continue
 					}
 
-					for _, typePart := range utils.UnionTypeParts(initType) {
+					for _, typePart := range ctx.Types.UnionTypeParts(initType) {
 						if utils.GetWellKnownSymbolPropertyOfType(typePart, "asyncDispose", ctx.TypeChecker) != nil {
 							continue DeclaratorLoop
 						}
@@ -235,7 +235,7 @@ var AwaitThenableRule = rule.Rule{
 						suggestions = append(suggestions, rule.RuleSuggestion{
 							Message: buildRemoveAwaitMessage(),
 							FixesArr: []rule.RuleFix{
-								rule.RuleFixRemoveRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Pos())),
+								rule.RuleFixRemoveRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Pos())).WithKind(rule.RuleFixKindUnsafe),
 							},
 						})
 					}
@@ -261,7 +261,7 @@ func isPromiseAggregatorMethod(
 		return false
 	}
 
-	methodName, ok := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+	methodName, ok := ctx.Types.GetAccessedPropertyName(callee)
 	if !ok || !slices.Contains(promiseAggregatorMethods, methodName) {
 		return false
 	}
@@ -269,23 +269,23 @@ func isPromiseAggregatorMethod(
 	return utils.IsPromiseConstructorLike(
 		ctx.Program,
 		ctx.TypeChecker,
-		utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, callee.Expression()),
+		ctx.Types.GetConstrainedTypeAtLocation(callee.Expression()),
 	)
 }
 
 func isInvalidPromiseAggregatorInput(
-	typeChecker *checker.Checker,
+	ctx rule.RuleContext,
 	node *ast.Node,
 	t *checker.Type,
 ) bool {
-	if !isIterable(typeChecker, t) {
+	if !isIterable(ctx, t) {
 		return false
 	}
 
-	for _, part := range utils.UnionTypeParts(t) {
-		valueTypes := getValueTypesOfArrayLike(typeChecker, part)
+	for _, part := range ctx.Types.UnionTypeParts(t) {
+		valueTypes := getValueTypesOfArrayLike(ctx.TypeChecker, part)
 		for _, valueType := range valueTypes {
-			if containsNonAwaitableType(typeChecker, node, valueType) {
+			if containsNonAwaitableType(ctx, node, valueType) {
 				return true
 			}
 		}
@@ -315,30 +315,30 @@ func getValueTypesOfArrayLike(
 }
 
 func isAlwaysNonAwaitableType(
-	typeChecker *checker.Checker,
+	ctx rule.RuleContext,
 	node *ast.Node,
 	t *checker.Type,
 ) bool {
-	return utils.Every(utils.UnionTypeParts(t), func(typePart *checker.Type) bool {
-		return utils.NeedsToBeAwaited(typeChecker, node, typePart) == utils.TypeAwaitableNever
+	return utils.Every(ctx.Types.UnionTypeParts(t), func(typePart *checker.Type) bool {
+		return utils.NeedsToBeAwaited(ctx.TypeChecker, node, typePart) == utils.TypeAwaitableNever
 	})
 }
 
 func containsNonAwaitableType(
-	typeChecker *checker.Checker,
+	ctx rule.RuleContext,
 	node *ast.Node,
 	t *checker.Type,
 ) bool {
-	return utils.Some(utils.UnionTypeParts(t), func(typePart *checker.Type) bool {
-		return utils.NeedsToBeAwaited(typeChecker, node, typePart) == utils.TypeAwaitableNever
+	return utils.Some(ctx.Types.UnionTypeParts(t), func(typePart *checker.Type) bool {
+		return utils.NeedsToBeAwaited(ctx.TypeChecker, node, typePart) == utils.TypeAwaitableNever
 	})
 }
 
 func isIterable(
-	typeChecker *checker.Checker,
+	ctx rule.RuleContext,
 	t *checker.Type,
 ) bool {
-	return utils.Every(utils.UnionTypeParts(t), func(typePart *checker.Type) bool {
-		return utils.GetWellKnownSymbolPropertyOfType(typePart, "iterator", typeChecker) != nil
+	return utils.Every(ctx.Types.UnionTypeParts(t), func(typePart *checker.Type) bool {
+		return utils.GetWellKnownSymbolPropertyOfType(typePart, "iterator", ctx.TypeChecker) != nil
 	})
 }
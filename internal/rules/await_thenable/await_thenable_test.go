@@ -585,6 +585,7 @@ Promise.all([
 						{
 							MessageId: "removeAwait",
 							Output:    " 0;",
+							Kind:      "unsafe",
 						},
 					},
 				},
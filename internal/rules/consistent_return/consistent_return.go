@@ -93,8 +93,8 @@ func isThenableTypeWithVoidValue(typeChecker *checker.Checker, node *ast.Node, t
 }
 
 func isReturnVoidOrThenableVoid(ctx rule.RuleContext, functionNode *ast.Node) bool {
-	functionType := ctx.TypeChecker.GetTypeAtLocation(functionNode)
-	callSignatures := utils.GetCallSignatures(ctx.TypeChecker, functionType)
+	functionType := ctx.Types.GetTypeAtLocation(functionNode)
+	callSignatures := ctx.Types.GetCallSignatures(functionType)
 	if len(callSignatures) == 0 {
 		return false
 	}
@@ -103,12 +103,12 @@ func isReturnVoidOrThenableVoid(ctx rule.RuleContext, functionNode *ast.Node) bo
 	isAsyncFunction := functionFlags&ast.FunctionFlagsAsync != 0
 
 	return utils.Some(callSignatures, func(signature *checker.Signature) bool {
-		returnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+		returnType := ctx.Types.GetReturnTypeOfSignature(signature)
 		if isAsyncFunction {
 			return isThenableTypeWithVoidValue(ctx.TypeChecker, functionNode, returnType, map[*checker.Type]struct{}{})
 		}
 
-		return utils.Some(utils.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
+		return utils.Some(ctx.Types.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
 	})
 }
 
@@ -121,7 +121,7 @@ func getHasReturnValue(ctx rule.RuleContext, node *ast.ReturnStatement, treatUnd
 		return true
 	}
 
-	returnValueType := ctx.TypeChecker.GetTypeAtLocation(node.Expression)
+	returnValueType := ctx.Types.GetTypeAtLocation(node.Expression)
 	if checker.Type_flags(returnValueType) == checker.TypeFlagsUndefined {
 		return false
 	}
@@ -168,19 +168,19 @@ var ConsistentTypeExportsRule = rule.Rule{
 				return
 			}
 
-			moduleSymbol := ctx.TypeChecker.GetSymbolAtLocation(exportDecl.ModuleSpecifier.AsNode())
+			moduleSymbol := ctx.Types.GetSymbolAtLocation(exportDecl.ModuleSpecifier.AsNode())
 			if moduleSymbol == nil {
 				return
 			}
-			sourceFileType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, moduleSymbol)
+			sourceFileType := ctx.Types.GetTypeOfSymbol(moduleSymbol)
 			if sourceFileType == nil {
 				return
 			}
 
 			isThereAnyExportedValue := false
 
-			for _, propertyTypeSymbol := range checker.Checker_getPropertiesOfType(ctx.TypeChecker, sourceFileType) {
-				if checker.Checker_getPropertyOfType(ctx.TypeChecker, sourceFileType, propertyTypeSymbol.Name) != nil {
+			for _, propertyTypeSymbol := range ctx.Types.GetPropertiesOfType(sourceFileType) {
+				if ctx.Types.GetPropertyOfType(sourceFileType, propertyTypeSymbol.Name) != nil {
 					isThereAnyExportedValue = true
 					break
 				}
@@ -199,7 +199,7 @@ var ConsistentTypeExportsRule = rule.Rule{
 					if s.Token() == ast.KindAsteriskToken {
 						tokenRange := s.TokenRange()
 						return []rule.RuleFix{
-							rule.RuleFixReplaceRange(tokenRange.WithEnd(tokenRange.Pos()), "type "),
+							rule.RuleFixReplaceRange(tokenRange.WithEnd(tokenRange.Pos()), "type ").WithKind(rule.RuleFixKindSafe),
 						}
 					}
 					if s.Token() == ast.KindEndOfFile || s.TokenRange().Pos() >= node.End() {
@@ -241,7 +241,7 @@ var ConsistentTypeExportsRule = rule.Rule{
 				if specifier.PropertyName != nil {
 					nameNode = specifier.PropertyName.AsNode()
 				}
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(nameNode)
+				symbol := ctx.Types.GetSymbolAtLocation(nameNode)
 				isType, resolved := isSymbolTypeBased(ctx.TypeChecker, symbol)
 				if !resolved {
 					continue
@@ -276,7 +276,7 @@ var ConsistentTypeExportsRule = rule.Rule{
 							ctx.SourceFile,
 							report.node,
 							buildNamedExportStatement(true, report.typeTexts, report.moduleSource),
-						),
+						).WithKind(rule.RuleFixKindSafe),
 					}
 				})
 				return
@@ -307,7 +307,7 @@ var ConsistentTypeExportsRule = rule.Rule{
 				if opts.FixMixedExportsWithInlineTypeSpecifier {
 					fixes := make([]rule.RuleFix, 0, len(report.typeBasedNodes))
 					for _, specifierNode := range report.typeBasedNodes {
-						fixes = append(fixes, rule.RuleFixInsertBefore(ctx.SourceFile, specifierNode, "type "))
+						fixes = append(fixes, rule.RuleFixInsertBefore(ctx.SourceFile, specifierNode, "type ").WithKind(rule.RuleFixKindSafe))
 					}
 					return fixes
 				}
@@ -315,7 +315,7 @@ var ConsistentTypeExportsRule = rule.Rule{
 				replacement := buildNamedExportStatement(true, report.typeTexts, report.moduleSource) + "\n" +
 					buildNamedExportStatement(false, report.valueTexts, report.moduleSource)
 				return []rule.RuleFix{
-					rule.RuleFixReplace(ctx.SourceFile, report.node, replacement),
+					rule.RuleFixReplace(ctx.SourceFile, report.node, replacement).WithKind(rule.RuleFixKindSafe),
 				}
 			})
 		}
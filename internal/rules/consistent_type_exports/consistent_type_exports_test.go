@@ -133,7 +133,7 @@ export { A };
 				Code: `export { Type1, value1 } from './consistent-type-exports';`,
 				Output: []string{`export type { Type1 } from './consistent-type-exports';
 export { value1 } from './consistent-type-exports';`},
-				Errors: []rule_tester.InvalidTestCaseError{{MessageId: `singleExportIsType`}},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: `singleExportIsType`, FixKind: `safe`}},
 			},
 			{
 				Code: `
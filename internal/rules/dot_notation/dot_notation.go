@@ -8,7 +8,6 @@ import (
 	"unicode/utf8"
 
 	"github.com/microsoft/typescript-go/shim/ast"
-	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
@@ -235,7 +234,7 @@ var DotNotationRule = rule.Rule{
 					fixes = append(fixes, rule.RuleFixInsertAfter(node, " "))
 				}
 
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
@@ -269,7 +268,7 @@ var DotNotationRule = rule.Rule{
 				}
 				fixes = append(fixes, rule.RuleFixReplace(ctx.SourceFile, property.AsNode(), fmt.Sprintf(`["%s"]`, propertyName)))
 
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
@@ -281,12 +280,12 @@ var DotNotationRule = rule.Rule{
 			elementAccess := node.AsElementAccessExpression()
 			property := elementAccess.ArgumentExpression
 
-			propertySymbol := ctx.TypeChecker.GetSymbolAtLocation(property)
+			propertySymbol := ctx.Types.GetSymbolAtLocation(property)
 			propertyName, hasStaticPropertyName := getStaticPropertyName(property)
 			if propertySymbol == nil && hasStaticPropertyName {
-				objectType := utils.GetNonNullableType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(node.Expression()))
+				objectType := ctx.Types.GetNonNullableType(ctx.Types.GetTypeAtLocation(node.Expression()))
 				if objectType != nil {
-					for _, candidate := range checker.Checker_getPropertiesOfType(ctx.TypeChecker, objectType) {
+					for _, candidate := range ctx.Types.GetPropertiesOfType(objectType) {
 						if candidate.Name == propertyName {
 							propertySymbol = candidate
 							break
@@ -313,13 +312,13 @@ var DotNotationRule = rule.Rule{
 					return true
 				}
 
-				objectType := utils.GetNonNullableType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(node.Expression()))
+				objectType := ctx.Types.GetNonNullableType(ctx.Types.GetTypeAtLocation(node.Expression()))
 				if objectType != nil {
-					objectType = checker.Checker_getApparentType(ctx.TypeChecker, objectType)
-					keyType := ctx.TypeChecker.GetTypeAtLocation(property)
-					baseKeyType := checker.Checker_getBaseTypeOfLiteralType(ctx.TypeChecker, keyType)
-					if checker.Checker_getIndexTypeOfType(ctx.TypeChecker, objectType, keyType) != nil ||
-						(baseKeyType != nil && checker.Checker_getIndexTypeOfType(ctx.TypeChecker, objectType, baseKeyType) != nil) {
+					objectType = ctx.Types.GetApparentType(objectType)
+					keyType := ctx.Types.GetTypeAtLocation(property)
+					baseKeyType := ctx.Types.GetBaseTypeOfLiteralType(keyType)
+					if ctx.Types.GetIndexTypeOfType(objectType, keyType) != nil ||
+						(baseKeyType != nil && ctx.Types.GetIndexTypeOfType(objectType, baseKeyType) != nil) {
 						return true
 					}
 				}
@@ -75,7 +75,7 @@ func TestDotNotation(t *testing.T) {
 			Options: rule_tester.OptionsFromJSON[DotNotationOptions]("{\"allowPrivateClassPropertyAccess\":false}"),
 			Output:  []string{"\nclass X {\n  private priv_prop = 123;\n}\n\nconst x = new X();\nx.priv_prop = 123;\n      "},
 			Errors: []rule_tester.InvalidTestCaseError{
-				{MessageId: "useDot"},
+				{MessageId: "useDot", FixKind: "safe"},
 			},
 		},
 		{
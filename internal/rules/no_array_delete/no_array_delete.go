@@ -27,7 +27,7 @@ var NoArrayDeleteRule = rule.Rule{
 		isUnderlyingTypeArray := func(t *checker.Type) bool {
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsUnion) {
 				for _, t := range t.Types() {
-					if !checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t) {
+					if !ctx.Types.IsArrayOrTupleType(t) {
 						return false
 					}
 				}
@@ -36,14 +36,14 @@ var NoArrayDeleteRule = rule.Rule{
 
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsIntersection) {
 				for _, t := range t.Types() {
-					if checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t) {
+					if ctx.Types.IsArrayOrTupleType(t) {
 						return true
 					}
 				}
 				return false
 			}
 
-			return checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t)
+			return ctx.Types.IsArrayOrTupleType(t)
 		}
 
 		return rule.RuleListeners{
@@ -56,7 +56,7 @@ var NoArrayDeleteRule = rule.Rule{
 
 				expression := deleteExpression.AsElementAccessExpression()
 
-				argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expression.Expression)
+				argType := ctx.Types.GetConstrainedTypeAtLocation(expression.Expression)
 
 				if !isUnderlyingTypeArray(argType) {
 					return
@@ -79,11 +79,11 @@ var NoArrayDeleteRule = rule.Rule{
 
 					return []rule.RuleSuggestion{{
 						Message: buildUseSpliceMessage(),
-						FixesArr: []rule.RuleFix{
+						FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 							rule.RuleFixRemoveRange(deleteTokenRange),
 							rule.RuleFixReplaceRange(leftBracketTokenRange, ".splice("),
 							rule.RuleFixReplaceRange(rightBracketTokenRange, ", 1)"),
-						},
+						}),
 					}}
 				})
 			},
@@ -64,6 +64,7 @@ func TestNoArrayDelete(t *testing.T) {
         declare const arr: number[];
          arr.splice(0, 1);
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
@@ -99,7 +99,7 @@ var NoBaseToStringRule = rule.Rule{
 			}
 
 			if t == nil {
-				t = ctx.TypeChecker.GetTypeAtLocation(node)
+				t = ctx.Types.GetTypeAtLocation(node)
 			}
 
 			certainty := collectToStringCertainty(
@@ -130,7 +130,7 @@ var NoBaseToStringRule = rule.Rule{
 			t *checker.Type,
 			collectSubTypeCertainty func(t *checker.Type) usefulness,
 		) usefulness {
-			certainties := utils.Map(utils.UnionTypeParts(t), collectSubTypeCertainty)
+			certainties := utils.Map(ctx.Types.UnionTypeParts(t), collectSubTypeCertainty)
 
 			if utils.Every(certainties, func(c usefulness) bool { return c == usefulnessNever }) {
 				return usefulnessNever
@@ -158,7 +158,7 @@ var NoBaseToStringRule = rule.Rule{
 			t *checker.Type,
 			visited []*checker.Type,
 		) usefulness {
-			typeArgs := checker.Checker_getTypeArguments(ctx.TypeChecker, t)
+			typeArgs := ctx.Types.GetTypeArguments(t)
 			certainties := utils.Map(typeArgs, func(t *checker.Type) usefulness {
 				return collectToStringCertainty(t, visited)
 			})
@@ -178,7 +178,7 @@ var NoBaseToStringRule = rule.Rule{
 			t *checker.Type,
 			visited []*checker.Type,
 		) usefulness {
-			elemType := utils.GetNumberIndexType(ctx.TypeChecker, t)
+			elemType := ctx.Types.GetNumberIndexType(t)
 			if elemType == nil {
 				panic("array should have number index type")
 			}
@@ -206,7 +206,7 @@ var NoBaseToStringRule = rule.Rule{
 					return collectTupleCertainty(t, visited)
 				}
 
-				if checker.Checker_isArrayType(ctx.TypeChecker, t) {
+				if ctx.Types.IsArrayType(t) {
 					return collectArrayCertainty(t, visited)
 				}
 
@@ -225,7 +225,7 @@ var NoBaseToStringRule = rule.Rule{
 
 			return toStringMemo.get(t, func() usefulness {
 				if utils.IsTypeParameter(t) {
-					constraint := checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, t)
+					constraint := ctx.Types.GetBaseConstraintOfType(t)
 					if constraint != nil {
 						return collectToStringCertainty(constraint, visited)
 					}
@@ -263,13 +263,13 @@ var NoBaseToStringRule = rule.Rule{
 					return collectTupleCertainty(t, append(visited, t))
 				}
 
-				if checker.Checker_isArrayType(ctx.TypeChecker, t) {
+				if ctx.Types.IsArrayType(t) {
 					return collectArrayCertainty(t, append(visited, t))
 				}
 
 				foundFallbackOnObject := false
 				for _, propertyName := range []string{"toString", "toLocaleString", "valueOf"} {
-					property := checker.Checker_getPropertyOfType(ctx.TypeChecker, t, propertyName)
+					property := ctx.Types.GetPropertyOfType(t, propertyName)
 					if property == nil {
 						continue
 					}
@@ -308,16 +308,14 @@ var NoBaseToStringRule = rule.Rule{
 
 		isBuiltInStringCall := func(node *ast.CallExpression) bool {
 			if ast.IsIdentifier(node.Expression) && node.Expression.AsIdentifier().Text == "String" && len(node.Arguments.Nodes) > 0 {
-				tt := ctx.TypeChecker.GetTypeAtLocation(node.Expression)
+				// Shadowed by a declaration of the file, e.g. `import { String } from 'foo'`
+				if ctx.Scope().Resolve(node.Expression) != nil {
+					return false
+				}
+				tt := ctx.Types.GetTypeAtLocation(node.Expression)
 				s := utils.IsBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, tt, "String")
 				sc := utils.IsBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, tt, "StringConstructor")
 				return s || sc
-				// TODO(port-scopemanager)
-				// const scope = context.sourceCode.getScope(node);
-				// // eslint-disable-next-line @typescript-eslint/internal/prefer-ast-types-enum
-				// const variable = scope.set.get('String');
-				// return !variable?.defs.length;
-				// return true
 			}
 			return false
 		}
@@ -328,8 +326,8 @@ var NoBaseToStringRule = rule.Rule{
 				if expr.OperatorToken.Kind != ast.KindPlusToken && expr.OperatorToken.Kind != ast.KindPlusEqualsToken {
 					return
 				}
-				leftType := ctx.TypeChecker.GetTypeAtLocation(expr.Left)
-				rightType := ctx.TypeChecker.GetTypeAtLocation(expr.Right)
+				leftType := ctx.Types.GetTypeAtLocation(expr.Left)
+				rightType := ctx.Types.GetTypeAtLocation(expr.Right)
 
 				if utils.GetTypeName(ctx.TypeChecker, leftType) == "string" {
 					checkExpression(expr.Right, rightType)
@@ -349,7 +347,7 @@ var NoBaseToStringRule = rule.Rule{
 					memberExpr := callExpr.Expression.AsPropertyAccessExpression()
 					propertyName := memberExpr.Name().Text()
 					if propertyName == "join" {
-						t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, memberExpr.Expression)
+						t := ctx.Types.GetConstrainedTypeAtLocation(memberExpr.Expression)
 						checkExpressionForArrayJoin(memberExpr.Expression, t)
 						return
 					} else if propertyName == "toLocaleString" || propertyName == "toString" {
@@ -203,6 +203,16 @@ import { String } from 'foo';
 String({});
     `},
 			{Code: `
+import { String } from './foo';
+declare const value: object;
+String(value);
+    `},
+			{Code: `
+function stringify(String: (value: unknown) => string, value: object) {
+  return String(value);
+}
+    `},
+			{Code: `
 declare module 'guid' {
   export function toString(id: number): string;
   export function toString(id: number, format: string): string;
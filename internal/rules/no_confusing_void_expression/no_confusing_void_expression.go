@@ -67,7 +67,7 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 		opts := utils.UnmarshalOptions[NoConfusingVoidExpressionOptions](options, "no-confusing-void-expression")
 
 		canFix := func(node *ast.Node) bool {
-			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node)
+			t := ctx.Types.GetConstrainedTypeAtLocation(node)
 			return utils.IsTypeFlagSet(t, checker.TypeFlagsVoidLike)
 		}
 
@@ -139,27 +139,27 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 
 			returnTypeNode := functionNode.Type()
 			if returnTypeNode != nil {
-				returnType := checker.Checker_getTypeFromTypeNode(ctx.TypeChecker, returnTypeNode)
+				returnType := ctx.Types.GetTypeFromTypeNode(returnTypeNode)
 
-				return utils.Some(utils.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
+				return utils.Some(ctx.Types.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
 			}
 
 			if !ast.IsArrowFunction(functionNode) && !ast.IsFunctionExpression(functionNode) {
 				return false
 			}
 
-			functionType := checker.Checker_getContextualType(ctx.TypeChecker, functionNode, checker.ContextFlagsNone)
+			functionType := ctx.Types.GetContextualType(functionNode, checker.ContextFlagsNone)
 
 			if functionType == nil {
 				return false
 			}
-			return utils.Some(utils.UnionTypeParts(functionType), func(t *checker.Type) bool {
-				callSignatures := utils.GetCallSignatures(ctx.TypeChecker, t)
+			return utils.Some(ctx.Types.UnionTypeParts(functionType), func(t *checker.Type) bool {
+				callSignatures := ctx.Types.GetCallSignatures(t)
 
 				return utils.Some(callSignatures, func(s *checker.Signature) bool {
-					returnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, s)
+					returnType := ctx.Types.GetReturnTypeOfSignature(s)
 
-					return utils.Some(utils.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
+					return utils.Some(ctx.Types.UnionTypeParts(returnType), utils.IsIntrinsicVoidType)
 				})
 			})
 		}
@@ -186,13 +186,13 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 				return
 			}
 
-			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node)
+			t := ctx.Types.GetConstrainedTypeAtLocation(node)
 			if !utils.IsTypeFlagSet(t, checker.TypeFlagsVoidLike) {
 				return
 			}
 
 			insertVoidFix := func() rule.RuleFix {
-				return rule.RuleFixInsertBefore(ctx.SourceFile, node, "void ")
+				return rule.RuleFixInsertBefore(ctx.SourceFile, node, "void ").WithKind(rule.RuleFixKindSafe)
 			}
 
 			if ast.IsArrowFunction(invalidAncestor) {
@@ -216,7 +216,7 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 						}
 					}
 
-					return fixes
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 				})
 				return
 			}
@@ -249,7 +249,7 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 							fixes = append(fixes, rule.RuleFixReplaceRange(returnToken, replaceText))
 						}
 
-						return fixes
+						return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 					})
 					return
 				}
@@ -272,7 +272,7 @@ var NoConfusingVoidExpressionRule = rule.Rule{
 							rule.RuleFixInsertAfter(invalidAncestor, " }"),
 						)
 					}
-					return fixes
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 				})
 				return
 			}
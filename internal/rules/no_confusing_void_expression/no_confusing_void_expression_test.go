@@ -537,6 +537,7 @@ function notcool(input: string) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "invalidVoidExprArrow",
+					FixKind:   "safe",
 					Line:      1,
 					Column:    7,
 				},
@@ -132,7 +132,7 @@ var NoDeprecatedRule = rule.Rule{
 			}
 
 			for _, decl := range symbol.Declarations {
-				if checker.Checker_IsDeprecatedDeclaration(ctx.TypeChecker, decl) {
+				if ctx.Types.IsDeprecatedDeclaration(decl) {
 					reason := getJsDocDeprecationFromNode(decl)
 					return true, reason
 				}
@@ -156,7 +156,7 @@ var NoDeprecatedRule = rule.Rule{
 				return false, ""
 			}
 
-			targetSymbol := ctx.TypeChecker.GetAliasedSymbol(symbol)
+			targetSymbol := ctx.Types.GetAliasedSymbol(symbol)
 
 			for utils.IsSymbolFlagSet(symbol, ast.SymbolFlagsAlias) {
 				isDeprecated, reason := getJsDocDeprecation(symbol)
@@ -164,11 +164,11 @@ var NoDeprecatedRule = rule.Rule{
 					return true, reason
 				}
 
-				if checker.Checker_getDeclarationOfAliasSymbol(ctx.TypeChecker, symbol) == nil {
+				if ctx.Types.GetDeclarationOfAliasSymbol(symbol) == nil {
 					break
 				}
 
-				immediateAliasedSymbol := checker.Checker_getImmediateAliasedSymbol(ctx.TypeChecker, symbol)
+				immediateAliasedSymbol := ctx.Types.GetImmediateAliasedSymbol(symbol)
 
 				if immediateAliasedSymbol == nil {
 					break
@@ -191,28 +191,28 @@ var NoDeprecatedRule = rule.Rule{
 
 			tsNode := node.Parent
 			// Get the resolved signature for the call
-			signature := checker.Checker_getResolvedSignature(ctx.TypeChecker, tsNode, nil, 0)
+			signature := ctx.Types.GetResolvedSignature(tsNode)
 			if signature == nil {
 				return false, ""
 			}
 
 			signatureDecl := signature.Declaration()
 			if signatureDecl != nil {
-				if checker.Checker_IsDeprecatedDeclaration(ctx.TypeChecker, signatureDecl) {
+				if ctx.Types.IsDeprecatedDeclaration(signatureDecl) {
 					reason := getJsDocDeprecationFromNode(signatureDecl)
 					return true, reason
 				}
 			}
 
 			// Also check the symbol
-			symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+			symbol := ctx.Types.GetSymbolAtLocation(node)
 			if symbol == nil {
 				return false, ""
 			}
 
 			aliasedSymbol := symbol
 			if utils.IsSymbolFlagSet(symbol, ast.SymbolFlagsAlias) {
-				aliasedSymbol = ctx.TypeChecker.GetAliasedSymbol(symbol)
+				aliasedSymbol = ctx.Types.GetAliasedSymbol(symbol)
 			}
 
 			// For property-like signatures, check the symbol itself first
@@ -267,13 +267,13 @@ var NoDeprecatedRule = rule.Rule{
 			}
 
 			// Get the contextual type for the JSX element
-			contextualType := checker.Checker_getContextualType(ctx.TypeChecker, tagName, 0)
+			contextualType := ctx.Types.GetContextualType(tagName, 0)
 			if contextualType == nil {
 				return false, ""
 			}
 
 			// Get the property symbol
-			symbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, contextualType, propertyName)
+			symbol := ctx.Types.GetPropertyOfType(contextualType, propertyName)
 
 			return getJsDocDeprecation(symbol)
 		}
@@ -294,7 +294,7 @@ var NoDeprecatedRule = rule.Rule{
 					return name.Text(), true
 				}
 
-				t := ctx.TypeChecker.GetTypeAtLocation(name)
+				t := ctx.Types.GetTypeAtLocation(name)
 				if t != nil {
 					if t.IsStringLiteral() || t.IsNumberLiteral() || t.IsBigIntLiteral() {
 						literalType := t.AsLiteralType()
@@ -329,13 +329,13 @@ var NoDeprecatedRule = rule.Rule{
 				return "", nil, nil, false, ""
 			}
 
-			contextualType := checker.Checker_getApparentTypeOfContextualType(ctx.TypeChecker, propertyNode.Parent, checker.ContextFlagsNone)
+			contextualType := ctx.Types.GetApparentTypeOfContextualType(propertyNode.Parent, checker.ContextFlagsNone)
 			if contextualType == nil {
 				return "", nil, nil, false, ""
 			}
 
-			property := checker.Checker_getPropertyOfType(ctx.TypeChecker, contextualType, propertyName)
-			if property == nil || !checker.Checker_isDeprecatedSymbol(ctx.TypeChecker, property) {
+			property := ctx.Types.GetPropertyOfType(contextualType, propertyName)
+			if property == nil || !ctx.Types.IsDeprecatedSymbol(property) {
 				return propertyName, contextualType, property, false, ""
 			}
 			isDeprecated, reason := getJsDocDeprecation(property)
@@ -348,7 +348,7 @@ var NoDeprecatedRule = rule.Rule{
 				return
 			}
 
-			nameType := ctx.TypeChecker.GetTypeAtLocation(name)
+			nameType := ctx.Types.GetTypeAtLocation(name)
 			propertyNameAllowed := slices.ContainsFunc(opts.Allow, func(specifier utils.TypeOrValueSpecifier) bool {
 				return utils.SymbolMatchesSpecifierNameAndSource(property, propertyName, specifier, ctx.Program)
 			})
@@ -377,12 +377,12 @@ var NoDeprecatedRule = rule.Rule{
 				case ast.KindVariableDeclaration:
 					varDecl := current.AsVariableDeclaration()
 					if varDecl.Initializer != nil {
-						return ctx.TypeChecker.GetTypeAtLocation(varDecl.Initializer)
+						return ctx.Types.GetTypeAtLocation(varDecl.Initializer)
 					}
 					return nil
 
 				case ast.KindParameter:
-					return ctx.TypeChecker.GetTypeAtLocation(current)
+					return ctx.Types.GetTypeAtLocation(current)
 
 				case ast.KindBindingElement:
 					// For nested destructuring like { bar: { anchor } }
@@ -426,9 +426,9 @@ var NoDeprecatedRule = rule.Rule{
 						}
 
 						// Get the type of this property
-						property := checker.Checker_getPropertyOfType(ctx.TypeChecker, parentSourceType, propertyName)
+						property := ctx.Types.GetPropertyOfType(parentSourceType, propertyName)
 						if property != nil {
-							return ctx.TypeChecker.GetTypeOfSymbolAtLocation(property, current)
+							return ctx.Types.GetTypeOfSymbolAtLocation(property, current)
 						}
 					}
 					return nil
@@ -441,9 +441,9 @@ var NoDeprecatedRule = rule.Rule{
 					}
 					// For arrays/tuples, try to get the first element type (index 0)
 					// Try getting property "0" for tuple types
-					property := checker.Checker_getPropertyOfType(ctx.TypeChecker, parentSourceType, "0")
+					property := ctx.Types.GetPropertyOfType(parentSourceType, "0")
 					if property != nil {
-						return ctx.TypeChecker.GetTypeOfSymbolAtLocation(property, current)
+						return ctx.Types.GetTypeOfSymbolAtLocation(property, current)
 					}
 					return parentSourceType
 
@@ -489,7 +489,7 @@ var NoDeprecatedRule = rule.Rule{
 						// If getBindingPatternSourceType returns nil (e.g., for nested destructuring),
 						// try using TypeChecker.GetTypeAtLocation as a fallback
 						if sourceType == nil {
-							sourceType = ctx.TypeChecker.GetTypeAtLocation(bindingPattern)
+							sourceType = ctx.Types.GetTypeAtLocation(bindingPattern)
 						}
 
 						if sourceType != nil {
@@ -502,8 +502,8 @@ var NoDeprecatedRule = rule.Rule{
 								propertyName = bindingElem.PropertyName.Text()
 							}
 
-							property := checker.Checker_getPropertyOfType(ctx.TypeChecker, sourceType, propertyName)
-							propertySymbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+							property := ctx.Types.GetPropertyOfType(sourceType, propertyName)
+							propertySymbol := ctx.Types.GetSymbolAtLocation(node)
 
 							// Check alias chain first
 							isDeprecated, reason := searchForDeprecationInAliasesChain(propertySymbol, true)
@@ -525,7 +525,7 @@ var NoDeprecatedRule = rule.Rule{
 
 							// Check shorthand assignment value symbol
 							if propertySymbol != nil && propertySymbol.ValueDeclaration != nil {
-								valueSymbol := checker.Checker_GetShorthandAssignmentValueSymbol(ctx.TypeChecker, propertySymbol.ValueDeclaration)
+								valueSymbol := ctx.Types.GetShorthandAssignmentValueSymbol(propertySymbol.ValueDeclaration)
 								isDeprecated, reason = getJsDocDeprecation(valueSymbol)
 								if isDeprecated {
 									return true, reason
@@ -537,10 +537,10 @@ var NoDeprecatedRule = rule.Rule{
 
 				// Handle shorthand property assignments in object literals
 				if parent.Kind == ast.KindShorthandPropertyAssignment && parent.Parent != nil {
-					parentType := ctx.TypeChecker.GetTypeAtLocation(parent.Parent)
+					parentType := ctx.Types.GetTypeAtLocation(parent.Parent)
 					if parentType != nil {
-						propertySymbol := ctx.TypeChecker.GetSymbolAtLocation(node)
-						property := checker.Checker_getPropertyOfType(ctx.TypeChecker, parentType, node.Text())
+						propertySymbol := ctx.Types.GetSymbolAtLocation(node)
+						property := ctx.Types.GetPropertyOfType(parentType, node.Text())
 
 						// Check alias chain first
 						isDeprecated, reason := searchForDeprecationInAliasesChain(propertySymbol, true)
@@ -562,7 +562,7 @@ var NoDeprecatedRule = rule.Rule{
 
 						// Check shorthand assignment value symbol
 						if propertySymbol != nil && propertySymbol.ValueDeclaration != nil {
-							valueSymbol := checker.Checker_GetShorthandAssignmentValueSymbol(ctx.TypeChecker, propertySymbol.ValueDeclaration)
+							valueSymbol := ctx.Types.GetShorthandAssignmentValueSymbol(propertySymbol.ValueDeclaration)
 							isDeprecated, reason = getJsDocDeprecation(valueSymbol)
 							if isDeprecated {
 								return true, reason
@@ -573,7 +573,7 @@ var NoDeprecatedRule = rule.Rule{
 			}
 
 			return searchForDeprecationInAliasesChain(
-				ctx.TypeChecker.GetSymbolAtLocation(node),
+				ctx.Types.GetSymbolAtLocation(node),
 				true,
 			)
 		}
@@ -701,7 +701,7 @@ var NoDeprecatedRule = rule.Rule{
 				return
 			}
 
-			ty := ctx.TypeChecker.GetTypeAtLocation(node)
+			ty := ctx.Types.GetTypeAtLocation(node)
 
 			// TODO: if type OR value is allowed, skip
 
@@ -726,7 +726,7 @@ var NoDeprecatedRule = rule.Rule{
 			}
 
 			// Get the type of the property being accessed
-			propertyType := ctx.TypeChecker.GetTypeAtLocation(eae.ArgumentExpression)
+			propertyType := ctx.Types.GetTypeAtLocation(eae.ArgumentExpression)
 			if propertyType == nil {
 				return
 			}
@@ -740,7 +740,7 @@ var NoDeprecatedRule = rule.Rule{
 				return
 			}
 
-			objectType := ctx.TypeChecker.GetTypeAtLocation(eae.Expression)
+			objectType := ctx.Types.GetTypeAtLocation(eae.Expression)
 
 			// Get the property name from the literal type
 			literalType := propertyType.AsLiteralType()
@@ -762,7 +762,7 @@ var NoDeprecatedRule = rule.Rule{
 				propertyName = literalType.String()
 			}
 
-			property := checker.Checker_getPropertyOfType(ctx.TypeChecker, objectType, propertyName)
+			property := ctx.Types.GetPropertyOfType(objectType, propertyName)
 
 			isDeprecated, reason := getJsDocDeprecation(property)
 			if !isDeprecated {
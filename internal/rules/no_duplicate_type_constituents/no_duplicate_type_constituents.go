@@ -176,7 +176,7 @@ var NoDuplicateTypeConstituentsRule = rule.Rule{
 					}
 				}
 
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
@@ -194,7 +194,7 @@ var NoDuplicateTypeConstituentsRule = rule.Rule{
 			cachedTypeMap map[*checker.Type]*ast.Node,
 			forEachNodeType func(t *checker.Type, node *ast.Node),
 		) bool {
-			t := ctx.TypeChecker.GetTypeAtLocation(constituentNode)
+			t := ctx.Types.GetTypeAtLocation(constituentNode)
 			if utils.IsIntrinsicErrorType(t) {
 				return false
 			}
@@ -156,6 +156,7 @@ type T = Record<string, A | B>;
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "duplicate",
+					FixKind:   "safe",
 				},
 			},
 		},
@@ -90,28 +90,30 @@ var NoFloatingPromisesRule = rule.Rule{
 			return nodePrecedence > ast.OperatorPrecedenceUnary
 		}
 
+		// Awaiting the promise suspends the function until it settles, and
+		// throws if it rejects, so the fixes are unsafe.
 		addAwait := func(
 			expression *ast.Expression,
 			node *ast.ExpressionStatement,
 		) []rule.RuleFix {
 			if ast.IsVoidExpression(expression) {
 				voidTokenRange := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, expression.Pos())
-				return []rule.RuleFix{rule.RuleFixReplaceRange(voidTokenRange, "await")}
+				return []rule.RuleFix{rule.RuleFixReplaceRange(voidTokenRange, "await").WithKind(rule.RuleFixKindUnsafe)}
 			}
 			if isHigherPrecedenceThanUnary(node.Expression) {
-				return []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, &node.Node, "await ")}
+				return []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, &node.Node, "await ").WithKind(rule.RuleFixKindUnsafe)}
 			}
-			return []rule.RuleFix{
+			return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 				rule.RuleFixInsertBefore(ctx.SourceFile, &node.Node, "await ("),
 				rule.RuleFixInsertAfter(expression, ")"),
-			}
+			})
 		}
 		hasMatchingSignature := func(
 			t *checker.Type,
 			matcher func(signature *checker.Signature) bool,
 		) bool {
-			for _, part := range utils.UnionTypeParts(t) {
-				if utils.Some(utils.GetCallSignatures(ctx.TypeChecker, part), matcher) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
+				if utils.Some(ctx.Types.GetCallSignatures(part), matcher) {
 					return true
 				}
 			}
@@ -123,9 +125,9 @@ var NoFloatingPromisesRule = rule.Rule{
 			param *ast.Symbol,
 			node *ast.Node,
 		) bool {
-			t := checker.Checker_getApparentType(ctx.TypeChecker, ctx.TypeChecker.GetTypeOfSymbolAtLocation(param, node))
-			for _, part := range utils.UnionTypeParts(t) {
-				if len(utils.GetCallSignatures(ctx.TypeChecker, part)) != 0 {
+			t := ctx.Types.GetApparentType(ctx.Types.GetTypeOfSymbolAtLocation(param, node))
+			for _, part := range ctx.Types.UnionTypeParts(t) {
+				if len(ctx.Types.GetCallSignatures(part)) != 0 {
 					return true
 				}
 			}
@@ -133,7 +135,7 @@ var NoFloatingPromisesRule = rule.Rule{
 		}
 		isPromiseLike := func(node *ast.Node, t *checker.Type) bool {
 			if t == nil {
-				t = ctx.TypeChecker.GetTypeAtLocation(node)
+				t = ctx.Types.GetTypeAtLocation(node)
 			}
 
 			// The highest priority is to allow anything allowlisted
@@ -146,9 +148,9 @@ var NoFloatingPromisesRule = rule.Rule{
 			}
 
 			// Otherwise, we always consider the built-in Promise to be Promise-like...
-			typeParts := utils.UnionTypeParts(checker.Checker_getApparentType(ctx.TypeChecker, t))
+			typeParts := ctx.Types.UnionTypeParts(ctx.Types.GetApparentType(t))
 			if utils.Some(typeParts, func(typePart *checker.Type) bool {
-				return utils.IsPromiseLike(ctx.Program, ctx.TypeChecker, typePart)
+				return ctx.Types.IsPromiseLike(typePart)
 			}) {
 				return true
 			}
@@ -163,12 +165,12 @@ var NoFloatingPromisesRule = rule.Rule{
 			//
 			//   https://github.com/ajafff/tsutils/blob/49d0d31050b44b81e918eae4fbaf1dfe7b7286af/util/type.ts#L95-L125
 			for _, typePart := range typeParts {
-				then := checker.Checker_getPropertyOfType(ctx.TypeChecker, typePart, "then")
+				then := ctx.Types.GetPropertyOfType(typePart, "then")
 				if then == nil {
 					continue
 				}
 
-				thenType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(then, node)
+				thenType := ctx.Types.GetTypeOfSymbolAtLocation(then, node)
 				if hasMatchingSignature(
 					thenType,
 					func(signature *checker.Signature) bool {
@@ -181,18 +183,18 @@ var NoFloatingPromisesRule = rule.Rule{
 			return false
 		}
 		isPromiseArray := func(node *ast.Node, t *checker.Type) bool {
-			for _, typePart := range utils.UnionTypeParts(t) {
-				apparent := checker.Checker_getApparentType(ctx.TypeChecker, typePart)
+			for _, typePart := range ctx.Types.UnionTypeParts(t) {
+				apparent := ctx.Types.GetApparentType(typePart)
 
-				if checker.Checker_isArrayType(ctx.TypeChecker, apparent) {
-					arrayType := checker.Checker_getTypeArguments(ctx.TypeChecker, apparent)[0]
+				if ctx.Types.IsArrayType(apparent) {
+					arrayType := ctx.Types.GetTypeArguments(apparent)[0]
 					if isPromiseLike(node, arrayType) {
 						return true
 					}
 				}
 
 				if checker.IsTupleType(apparent) {
-					for _, tupleElementType := range checker.Checker_getTypeArguments(ctx.TypeChecker, apparent) {
+					for _, tupleElementType := range ctx.Types.GetTypeArguments(apparent) {
 						if isPromiseLike(node, tupleElementType) {
 							return true
 						}
@@ -213,7 +215,7 @@ var NoFloatingPromisesRule = rule.Rule{
 
 			callExpression := node.AsCallExpression()
 
-			t := ctx.TypeChecker.GetTypeAtLocation(callExpression.Expression)
+			t := ctx.Types.GetTypeAtLocation(callExpression.Expression)
 
 			if utils.ValueMatchesSomeSpecifier(
 				callExpression.Expression,
@@ -242,7 +244,7 @@ var NoFloatingPromisesRule = rule.Rule{
 		}
 
 		isValidRejectionHandler := func(rejectionHandler *ast.Node) bool {
-			return len(utils.GetCallSignatures(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(rejectionHandler))) > 0
+			return len(ctx.Types.GetCallSignatures(ctx.Types.GetTypeAtLocation(rejectionHandler))) > 0
 		}
 
 		isKnownArgumentAt := func(args *ast.ArgumentList, index int) bool {
@@ -286,7 +288,7 @@ var NoFloatingPromisesRule = rule.Rule{
 			// Check the type. At this point it can't be unhandled if it isn't a promise
 			// or array thereof.
 
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 			if isPromiseArray(node, t) {
 				return &unhandledPromise{node: node, t: t, promiseArray: true}
 			}
@@ -314,7 +316,7 @@ var NoFloatingPromisesRule = rule.Rule{
 					// TODO(port): getStaticMemberAccessValue -> GetAccessedPropertyName is an
 					// enhancement, we should probably add tests for it
 					// const methodName = getStaticMemberAccessValue(callee, context);
-					methodName, _ := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+					methodName, _ := ctx.Types.GetAccessedPropertyName(callee)
 
 					if methodName == "catch" && len(callExpr.Arguments.Nodes) >= 1 {
 						if !isKnownArgumentAt(callExpr.Arguments, 0) {
@@ -388,15 +390,15 @@ var NoFloatingPromisesRule = rule.Rule{
 				typeDescription = "This array contains Promises and"
 			}
 			labels := []rule.RuleLabeledRange{{
-				Label: fmt.Sprintf("%s has type `%s`.", typeDescription, ctx.TypeChecker.TypeToString(result.t)),
+				Label: fmt.Sprintf("%s has type `%s`.", typeDescription, ctx.Types.TypeToString(result.t)),
 				Range: diagnosticRange,
 			}}
 			if result.nonFunctionHandler != nil {
-				handlerType := ctx.TypeChecker.GetTypeAtLocation(result.nonFunctionHandler)
+				handlerType := ctx.Types.GetTypeAtLocation(result.nonFunctionHandler)
 				labels = append(labels, rule.RuleLabeledRange{
 					Label: fmt.Sprintf(
 						"This rejection handler has type `%s`, which is not callable.",
-						ctx.TypeChecker.TypeToString(handlerType),
+						ctx.Types.TypeToString(handlerType),
 					),
 					Range: utils.TrimNodeTextRange(ctx.SourceFile, result.nonFunctionHandler),
 				})
@@ -447,7 +449,8 @@ var NoFloatingPromisesRule = rule.Rule{
 						return []rule.RuleSuggestion{
 							{
 								Message: buildFloatingFixVoidMessage(),
-								FixesArr: func() []rule.RuleFix {
+								// The value of an expression statement is unused
+								FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindSafe, func() []rule.RuleFix {
 									if isHigherPrecedenceThanUnary(exprStatement.Expression) {
 										return []rule.RuleFix{rule.RuleFixInsertBefore(ctx.SourceFile, node, "void ")}
 									}
@@ -455,7 +458,7 @@ var NoFloatingPromisesRule = rule.Rule{
 										rule.RuleFixInsertBefore(ctx.SourceFile, node, "void ("),
 										rule.RuleFixInsertAfter(expression, ")"),
 									}
-								}(),
+								}()),
 							},
 							{
 								Message:  buildFloatingFixAwaitMessage(),
@@ -866,6 +866,7 @@ async function test() {
   Promise.resolve('value').finally();
 }
       `,
+							Kind: "safe",
 						},
 						{
 							MessageId: "floatingFixAwait",
@@ -877,6 +878,7 @@ async function test() {
   Promise.resolve('value').finally();
 }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
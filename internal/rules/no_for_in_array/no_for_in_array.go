@@ -19,22 +19,22 @@ var NoForInArrayRule = rule.Rule{
 	Name: "no-for-in-array",
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		hasArrayishLength := func(t *checker.Type) bool {
-			lengthProperty := checker.Checker_getPropertyOfType(ctx.TypeChecker, t, "length")
+			lengthProperty := ctx.Types.GetPropertyOfType(t, "length")
 			if lengthProperty == nil {
 				return false
 			}
 
-			return utils.IsTypeFlagSet(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, lengthProperty), checker.TypeFlagsNumberLike)
+			return utils.IsTypeFlagSet(ctx.Types.GetTypeOfSymbol(lengthProperty), checker.TypeFlagsNumberLike)
 		}
 		isArrayLike := func(t *checker.Type) bool {
 			return utils.TypeRecurser(t, func(t *checker.Type) bool {
-				return utils.GetNumberIndexType(ctx.TypeChecker, t) != nil && hasArrayishLength(t)
+				return ctx.Types.GetNumberIndexType(t) != nil && hasArrayishLength(t)
 			})
 		}
 
 		return rule.RuleListeners{
 			ast.KindForInStatement: func(node *ast.Node) {
-				t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node.AsForInOrOfStatement().Expression)
+				t := ctx.Types.GetConstrainedTypeAtLocation(node.AsForInOrOfStatement().Expression)
 
 				if isArrayLike(t) {
 					ctx.ReportRange(
@@ -51,7 +51,7 @@ var NoImpliedEvalRule = rule.Rule{
 		}
 
 		isFunctionType := func(node *ast.Node) bool {
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 			symbol := checker.Type_symbol(t)
 
 			if symbol != nil && utils.IsSymbolFlagSet(symbol, ast.SymbolFlagsFunction|ast.SymbolFlagsMethod) {
@@ -62,7 +62,7 @@ var NoImpliedEvalRule = rule.Rule{
 				return true
 			}
 
-			return len(utils.GetCallSignatures(ctx.TypeChecker, t)) > 0
+			return len(ctx.Types.GetCallSignatures(t)) > 0
 		}
 
 		isBind := func(node *ast.Node) bool {
@@ -94,7 +94,7 @@ var NoImpliedEvalRule = rule.Rule{
 			}
 
 			if calleeName == "Function" {
-				t := ctx.TypeChecker.GetTypeAtLocation(node.Expression())
+				t := ctx.Types.GetTypeAtLocation(node.Expression())
 				symbol := checker.Type_symbol(t)
 
 				if symbol != nil {
@@ -114,7 +114,7 @@ var NoImpliedEvalRule = rule.Rule{
 			handler := node.Arguments()[0]
 
 			if slices.Contains(evalLikeFunctions, calleeName) && !isFunction(handler) {
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node.Expression())
+				symbol := ctx.Types.GetSymbolAtLocation(node.Expression())
 				if symbol == nil || !utils.Some(symbol.Declarations, func(d *ast.Node) bool {
 					return ast.GetSourceFileOfNode(d) == ctx.SourceFile
 				}) {
@@ -30,9 +30,9 @@ var NoMeaninglessVoidOperatorRule = rule.Rule{
 		return rule.RuleListeners{
 			ast.KindVoidExpression: func(node *ast.Node) {
 				arg := node.AsVoidExpression().Expression
-				argType := ctx.TypeChecker.GetTypeAtLocation(arg)
+				argType := ctx.Types.GetTypeAtLocation(arg)
 
-				unionParts := utils.UnionTypeParts(argType)
+				unionParts := ctx.Types.UnionTypeParts(argType)
 
 				isAlwaysVoidLike := utils.Every(unionParts, func(t *checker.Type) bool {
 					return utils.IsTypeFlagSet(t, checker.TypeFlagsVoidLike)
@@ -41,17 +41,18 @@ var NoMeaninglessVoidOperatorRule = rule.Rule{
 					return utils.IsTypeFlagSet(t, checker.TypeFlagsVoidLike|checker.TypeFlagsNever)
 				})
 
-				fixRemoveVoidKeyword := func() rule.RuleFix {
-					return rule.RuleFixRemoveRange(utils.TrimNodeTextRange(ctx.SourceFile, node).WithEnd(arg.Pos()))
+				fixRemoveVoidKeyword := func(kind rule.RuleFixKind) rule.RuleFix {
+					return rule.RuleFixRemoveRange(utils.TrimNodeTextRange(ctx.SourceFile, node).WithEnd(arg.Pos())).WithKind(kind)
 				}
 
 				if isAlwaysVoidLike {
-					ctx.ReportNodeWithFixes(node, buildMeaninglessVoidOperatorMessage(ctx.TypeChecker.TypeToString(argType)), func() []rule.RuleFix { return []rule.RuleFix{fixRemoveVoidKeyword()} })
+					ctx.ReportNodeWithFixes(node, buildMeaninglessVoidOperatorMessage(ctx.Types.TypeToString(argType)), func() []rule.RuleFix { return []rule.RuleFix{fixRemoveVoidKeyword(rule.RuleFixKindSafe)} })
 				} else if opts.CheckNever && isAlwaysVoidLikeOrNever {
-					ctx.ReportNodeWithSuggestions(node, buildMeaninglessVoidOperatorMessage(ctx.TypeChecker.TypeToString(argType)), func() []rule.RuleSuggestion {
+					ctx.ReportNodeWithSuggestions(node, buildMeaninglessVoidOperatorMessage(ctx.Types.TypeToString(argType)), func() []rule.RuleSuggestion {
+						// The argument may not be `never` at runtime
 						return []rule.RuleSuggestion{{
 							Message:  buildRemoveVoidMessage(),
-							FixesArr: []rule.RuleFix{fixRemoveVoidKeyword()},
+							FixesArr: []rule.RuleFix{fixRemoveVoidKeyword(rule.RuleFixKindUnsafe)},
 						}}
 					})
 				}
@@ -34,6 +34,7 @@ function bar(x: never) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "meaninglessVoidOperator",
+					FixKind:   "safe",
 					Line:      1,
 					Column:    1,
 				},
@@ -77,6 +78,7 @@ function bar(x: never) {
    x;
 }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
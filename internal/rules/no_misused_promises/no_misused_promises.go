@@ -122,14 +122,14 @@ var NoMisusedPromisesRule = rule.Rule{
 			node *ast.Node,
 			t *checker.Type,
 		) bool {
-			return utils.Some(utils.GetCallSignatures(ctx.TypeChecker, t), func(sig *checker.Signature) bool {
-				return utils.IsThenableType(ctx.TypeChecker, node, checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, sig))
+			return utils.Some(ctx.Types.GetCallSignatures(t), func(sig *checker.Signature) bool {
+				return ctx.Types.IsThenableType(node, ctx.Types.GetReturnTypeOfSignature(sig))
 			})
 		}
 
 		returnsThenable := func(node *ast.Node) bool {
-			t := checker.Checker_getApparentType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(node))
-			return utils.Some(utils.UnionTypeParts(t), func(t *checker.Type) bool {
+			t := ctx.Types.GetApparentType(ctx.Types.GetTypeAtLocation(node))
+			return utils.Some(ctx.Types.UnionTypeParts(t), func(t *checker.Type) bool {
 				return anySignatureIsThenableType(node, t)
 			})
 		}
@@ -194,16 +194,16 @@ var NoMisusedPromisesRule = rule.Rule{
 			message rule.RuleMessage,
 			expectation *voidExpectation,
 		) rule.RuleDiagnostic {
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 			valueDescription := "This expression"
-			if ast.IsFunctionLike(ast.SkipParentheses(node)) || len(utils.GetCallSignatures(ctx.TypeChecker, t)) != 0 {
+			if ast.IsFunctionLike(ast.SkipParentheses(node)) || len(ctx.Types.GetCallSignatures(t)) != 0 {
 				valueDescription = "This callback"
 			}
 			diagnostic := rule.RuleDiagnostic{
 				Range:   primaryRange,
 				Message: message,
 				LabeledRanges: []rule.RuleLabeledRange{{
-					Label: fmt.Sprintf("%s has type `%s`.", valueDescription, ctx.TypeChecker.TypeToString(t)),
+					Label: fmt.Sprintf("%s has type `%s`.", valueDescription, ctx.Types.TypeToString(t)),
 					Range: promiseRange(node),
 				}},
 			}
@@ -211,7 +211,7 @@ var NoMisusedPromisesRule = rule.Rule{
 				diagnostic.LabeledRanges = append(diagnostic.LabeledRanges, rule.RuleLabeledRange{
 					Label: fmt.Sprintf(
 						"This context accepts a `void`-returning callback through type `%s`.",
-						ctx.TypeChecker.TypeToString(expectation.t),
+						ctx.Types.TypeToString(expectation.t),
 					),
 					Range: expectationRange(expectation),
 				})
@@ -247,12 +247,12 @@ var NoMisusedPromisesRule = rule.Rule{
 			param *ast.Symbol,
 			node *ast.Node,
 		) bool {
-			t := checker.Checker_getApparentType(ctx.TypeChecker, ctx.TypeChecker.GetTypeOfSymbolAtLocation(param, node))
+			t := ctx.Types.GetApparentType(ctx.Types.GetTypeOfSymbolAtLocation(param, node))
 			if t == nil {
 				return false
 			}
-			return utils.Some(utils.UnionTypeParts(t), func(t *checker.Type) bool {
-				return len(utils.GetCallSignatures(ctx.TypeChecker, t)) != 0
+			return utils.Some(ctx.Types.UnionTypeParts(t), func(t *checker.Type) bool {
+				return len(ctx.Types.GetCallSignatures(t)) != 0
 			})
 		}
 
@@ -261,10 +261,10 @@ var NoMisusedPromisesRule = rule.Rule{
 		// check if something is defined or undefined and get caught because one of the
 		// branches is thenable.
 		isAlwaysThenable := func(node *ast.Node) bool {
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 
-			for _, subType := range utils.UnionTypeParts(checker.Checker_getApparentType(ctx.TypeChecker, t)) {
-				thenProp := checker.Checker_getPropertyOfType(ctx.TypeChecker, subType, "then")
+			for _, subType := range ctx.Types.UnionTypeParts(ctx.Types.GetApparentType(t)) {
+				thenProp := ctx.Types.GetPropertyOfType(subType, "then")
 
 				// If one of the alternates has no then property, it is not thenable in all
 				// cases.
@@ -275,10 +275,10 @@ var NoMisusedPromisesRule = rule.Rule{
 				// We walk through each variation of the then property. Since we know it
 				// exists at this point, we just need at least one of the alternates to
 				// be of the right form to consider it thenable.
-				thenType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(thenProp, node)
+				thenType := ctx.Types.GetTypeOfSymbolAtLocation(thenProp, node)
 				hasThenableSignature := false
-				for _, subType := range utils.UnionTypeParts(thenType) {
-					for _, signature := range utils.GetCallSignatures(ctx.TypeChecker, subType) {
+				for _, subType := range ctx.Types.UnionTypeParts(thenType) {
+					for _, signature := range ctx.Types.GetCallSignatures(subType) {
 						params := checker.Signature_parameters(signature)
 						if len(params) != 0 && isFunctionParam(params[0], node) {
 							hasThenableSignature = true
@@ -357,7 +357,7 @@ var NoMisusedPromisesRule = rule.Rule{
 				return symbol
 			}
 
-			return checker.Checker_getPropertyOfType(ctx.TypeChecker, t, memberName)
+			return ctx.Types.GetPropertyOfType(t, memberName)
 		}
 
 		isVoidReturningFunctionType := func(
@@ -365,12 +365,12 @@ var NoMisusedPromisesRule = rule.Rule{
 			t *checker.Type,
 		) bool {
 			hadVoidReturn := false
-			for _, t := range utils.UnionTypeParts(t) {
-				for _, sig := range utils.GetCallSignatures(ctx.TypeChecker, t) {
-					returnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, sig)
+			for _, t := range ctx.Types.UnionTypeParts(t) {
+				for _, sig := range ctx.Types.GetCallSignatures(t) {
+					returnType := ctx.Types.GetReturnTypeOfSignature(sig)
 					// If a certain positional argument accepts both thenable and void returns,
 					// a promise-returning function is valid
-					if utils.IsThenableType(ctx.TypeChecker, node, returnType) {
+					if ctx.Types.IsThenableType(node, returnType) {
 						return false
 					}
 
@@ -396,7 +396,7 @@ var NoMisusedPromisesRule = rule.Rule{
 			if heritageMember == nil {
 				return
 			}
-			memberType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(
+			memberType := ctx.Types.GetTypeOfSymbolAtLocation(
 				heritageMember,
 				nodeMember,
 			)
@@ -405,7 +405,7 @@ var NoMisusedPromisesRule = rule.Rule{
 			}
 			reportNode(
 				nodeMember,
-				buildVoidReturnInheritedMethodMessage(ctx.TypeChecker.TypeToString(heritageType)),
+				buildVoidReturnInheritedMethodMessage(ctx.Types.TypeToString(heritageType)),
 				localExpectationForSymbol(heritageMember, memberType),
 			)
 		}
@@ -419,12 +419,12 @@ var NoMisusedPromisesRule = rule.Rule{
 			if expression == nil {
 				return
 			}
-			contextualType := checker.Checker_getContextualType(ctx.TypeChecker, node.Initializer, checker.ContextFlagsNone)
+			contextualType := ctx.Types.GetContextualType(node.Initializer, checker.ContextFlagsNone)
 			if contextualType != nil && isVoidReturningFunctionType(node.Initializer, contextualType) && returnsThenable(expression) {
 				var expectation *voidExpectation
-				attributesType := checker.Checker_getContextualType(ctx.TypeChecker, node.AsNode().Parent, checker.ContextFlagsNone)
+				attributesType := ctx.Types.GetContextualType(node.AsNode().Parent, checker.ContextFlagsNone)
 				if attributesType != nil {
-					propertySymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, attributesType, node.Name().Text())
+					propertySymbol := ctx.Types.GetPropertyOfType(attributesType, node.Name().Text())
 					expectation = localExpectationForSymbol(propertySymbol, contextualType)
 				}
 				reportNode(
@@ -437,7 +437,7 @@ var NoMisusedPromisesRule = rule.Rule{
 
 		checkSpread := func(node *ast.Node) {
 			expression := node.Expression()
-			if utils.IsThenableType(ctx.TypeChecker, expression, nil) {
+			if ctx.Types.IsThenableType(expression, nil) {
 				spreadRange := utils.TrimNodeTextRange(ctx.SourceFile, node).WithEnd(
 					utils.TrimNodeTextRange(ctx.SourceFile, expression).Pos(),
 				)
@@ -449,7 +449,7 @@ var NoMisusedPromisesRule = rule.Rule{
 			node *ast.Node,
 			t *checker.Type,
 		) bool {
-			return utils.Some(utils.UnionTypeParts(t), func(t *checker.Type) bool {
+			return utils.Some(ctx.Types.UnionTypeParts(t), func(t *checker.Type) bool {
 				return anySignatureIsThenableType(node, t)
 			})
 		}
@@ -488,7 +488,7 @@ var NoMisusedPromisesRule = rule.Rule{
 					}
 				}
 			}
-			contextualType := checker.Checker_getContextualTypeForArgumentAtIndex(ctx.TypeChecker, node, index)
+			contextualType := ctx.Types.GetContextualTypeForArgumentAtIndex(node, index)
 
 			if contextualType != t {
 				checkThenableOrVoidArgument(
@@ -521,32 +521,32 @@ var NoMisusedPromisesRule = rule.Rule{
 			thenableReturnIndices := []int{}
 			voidReturnIndices := []int{}
 			voidReturnExpectations := map[int]*voidExpectation{}
-			t := ctx.TypeChecker.GetTypeAtLocation(node.Expression())
+			t := ctx.Types.GetTypeAtLocation(node.Expression())
 
 			// We can't use checker.getResolvedSignature because it prefers an early '() => void' over a later '() => Promise<void>'
 			// See https://github.com/microsoft/TypeScript/issues/48077
 
-			for _, subType := range utils.UnionTypeParts(t) {
+			for _, subType := range ctx.Types.UnionTypeParts(t) {
 				// Standard function calls and `new` have two different types of signatures
 				var signatures []*checker.Signature
 				if ast.IsCallExpression(node) {
-					signatures = utils.GetCallSignatures(ctx.TypeChecker, subType)
+					signatures = ctx.Types.GetCallSignatures(subType)
 				} else {
-					signatures = utils.GetConstructSignatures(ctx.TypeChecker, subType)
+					signatures = ctx.Types.GetConstructSignatures(subType)
 				}
 				for _, signature := range signatures {
 					for index, parameter := range checker.Signature_parameters(signature) {
 						parameterDeclaration := parameter.ValueDeclaration
-						t := ctx.TypeChecker.GetTypeOfSymbolAtLocation(parameter, node.Expression())
+						t := ctx.Types.GetTypeOfSymbolAtLocation(parameter, node.Expression())
 
 						// If this is a array 'rest' parameter, check all of the argument indices
 						// from the current argument to the end.
 						if parameterDeclaration != nil && utils.IsRestParameterDeclaration(parameterDeclaration) {
-							if checker.Checker_isArrayType(ctx.TypeChecker, t) {
+							if ctx.Types.IsArrayType(t) {
 								// Unwrap 'Array<MaybeVoidFunction>' to 'MaybeVoidFunction',
 								// so that we'll handle it in the same way as a non-rest
 								// 'param: MaybeVoidFunction'
-								t = checker.Checker_getTypeArguments(ctx.TypeChecker, t)[0]
+								t = ctx.Types.GetTypeArguments(t)[0]
 								for i := index; i < len(node.Arguments()); i++ {
 									checkThenableOrVoidArgument(
 										node,
@@ -562,7 +562,7 @@ var NoMisusedPromisesRule = rule.Rule{
 							} else if checker.IsTupleType(t) {
 								// Check each type in the tuple - for example, [boolean, () => void] would
 								// add the index of the second tuple parameter to 'voidReturnIndices'
-								typeArgs := checker.Checker_getTypeArguments(ctx.TypeChecker, t)
+								typeArgs := ctx.Types.GetTypeArguments(t)
 								for i := index; i < len(node.Arguments()) && i-index < len(typeArgs); i++ {
 									checkThenableOrVoidArgument(
 										node,
@@ -631,7 +631,7 @@ var NoMisusedPromisesRule = rule.Rule{
 
 			heritageTypes := utils.Flatten(utils.Map(heritageClauses.Nodes, func(h *ast.Node) []*checker.Type {
 				return utils.Map(h.AsHeritageClause().Types.Nodes, func(n *ast.Node) *checker.Type {
-					return ctx.TypeChecker.GetTypeAtLocation(n)
+					return ctx.Types.GetTypeAtLocation(n)
 				})
 			}))
 
@@ -667,18 +667,18 @@ var NoMisusedPromisesRule = rule.Rule{
 			if propertyNode.Name() == nil || !ast.IsObjectLiteralExpression(propertyNode.Parent) {
 				return nil
 			}
-			objType := checker.Checker_getContextualType(ctx.TypeChecker, propertyNode.Parent, checker.ContextFlagsNone)
+			objType := ctx.Types.GetContextualType(propertyNode.Parent, checker.ContextFlagsNone)
 			if objType == nil {
 				return nil
 			}
-			propertySymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, objType, propertyNode.Name().Text())
+			propertySymbol := ctx.Types.GetPropertyOfType(objType, propertyNode.Name().Text())
 			return localExpectationForSymbol(propertySymbol, t)
 		}
 
 		checkProperty := func(node *ast.Node) {
 			if ast.IsPropertyAssignment(node) {
 				property := node.AsPropertyAssignment()
-				contextualType := checker.Checker_getContextualType(ctx.TypeChecker, property.Initializer, checker.ContextFlagsNone)
+				contextualType := ctx.Types.GetContextualType(property.Initializer, checker.ContextFlagsNone)
 
 				if contextualType != nil && isVoidReturningFunctionType(
 					property.Initializer,
@@ -691,7 +691,7 @@ var NoMisusedPromisesRule = rule.Rule{
 					)
 				}
 			} else if ast.IsShorthandPropertyAssignment(node) {
-				contextualType := checker.Checker_getContextualType(ctx.TypeChecker, node.Name(), checker.ContextFlagsNone)
+				contextualType := ctx.Types.GetContextualType(node.Name(), checker.ContextFlagsNone)
 				if contextualType != nil &&
 					isVoidReturningFunctionType(node.Name(), contextualType) &&
 					returnsThenable(node.Name()) {
@@ -720,16 +720,16 @@ var NoMisusedPromisesRule = rule.Rule{
 				if !returnsThenable(node) {
 					return
 				}
-				objType := checker.Checker_getContextualType(ctx.TypeChecker, obj, checker.ContextFlagsNone)
+				objType := ctx.Types.GetContextualType(obj, checker.ContextFlagsNone)
 				if objType == nil {
 					return
 				}
-				propertySymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, objType, node.Name().Text())
+				propertySymbol := ctx.Types.GetPropertyOfType(objType, node.Name().Text())
 				if propertySymbol == nil {
 					return
 				}
 
-				contextualType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(
+				contextualType := ctx.Types.GetTypeOfSymbolAtLocation(
 					propertySymbol,
 					node.Name(),
 				)
@@ -824,7 +824,7 @@ var NoMisusedPromisesRule = rule.Rule{
 				return
 			}
 
-			contextualType := checker.Checker_getContextualType(ctx.TypeChecker, node.Expression, checker.ContextFlagsNone)
+			contextualType := ctx.Types.GetContextualType(node.Expression, checker.ContextFlagsNone)
 			if contextualType != nil &&
 				isVoidReturningFunctionType(
 					node.Expression,
@@ -836,8 +836,8 @@ var NoMisusedPromisesRule = rule.Rule{
 				} else if functionNode != nil {
 					// The enclosing function may have no contextual type of its own, e.g. a
 					// getter whose type comes from its paired setter's parameter.
-					if functionType := checker.Checker_getContextualType(ctx.TypeChecker, functionNode, checker.ContextFlagsNone); functionType != nil {
-						for _, signature := range utils.GetCallSignatures(ctx.TypeChecker, functionType) {
+					if functionType := ctx.Types.GetContextualType(functionNode, checker.ContextFlagsNone); functionType != nil {
+						for _, signature := range ctx.Types.GetCallSignatures(functionType) {
 							if expectation = localExpectationForDeclaration(checker.Signature_declaration(signature), contextualType); expectation != nil {
 								break
 							}
@@ -849,15 +849,15 @@ var NoMisusedPromisesRule = rule.Rule{
 		}
 
 		checkAssignment := func(node *ast.BinaryExpression) {
-			varType := ctx.TypeChecker.GetTypeAtLocation(node.Left)
+			varType := ctx.Types.GetTypeAtLocation(node.Left)
 			if !isVoidReturningFunctionType(node.Left, varType) {
 				return
 			}
 
 			if returnsThenable(node.Right) {
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node.Left)
+				symbol := ctx.Types.GetSymbolAtLocation(node.Left)
 				if symbol == nil && node.Left.Name() != nil {
-					symbol = ctx.TypeChecker.GetSymbolAtLocation(node.Left.Name())
+					symbol = ctx.Types.GetSymbolAtLocation(node.Left.Name())
 				}
 				reportNode(
 					node.Right,
@@ -878,7 +878,7 @@ var NoMisusedPromisesRule = rule.Rule{
 				return
 			}
 
-			varType := ctx.TypeChecker.GetTypeAtLocation(node.Name())
+			varType := ctx.Types.GetTypeAtLocation(node.Name())
 			if !isVoidReturningFunctionType(node.Initializer, varType) {
 				return
 			}
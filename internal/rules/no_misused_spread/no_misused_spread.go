@@ -151,7 +151,7 @@ var NoMisusedSpreadRule = rule.Rule{
 		opts := utils.UnmarshalOptions[NoMisusedSpreadOptions](options, "no-misused-spread")
 
 		checkArrayOrCallSpread := func(node *ast.Node) {
-			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node.AsSpreadElement().Expression)
+			t := ctx.Types.GetConstrainedTypeAtLocation(node.AsSpreadElement().Expression)
 			if !utils.TypeMatchesSomeSpecifier(t, opts.Allow, ctx.Program) && isString(t) {
 				ctx.ReportNode(node, buildNoStringSpreadMessage())
 			}
@@ -159,19 +159,19 @@ var NoMisusedSpreadRule = rule.Rule{
 
 		insertAwaitFix := func(node *ast.Node) []rule.RuleFix {
 			if utils.IsHigherPrecedenceThanAwait(node) {
-				return []rule.RuleFix{
+				return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 					rule.RuleFixInsertBefore(ctx.SourceFile, node, "await "),
-				}
+				})
 			}
-			return []rule.RuleFix{
+			return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 				rule.RuleFixInsertBefore(ctx.SourceFile, node, "await ("),
 				rule.RuleFixInsertAfter(node, ")"),
-			}
+			})
 		}
 
 		getMapSpreadSuggestions := func(node *ast.Node, argument *ast.Node, t *checker.Type) []rule.RuleSuggestion {
 			// TODO(port): do we need this loop?
-			for _, t := range utils.UnionTypeParts(t) {
+			for _, t := range ctx.Types.UnionTypeParts(t) {
 				if !isMap(ctx.Program, ctx.TypeChecker, t) {
 					return []rule.RuleSuggestion{}
 				}
@@ -183,12 +183,12 @@ var NoMisusedSpreadRule = rule.Rule{
 					return []rule.RuleSuggestion{
 						{
 							Message: buildReplaceMapSpreadInObjectMessage(),
-							FixesArr: []rule.RuleFix{
+							FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 								rule.RuleFixRemoveRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Parent.Pos())),                  // {
 								rule.RuleFixReplaceRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Pos()), "Object.fromEntries("), // ...
 								rule.RuleFixReplaceRange(properties.Loc.WithPos(argument.End()), ")"),
 								rule.RuleFixRemoveRange(node.Parent.Loc.WithPos(node.Parent.End() - 1)), // }
-							},
+							}),
 						},
 					}
 				}
@@ -197,16 +197,16 @@ var NoMisusedSpreadRule = rule.Rule{
 			return []rule.RuleSuggestion{
 				{
 					Message: buildReplaceMapSpreadInObjectMessage(),
-					FixesArr: []rule.RuleFix{
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 						rule.RuleFixInsertBefore(ctx.SourceFile, argument, "Object.fromEntries("),
 						rule.RuleFixInsertAfter(argument, ")"),
-					},
+					}),
 				},
 			}
 		}
 
 		checkObjectSpread := func(node *ast.Node, argument *ast.Node) {
-			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, argument)
+			t := ctx.Types.GetConstrainedTypeAtLocation(argument)
 
 			if utils.TypeMatchesSomeSpecifier(t, opts.Allow, ctx.Program) {
 				return
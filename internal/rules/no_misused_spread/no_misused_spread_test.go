@@ -708,6 +708,7 @@ func TestNoMisusedSpreadRule(t *testing.T) {
           ]))
         ;
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
@@ -999,6 +1000,7 @@ func TestNoMisusedSpreadRule(t *testing.T) {
         const promise = new Promise(() => {});
         const o = { ...await promise };
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
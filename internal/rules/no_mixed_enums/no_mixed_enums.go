@@ -38,7 +38,7 @@ var NoMixedEnumsRule = rule.Rule{
 			case ast.KindStringLiteral:
 				return allowedTypeString
 			default:
-				t := ctx.TypeChecker.GetTypeAtLocation(initializer)
+				t := ctx.Types.GetTypeAtLocation(initializer)
 				if utils.IsTypeFlagSet(t, checker.TypeFlagsStringLike) {
 					return allowedTypeString
 				}
@@ -49,7 +49,7 @@ var NoMixedEnumsRule = rule.Rule{
 			}
 		}
 		getDesiredTypeForDefinition := func(node *ast.Node) allowedType {
-			symbol := ctx.TypeChecker.GetSymbolAtLocation(node.Name())
+			symbol := ctx.Types.GetSymbolAtLocation(node.Name())
 
 			declaration := symbol.Declarations[0]
 
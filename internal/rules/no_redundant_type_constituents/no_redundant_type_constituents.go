@@ -151,13 +151,13 @@ var NoRedundantTypeConstituentsRule = rule.Rule{
 				return result
 			}
 
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 
 			var typeParts []*checker.Type
 			if t == checker.Checker_booleanType(ctx.TypeChecker) {
 				typeParts = []*checker.Type{t}
 			} else {
-				typeParts = utils.UnionTypeParts(t)
+				typeParts = ctx.Types.UnionTypeParts(t)
 			}
 
 			res := make([]typeFlagsWithNodeOrType, len(typeParts))
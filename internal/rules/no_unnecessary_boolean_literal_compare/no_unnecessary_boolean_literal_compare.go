@@ -111,7 +111,7 @@ var NoUnnecessaryBooleanLiteralCompareRule = rule.Rule{
 				return res, false
 			}
 
-			constraintType, isTypeParameter := utils.GetConstraintInfo(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(res.expression))
+			constraintType, isTypeParameter := utils.GetConstraintInfo(ctx.TypeChecker, ctx.Types.GetTypeAtLocation(res.expression))
 
 			if isTypeParameter && constraintType == nil {
 				return res, false
@@ -186,7 +186,7 @@ var NoUnnecessaryBooleanLiteralCompareRule = rule.Rule{
 					if comparison.expressionIsNullableBoolean && !comparison.literalBooleanInComparison {
 						fixes = append(fixes, rule.RuleFixInsertBefore(ctx.SourceFile, mutatedNode, "("), rule.RuleFixInsertAfter(mutatedNode, " ?? true)"))
 					}
-					return fixes
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 				})
 			},
 		}
@@ -125,6 +125,7 @@ const extendsUnknown: <T extends unknown>(
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "direct",
+					FixKind:   "safe",
 				},
 			},
 		},
@@ -339,17 +339,17 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				return t
 			}
 
-			if constrainedObject := checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, objectType); constrainedObject != nil {
+			if constrainedObject := ctx.Types.GetBaseConstraintOfType(objectType); constrainedObject != nil {
 				objectType = constrainedObject
 			}
-			if constrainedIndex := checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, indexType); constrainedIndex != nil {
+			if constrainedIndex := ctx.Types.GetBaseConstraintOfType(indexType); constrainedIndex != nil {
 				indexType = constrainedIndex
 			}
 
 			if checker.Type_flags(indexType)&checker.TypeFlagsStringLiteral != 0 && indexType.IsStringLiteral() {
 				if literal := indexType.AsLiteralType(); literal != nil {
 					if propertyName, ok := literal.Value().(string); ok {
-						if propType := checker.Checker_getTypeOfPropertyOfType(ctx.TypeChecker, objectType, propertyName); propType != nil {
+						if propType := ctx.Types.GetTypeOfPropertyOfType(objectType, propertyName); propType != nil {
 							return propType
 						}
 					}
@@ -358,17 +358,17 @@ var NoUnnecessaryConditionRule = rule.Rule{
 
 			if checker.Type_flags(indexType)&checker.TypeFlagsNumberLiteral != 0 && indexType.IsNumberLiteral() {
 				if literal := indexType.AsLiteralType(); literal != nil {
-					if propType := checker.Checker_getTypeOfPropertyOfType(ctx.TypeChecker, objectType, literal.String()); propType != nil {
+					if propType := ctx.Types.GetTypeOfPropertyOfType(objectType, literal.String()); propType != nil {
 						return propType
 					}
 				}
 			}
 
-			indexParts := utils.UnionTypeParts(indexType)
+			indexParts := ctx.Types.UnionTypeParts(indexType)
 			if len(indexParts) != 0 && slices.ContainsFunc(indexParts, func(part *checker.Type) bool {
 				return checker.Type_flags(part)&checker.TypeFlagsStringLike != 0
 			}) {
-				if stringIndexType := ctx.TypeChecker.GetStringIndexType(objectType); stringIndexType != nil {
+				if stringIndexType := ctx.Types.GetStringIndexType(objectType); stringIndexType != nil {
 					return stringIndexType
 				}
 			}
@@ -376,7 +376,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			if len(indexParts) != 0 && slices.ContainsFunc(indexParts, func(part *checker.Type) bool {
 				return checker.Type_flags(part)&checker.TypeFlagsNumberLike != 0
 			}) {
-				if numberIndexType := ctx.TypeChecker.GetNumberIndexType(objectType); numberIndexType != nil {
+				if numberIndexType := ctx.Types.GetNumberIndexType(objectType); numberIndexType != nil {
 					return numberIndexType
 				}
 			}
@@ -385,7 +385,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 		}
 
 		getResolvedType := func(node *ast.Node) *checker.Type {
-			nodeType := ctx.TypeChecker.GetTypeAtLocation(node)
+			nodeType := ctx.Types.GetTypeAtLocation(node)
 			if nodeType == nil {
 				return nil
 			}
@@ -406,8 +406,8 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				return false
 			}
 
-			for _, part := range utils.UnionTypeParts(nodeType) {
-				if checker.Checker_isArrayType(ctx.TypeChecker, part) {
+			for _, part := range ctx.Types.UnionTypeParts(nodeType) {
+				if ctx.Types.IsArrayType(part) {
 					return true
 				}
 			}
@@ -421,7 +421,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				return false
 			}
 
-			for _, part := range utils.UnionTypeParts(nodeType) {
+			for _, part := range ctx.Types.UnionTypeParts(nodeType) {
 				if checker.IsTupleType(part) {
 					return true
 				}
@@ -451,7 +451,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 		}
 
 		isConditionalAlwaysNecessary := func(t *checker.Type) bool {
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				flags := checker.Type_flags(part)
 				if flags&(checker.TypeFlagsAny|checker.TypeFlagsUnknown|checker.TypeFlagsTypeVariable) != 0 {
 					return true
@@ -575,14 +575,14 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			}
 
 			if propertyName, ok := getPropertyNameFromLiteralType(propertyType); ok {
-				propType := checker.Checker_getTypeOfPropertyOfType(ctx.TypeChecker, objType, propertyName)
+				propType := ctx.Types.GetTypeOfPropertyOfType(objType, propertyName)
 				if propType != nil {
 					return isNullishType(propType)
 				}
 			}
 
 			propertyTypeName := utils.GetTypeName(ctx.TypeChecker, propertyType)
-			for _, info := range checker.Checker_getIndexInfosOfType(ctx.TypeChecker, objType) {
+			for _, info := range ctx.Types.GetIndexInfosOfType(objType) {
 				if utils.GetTypeName(ctx.TypeChecker, info.KeyType()) == propertyTypeName {
 					return true
 				}
@@ -596,12 +596,12 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				return false
 			}
 
-			objectType := ctx.TypeChecker.GetTypeAtLocation(elemAccess.Expression)
+			objectType := ctx.Types.GetTypeAtLocation(elemAccess.Expression)
 			if objectType == nil {
 				return false
 			}
 
-			propertyType := ctx.TypeChecker.GetTypeAtLocation(elemAccess.ArgumentExpression)
+			propertyType := ctx.Types.GetTypeAtLocation(elemAccess.ArgumentExpression)
 			if propertyType == nil {
 				return false
 			}
@@ -627,8 +627,8 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				}
 			}
 
-			if resolvedSignature := checker.Checker_getResolvedSignature(ctx.TypeChecker, callExpr, nil, checker.CheckModeNormal); resolvedSignature != nil {
-				if returnType := ctx.TypeChecker.GetReturnTypeOfSignature(resolvedSignature); returnType != nil {
+			if resolvedSignature := ctx.Types.GetResolvedSignature(callExpr); resolvedSignature != nil {
+				if returnType := ctx.Types.GetReturnTypeOfSignature(resolvedSignature); returnType != nil {
 					return returnType
 				}
 			}
@@ -650,30 +650,30 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				// If so, the optional chaining result can be nullish
 				parts := nonNullishFunc.Types()
 				for _, part := range parts {
-					sigs := ctx.TypeChecker.GetCallSignatures(part)
+					sigs := ctx.Types.GetCallSignatures(part)
 					if len(sigs) > 0 {
-						retType := ctx.TypeChecker.GetReturnTypeOfSignature(sigs[0])
+						retType := ctx.Types.GetReturnTypeOfSignature(sigs[0])
 						if retType != nil && isNullishType(retType) {
 							// At least one function returns nullish, so use full expression type
 							// which includes all possible return types
-							return ctx.TypeChecker.GetTypeAtLocation(callExpr)
+							return ctx.Types.GetTypeAtLocation(callExpr)
 						}
 					}
 				}
 				// No function returns nullish, get first signature's return type
-				signatures := ctx.TypeChecker.GetCallSignatures(nonNullishFunc)
+				signatures := ctx.Types.GetCallSignatures(nonNullishFunc)
 				if len(signatures) > 0 {
-					return ctx.TypeChecker.GetReturnTypeOfSignature(signatures[0])
+					return ctx.Types.GetReturnTypeOfSignature(signatures[0])
 				}
 				return nil
 			}
 
-			signatures := ctx.TypeChecker.GetCallSignatures(nonNullishFunc)
+			signatures := ctx.Types.GetCallSignatures(nonNullishFunc)
 			if len(signatures) == 0 {
 				return nil
 			}
 
-			return ctx.TypeChecker.GetReturnTypeOfSignature(signatures[0])
+			return ctx.Types.GetReturnTypeOfSignature(signatures[0])
 		}
 
 		// Helper: Get property type from a base type given a property access expression
@@ -693,26 +693,26 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			pa := propAccess.AsPropertyAccessExpression()
 			nameNode := pa.Name()
 			if nameNode == nil {
-				return ctx.TypeChecker.GetTypeAtLocation(propAccess)
+				return ctx.Types.GetTypeAtLocation(propAccess)
 			}
 
 			propName := ast.GetTextOfPropertyName(nameNode)
 			if propName == "" {
-				return ctx.TypeChecker.GetTypeAtLocation(propAccess)
+				return ctx.Types.GetTypeAtLocation(propAccess)
 			}
 
 			// Try to get the property directly first
-			prop := checker.Checker_getPropertyOfType(ctx.TypeChecker, nonNullishBase, propName)
+			prop := ctx.Types.GetPropertyOfType(nonNullishBase, propName)
 			if prop != nil {
-				return ctx.TypeChecker.GetTypeOfSymbol(prop)
+				return ctx.Types.GetTypeOfSymbol(prop)
 			}
 
 			// For mapped types, try the apparent type which may have the property
-			apparentType := checker.Checker_getApparentType(ctx.TypeChecker, nonNullishBase)
+			apparentType := ctx.Types.GetApparentType(nonNullishBase)
 			if apparentType != nil && apparentType != nonNullishBase {
-				prop = checker.Checker_getPropertyOfType(ctx.TypeChecker, apparentType, propName)
+				prop = ctx.Types.GetPropertyOfType(apparentType, propName)
 				if prop != nil {
-					return ctx.TypeChecker.GetTypeOfSymbol(prop)
+					return ctx.Types.GetTypeOfSymbol(prop)
 				}
 			}
 
@@ -720,10 +720,10 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			// For index signatures and mapped types, behavior depends on noUncheckedIndexedAccess:
 			// - WITH noUncheckedIndexedAccess: index access returns T | undefined, be conservative
 			// - WITHOUT noUncheckedIndexedAccess: index access returns T, use the actual type
-			stringIndexType := ctx.TypeChecker.GetStringIndexType(nonNullishBase)
+			stringIndexType := ctx.Types.GetStringIndexType(nonNullishBase)
 			if stringIndexType == nil && apparentType != nil {
 				// Try the apparent type's index signature
-				stringIndexType = ctx.TypeChecker.GetStringIndexType(apparentType)
+				stringIndexType = ctx.Types.GetStringIndexType(apparentType)
 			}
 
 			// For mapped types with template literal keys (e.g., Lowercase<string>),
@@ -733,13 +733,13 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				objectFlags := checker.Type_objectFlags(nonNullishBase)
 				if objectFlags&checker.ObjectFlagsMapped != 0 {
 					// This is a mapped type - get all its properties
-					properties := checker.Checker_getPropertiesOfType(ctx.TypeChecker, nonNullishBase)
+					properties := ctx.Types.GetPropertiesOfType(nonNullishBase)
 					for _, p := range properties {
 						if p.Name == propName {
 							// Found the property - check if it's optional
 							if p.Flags&ast.SymbolFlagsOptional == 0 {
 								// Non-optional property - use its type
-								return ctx.TypeChecker.GetTypeOfSymbol(p)
+								return ctx.Types.GetTypeOfSymbol(p)
 							}
 							// Optional property - let caller handle it
 							return nil
@@ -764,7 +764,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			}
 
 			// For non-mapped types, fall back to GetTypeAtLocation
-			return ctx.TypeChecker.GetTypeAtLocation(propAccess)
+			return ctx.Types.GetTypeAtLocation(propAccess)
 		}
 
 		// Helper: Get type from property/element access on call expression result
@@ -773,7 +773,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			returnType := getCallReturnType(callExpr)
 			if returnType == nil {
 				// For union types, use GetTypeAtLocation
-				returnType = ctx.TypeChecker.GetTypeAtLocation(callExpr)
+				returnType = ctx.Types.GetTypeAtLocation(callExpr)
 				if returnType == nil {
 					return nil
 				}
@@ -789,7 +789,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 			}
 
 			// ElementAccessExpression
-			return ctx.TypeChecker.GetTypeAtLocation(accessExpr)
+			return ctx.Types.GetTypeAtLocation(accessExpr)
 		}
 
 		isCallExpressionNullableOriginFromCallee := func(callExpr *ast.CallExpression) bool {
@@ -804,9 +804,9 @@ var NoUnnecessaryConditionRule = rule.Rule{
 
 			isOwnNullable := false
 			for _, part := range prevType.Types() {
-				signatures := ctx.TypeChecker.GetCallSignatures(part)
+				signatures := ctx.Types.GetCallSignatures(part)
 				for _, sig := range signatures {
-					returnType := ctx.TypeChecker.GetReturnTypeOfSignature(sig)
+					returnType := ctx.Types.GetReturnTypeOfSignature(sig)
 					if returnType != nil && isNullishType(returnType) {
 						isOwnNullable = true
 						break
@@ -876,7 +876,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					continue
 				}
 
-				propType := checker.Checker_getTypeOfPropertyOfType(ctx.TypeChecker, part, propertyName)
+				propType := ctx.Types.GetTypeOfPropertyOfType(part, propertyName)
 				if propType != nil {
 					if isNullishType(propType) {
 						isOwnNullable = true
@@ -885,7 +885,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					continue
 				}
 
-				for _, info := range checker.Checker_getIndexInfosOfType(ctx.TypeChecker, part) {
+				for _, info := range ctx.Types.GetIndexInfosOfType(part) {
 					if utils.GetTypeName(ctx.TypeChecker, info.KeyType()) != "string" {
 						continue
 					}
@@ -1127,7 +1127,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					exprType = getPropertyTypeFromBase(nonNullishBase, expression)
 					// If nil (couldn't resolve property directly), use GetTypeAtLocation
 					if exprType == nil {
-						exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+						exprType = ctx.Types.GetTypeAtLocation(expression)
 						// For chained access with optional chains, GetTypeAtLocation includes
 						// short-circuit undefined. For non-optional mapped types, we should
 						// remove this undefined. Check if the base type is a mapped type
@@ -1152,7 +1152,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					argExpr := elemAccess.ArgumentExpression
 					if argExpr != nil {
 						// Get the type of the key
-						keyType := ctx.TypeChecker.GetTypeAtLocation(argExpr)
+						keyType := ctx.Types.GetTypeAtLocation(argExpr)
 						if keyType != nil {
 							// Check if the key is a string literal type or union of string literals
 							keyFlags := checker.Type_flags(keyType)
@@ -1194,13 +1194,13 @@ var NoUnnecessaryConditionRule = rule.Rule{
 								var representativeType *checker.Type
 								var propertyTypeNames []string
 								for _, key := range literalKeys {
-									prop := checker.Checker_getPropertyOfType(ctx.TypeChecker, nonNullishBase, key)
+									prop := ctx.Types.GetPropertyOfType(nonNullishBase, key)
 									if prop == nil {
 										// Property doesn't exist, might be index signature
 										allNonNullish = false
 										break
 									}
-									propType := ctx.TypeChecker.GetTypeOfSymbol(prop)
+									propType := ctx.Types.GetTypeOfSymbol(prop)
 									if propType == nil || isNullishType(propType) {
 										allNonNullish = false
 										break
@@ -1208,7 +1208,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 									if representativeType == nil {
 										representativeType = propType
 									}
-									propertyTypeName := ctx.TypeChecker.TypeToString(propType)
+									propertyTypeName := ctx.Types.TypeToString(propType)
 									if !slices.Contains(propertyTypeNames, propertyTypeName) {
 										propertyTypeNames = append(propertyTypeNames, propertyTypeName)
 									}
@@ -1221,17 +1221,17 @@ var NoUnnecessaryConditionRule = rule.Rule{
 									exprType = representativeType
 									effectiveTypeName = truncateTypeNameForDiagnostic(strings.Join(propertyTypeNames, " | "))
 								} else {
-									exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+									exprType = ctx.Types.GetTypeAtLocation(expression)
 								}
 							} else {
 								// Not a literal key, use default behavior
-								exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+								exprType = ctx.Types.GetTypeAtLocation(expression)
 							}
 						} else {
-							exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+							exprType = ctx.Types.GetTypeAtLocation(expression)
 						}
 					} else {
-						exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+						exprType = ctx.Types.GetTypeAtLocation(expression)
 					}
 				} else if isCallExpr(expression) {
 					// For call expressions in a chain, get the function's return type
@@ -1245,7 +1245,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					}
 				} else {
 					// For other expression types, use the full type
-					exprType = ctx.TypeChecker.GetTypeAtLocation(expression)
+					exprType = ctx.Types.GetTypeAtLocation(expression)
 				}
 			} else if isPropertyAccess(expression) || isElementAccess(expression) {
 				// Handle property/element access on call expression result
@@ -1309,7 +1309,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				if funcType != nil {
 					// Check the ORIGINAL type's parts, not after removeNullishFromType
 					// because removeNullishFromType only returns the first non-nullish part
-					parts := utils.UnionTypeParts(funcType)
+					parts := ctx.Types.UnionTypeParts(funcType)
 					for _, part := range parts {
 						// Skip nullish parts (null, undefined, void)
 						partFlags := checker.Type_flags(part)
@@ -1317,9 +1317,9 @@ var NoUnnecessaryConditionRule = rule.Rule{
 							continue
 						}
 						// Check if this function part returns nullish
-						sigs := ctx.TypeChecker.GetCallSignatures(part)
+						sigs := ctx.Types.GetCallSignatures(part)
 						if len(sigs) > 0 {
-							retType := ctx.TypeChecker.GetReturnTypeOfSignature(sigs[0])
+							retType := ctx.Types.GetReturnTypeOfSignature(sigs[0])
 							if retType != nil && isNullishType(retType) {
 								// At least one function returns nullish, allow the optional chain
 								return
@@ -1367,7 +1367,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					return false
 				}
 
-				baseType := ctx.TypeChecker.GetTypeAtLocation(propAccess.Expression)
+				baseType := ctx.Types.GetTypeAtLocation(propAccess.Expression)
 				if baseType == nil {
 					return false
 				}
@@ -1377,12 +1377,12 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					return false
 				}
 
-				propSymbol := ctx.TypeChecker.GetSymbolAtLocation(nameNode)
+				propSymbol := ctx.Types.GetSymbolAtLocation(nameNode)
 				if propSymbol == nil {
-					propSymbol = checker.Checker_getPropertyOfType(ctx.TypeChecker, baseType, propName)
+					propSymbol = ctx.Types.GetPropertyOfType(baseType, propName)
 				}
 				if propSymbol == nil {
-					for _, prop := range checker.Checker_getPropertiesOfType(ctx.TypeChecker, baseType) {
+					for _, prop := range ctx.Types.GetPropertiesOfType(baseType) {
 						if prop.Name == propName {
 							propSymbol = prop
 							break
@@ -1479,7 +1479,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				elemAccess := expression.AsElementAccessExpression()
 				baseType := getResolvedType(elemAccess.Expression)
 				if baseType != nil {
-					if stringIndexType := ctx.TypeChecker.GetStringIndexType(baseType); stringIndexType != nil {
+					if stringIndexType := ctx.Types.GetStringIndexType(baseType); stringIndexType != nil {
 						nodeType = stringIndexType
 					}
 				}
@@ -1733,12 +1733,12 @@ var NoUnnecessaryConditionRule = rule.Rule{
 				return
 			}
 
-			callSignature := checker.Checker_getResolvedSignature(ctx.TypeChecker, node, nil, checker.CheckModeNormal)
+			callSignature := ctx.Types.GetResolvedSignature(node)
 			if callSignature == nil {
 				return
 			}
 
-			typePredicate := ctx.TypeChecker.GetTypePredicateOfSignature(callSignature)
+			typePredicate := ctx.Types.GetTypePredicateOfSignature(callSignature)
 			if typePredicate == nil {
 				return
 			}
@@ -1757,7 +1757,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					checkNode(arg, false, nil)
 					return
 				}
-				if argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, arg); argType != nil && argType == predicateType {
+				if argType := ctx.Types.GetConstrainedTypeAtLocation(arg); argType != nil && argType == predicateType {
 					argRange := utils.TrimNodeTextRange(ctx.SourceFile, arg)
 					ctx.ReportDiagnostic(buildTypeGuardDiagnostic(
 						argRange,
@@ -1767,7 +1767,7 @@ var NoUnnecessaryConditionRule = rule.Rule{
 					))
 				}
 			case checker.TypePredicateKindIdentifier:
-				if argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, arg); argType != nil && argType == predicateType {
+				if argType := ctx.Types.GetConstrainedTypeAtLocation(arg); argType != nil && argType == predicateType {
 					argRange := utils.TrimNodeTextRange(ctx.SourceFile, arg)
 					ctx.ReportDiagnostic(buildTypeGuardDiagnostic(
 						argRange,
@@ -2104,12 +2104,12 @@ func normalizeAllowConstantLoopConditions(value any) string {
 // funcNode is the predicate argument, which need not be an inline function: a
 // reference to one (`[1, 2].filter(pred)`) is analyzed through its call signatures.
 func checkPredicateFunction(ctx rule.RuleContext, funcNode *ast.Node, checkTypeGuards bool) {
-	funcType := ctx.TypeChecker.GetTypeAtLocation(funcNode)
-	signatures := ctx.TypeChecker.GetCallSignatures(funcType)
+	funcType := ctx.Types.GetTypeAtLocation(funcNode)
+	signatures := ctx.Types.GetCallSignatures(funcType)
 
 	for _, signature := range signatures {
 		// Check if this is a type predicate (type guard)
-		typePredicate := ctx.TypeChecker.GetTypePredicateOfSignature(signature)
+		typePredicate := ctx.Types.GetTypePredicateOfSignature(signature)
 		if checkTypeGuards && typePredicate != nil {
 			// Check if the argument already satisfies the type predicate
 			params := checker.Signature_parameters(signature)
@@ -2120,7 +2120,7 @@ func checkPredicateFunction(ctx rule.RuleContext, funcNode *ast.Node, checkTypeG
 				if paramIndex >= 0 && paramIndex < len(params) {
 					param := params[paramIndex]
 					if param != nil {
-						paramType := ctx.TypeChecker.GetTypeOfSymbol(param)
+						paramType := ctx.Types.GetTypeOfSymbol(param)
 						predicateKind := checker.TypePredicate_kind(typePredicate)
 
 						if paramType != nil {
@@ -2132,7 +2132,7 @@ func checkPredicateFunction(ctx rule.RuleContext, funcNode *ast.Node, checkTypeG
 								if predicateType != nil {
 									// Check if paramType is assignable to predicateType
 									// If so, the type guard is unnecessary
-									if checker.Checker_isTypeAssignableTo(ctx.TypeChecker, paramType, predicateType) {
+									if ctx.Types.IsTypeAssignableTo(paramType, predicateType) {
 										primaryRange := utils.TrimNodeTextRange(ctx.SourceFile, funcNode)
 										typeRange := primaryRange
 										if declaration := param.ValueDeclaration; declaration != nil && ast.GetSourceFileOfNode(declaration) == ctx.SourceFile {
@@ -2155,12 +2155,12 @@ func checkPredicateFunction(ctx rule.RuleContext, funcNode *ast.Node, checkTypeG
 			}
 		}
 
-		returnType := ctx.TypeChecker.GetReturnTypeOfSignature(signature)
+		returnType := ctx.Types.GetReturnTypeOfSignature(signature)
 
 		// Handle type parameters
 		typeFlags := checker.Type_flags(returnType)
 		if typeFlags&checker.TypeFlagsTypeParameter != 0 {
-			constraint := ctx.TypeChecker.GetConstraintOfTypeParameter(returnType)
+			constraint := ctx.Types.GetConstraintOfTypeParameter(returnType)
 			if constraint != nil {
 				returnType = constraint
 			}
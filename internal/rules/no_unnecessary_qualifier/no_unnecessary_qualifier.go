@@ -47,14 +47,14 @@ var NoUnnecessaryQualifierRule = rule.Rule{
 			}
 
 			if utils.IsSymbolFlagSet(symbol, ast.SymbolFlagsAlias) {
-				return symbolIsNamespaceInScope(ctx.TypeChecker.GetAliasedSymbol(symbol))
+				return symbolIsNamespaceInScope(ctx.Types.GetAliasedSymbol(symbol))
 			}
 
 			return false
 		}
 
 		getSymbolInScope := func(node *ast.Node, flags ast.SymbolFlags, name string) *ast.Symbol {
-			scopeSymbols := ctx.TypeChecker.GetSymbolsInScope(node, flags)
+			scopeSymbols := ctx.Types.GetSymbolsInScope(node, flags)
 			for _, scopeSymbol := range scopeSymbols {
 				if scopeSymbol.Name == name {
 					return scopeSymbol
@@ -67,16 +67,16 @@ var NoUnnecessaryQualifierRule = rule.Rule{
 			if accessed == nil || inScope == nil {
 				return false
 			}
-			return accessed == ctx.TypeChecker.GetExportSymbolOfSymbol(inScope)
+			return accessed == ctx.Types.GetExportSymbolOfSymbol(inScope)
 		}
 
 		qualifierIsUnnecessary := func(qualifier *ast.Node, name *ast.Node) bool {
-			namespaceSymbol := ctx.TypeChecker.GetSymbolAtLocation(qualifier)
+			namespaceSymbol := ctx.Types.GetSymbolAtLocation(qualifier)
 			if namespaceSymbol == nil || !symbolIsNamespaceInScope(namespaceSymbol) {
 				return false
 			}
 
-			accessedSymbol := ctx.TypeChecker.GetSymbolAtLocation(name)
+			accessedSymbol := ctx.Types.GetSymbolAtLocation(name)
 			if accessedSymbol == nil {
 				return false
 			}
@@ -104,7 +104,7 @@ var NoUnnecessaryQualifierRule = rule.Rule{
 			ctx.ReportNodeWithFixes(qualifier, buildUnnecessaryQualifierMessage(name.Text()), func() []rule.RuleFix {
 				qualifierStart := utils.TrimNodeTextRange(ctx.SourceFile, qualifier).Pos()
 				nameStart := utils.TrimNodeTextRange(ctx.SourceFile, name).Pos()
-				return []rule.RuleFix{rule.RuleFixRemoveRange(core.NewTextRange(qualifierStart, nameStart))}
+				return []rule.RuleFix{rule.RuleFixRemoveRange(core.NewTextRange(qualifierStart, nameStart)).WithKind(rule.RuleFixKindSafe)}
 			})
 		}
 
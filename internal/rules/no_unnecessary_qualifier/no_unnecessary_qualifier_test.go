@@ -88,7 +88,7 @@ namespace A {
   const x: B = 3;
 }
       `},
-			Errors: []rule_tester.InvalidTestCaseError{{MessageId: `unnecessaryQualifier`}},
+			Errors: []rule_tester.InvalidTestCaseError{{MessageId: `unnecessaryQualifier`, FixKind: `safe`}},
 		},
 		{
 			Code: `
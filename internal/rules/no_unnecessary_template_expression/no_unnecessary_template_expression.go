@@ -18,8 +18,8 @@ func buildNoUnnecessaryTemplateExpressionMessage() rule.RuleMessage {
 	}
 }
 
-func isUnderlyingTypeString(t *checker.Type) bool {
-	return utils.Every(utils.UnionTypeParts(t), func(t *checker.Type) bool {
+func isUnderlyingTypeString(ctx rule.RuleContext, t *checker.Type) bool {
+	return utils.Every(ctx.Types.UnionTypeParts(t), func(t *checker.Type) bool {
 		return utils.Some(utils.IntersectionTypeParts(t), func(t *checker.Type) bool {
 			return utils.IsTypeFlagSet(t, checker.TypeFlagsStringLike)
 		})
@@ -127,7 +127,7 @@ var NoUnnecessaryTemplateExpressionRule = rule.Rule{
 				Range:   core.NewTextRange(interpolation.Pos()-2, spanLiteral.Pos()+1),
 				Message: buildNoUnnecessaryTemplateExpressionMessage(),
 			}, func() []rule.RuleFix {
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
@@ -307,7 +307,7 @@ var NoUnnecessaryTemplateExpressionRule = rule.Rule{
 					Range:   reportRange,
 					Message: buildNoUnnecessaryTemplateExpressionMessage(),
 				}, func() []rule.RuleFix {
-					return fixes
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 				})
 			}
 		}
@@ -322,9 +322,9 @@ var NoUnnecessaryTemplateExpressionRule = rule.Rule{
 				firstSpan := expr.TemplateSpans.Nodes[0].AsTemplateSpan()
 
 				if isTrivialInterpolation(expr.TemplateSpans, expr.Head, firstSpan.Literal) {
-					constraintType, _ := utils.GetConstraintInfo(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(firstSpan.Expression))
+					constraintType, _ := utils.GetConstraintInfo(ctx.TypeChecker, ctx.Types.GetTypeAtLocation(firstSpan.Expression))
 
-					if constraintType != nil && isUnderlyingTypeString(constraintType) {
+					if constraintType != nil && isUnderlyingTypeString(ctx, constraintType) {
 						reportSingleInterpolation(node, firstSpan.Expression, firstSpan.Literal)
 						return
 					}
@@ -337,9 +337,9 @@ var NoUnnecessaryTemplateExpressionRule = rule.Rule{
 				firstSpan := expr.TemplateSpans.Nodes[0].AsTemplateLiteralTypeSpan()
 
 				if isTrivialInterpolation(expr.TemplateSpans, expr.Head, firstSpan.Literal) {
-					constraintType, isTypeParameter := utils.GetConstraintInfo(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(firstSpan.Type))
+					constraintType, isTypeParameter := utils.GetConstraintInfo(ctx.TypeChecker, ctx.Types.GetTypeAtLocation(firstSpan.Type))
 
-					if constraintType != nil && !isTypeParameter && isUnderlyingTypeString(constraintType) && !isEnumMemberType(constraintType) {
+					if constraintType != nil && !isTypeParameter && isUnderlyingTypeString(ctx, constraintType) && !isEnumMemberType(constraintType) {
 						reportSingleInterpolation(node, firstSpan.Type, firstSpan.Literal)
 						return
 					}
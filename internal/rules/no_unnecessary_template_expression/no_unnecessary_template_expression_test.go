@@ -261,6 +261,7 @@ function foo<T extends string>() {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "noUnnecessaryTemplateExpression",
+					FixKind:   "safe",
 					Line:      1,
 					Column:    2,
 					EndColumn: 6,
@@ -8,7 +8,6 @@ import (
 	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
-	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
 
 func buildUnnecessaryTypeParameterMessage() rule.RuleMessage {
@@ -48,14 +47,14 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 	Name: "no-unnecessary-type-arguments",
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getTypeParametersFromType := func(node *ast.Node, nodeName *ast.Node) []*ast.Node {
-			symbol := ctx.TypeChecker.GetSymbolAtLocation(nodeName)
+			symbol := ctx.Types.GetSymbolAtLocation(nodeName)
 			if symbol == nil {
 				return nil
 			}
 
 			if symbol.Flags&ast.SymbolFlagsAlias != 0 {
 				var found bool
-				symbol, found = ctx.TypeChecker.ResolveAlias(symbol)
+				symbol, found = ctx.Types.ResolveAlias(symbol)
 				if !found {
 					return nil
 				}
@@ -90,8 +89,8 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				}
 
 				if ast.IsVariableDeclaration(decl) {
-					t := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, symbol)
-					signatures := utils.GetConstructSignatures(ctx.TypeChecker, t)
+					t := ctx.Types.GetTypeOfSymbol(symbol)
+					signatures := ctx.Types.GetConstructSignatures(t)
 					if len(signatures) == 0 {
 						continue
 					}
@@ -106,7 +105,7 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 		}
 
 		getTypeParametersFromCall := func(node *ast.Node) []*ast.Node {
-			signature := checker.Checker_getResolvedSignature(ctx.TypeChecker, node, nil, checker.CheckModeNormal)
+			signature := ctx.Types.GetResolvedSignature(node)
 			if signature != nil {
 				if declaration := checker.Signature_declaration(signature); declaration != nil {
 					if typeParameters := declaration.TypeParameters(); len(typeParameters) != 0 {
@@ -128,7 +127,7 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				}
 
 				if ast.IsIdentifier(node) {
-					if symbol := ctx.TypeChecker.GetSymbolAtLocation(node); symbol != nil {
+					if symbol := ctx.Types.GetSymbolAtLocation(node); symbol != nil {
 						if _, ok := typeParameterSymbols[symbol]; ok {
 							return true
 						}
@@ -154,7 +153,7 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				if name == nil {
 					continue
 				}
-				if symbol := ctx.TypeChecker.GetSymbolAtLocation(name); symbol != nil {
+				if symbol := ctx.Types.GetSymbolAtLocation(name); symbol != nil {
 					typeParameterSymbols[symbol] = struct{}{}
 				}
 			}
@@ -162,7 +161,7 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				return false
 			}
 
-			signature := checker.Checker_getResolvedSignature(ctx.TypeChecker, node, nil, checker.CheckModeNormal)
+			signature := ctx.Types.GetResolvedSignature(node)
 			if signature == nil {
 				return false
 			}
@@ -200,8 +199,8 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				return
 			}
 
-			defaultType := ctx.TypeChecker.GetTypeAtLocation(defaultTypeNode)
-			argType := ctx.TypeChecker.GetTypeAtLocation(typeArgument)
+			defaultType := ctx.Types.GetTypeAtLocation(defaultTypeNode)
+			argType := ctx.Types.GetTypeAtLocation(typeArgument)
 
 			if defaultType == nil || argType == nil {
 				return
@@ -244,7 +243,7 @@ var NoUnnecessaryTypeArgumentsRule = rule.Rule{
 				} else {
 					removeRange = typeArgument.Loc.WithPos(arguments.Nodes[lastParamIndex-1].End())
 				}
-				return []rule.RuleFix{rule.RuleFixRemoveRange(removeRange)}
+				return []rule.RuleFix{rule.RuleFixRemoveRange(removeRange).WithKind(rule.RuleFixKindSafe)}
 			})
 		}
 
@@ -490,6 +490,7 @@ f();
 				{
 					Column:    3,
 					MessageId: "unnecessaryTypeParameter",
+					FixKind:   "safe",
 				},
 			},
 		},
@@ -126,8 +126,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				decl.ExclamationToken == nil &&
 				decl.Type != nil {
 				// check if the defined variable type has changed since assignment
-				declarationType := checker.Checker_getTypeFromTypeNode(ctx.TypeChecker, declaration.Type())
-				t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node)
+				declarationType := ctx.Types.GetTypeFromTypeNode(declaration.Type())
+				t := ctx.Types.GetConstrainedTypeAtLocation(node)
 				if declarationType == t &&
 					// `declare`s are never narrowed, so never skip them
 					!(ast.IsVariableDeclarationList(declaration.Parent) &&
@@ -174,7 +174,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if checker.Type_objectFlags(t)&checker.ObjectFlagsReference == 0 {
 				return nil
 			}
-			return checker.Checker_getTypeArguments(ctx.TypeChecker, t)
+			return ctx.Types.GetTypeArguments(t)
 		}
 
 		var typeContains func(t *checker.Type, predicate func(*checker.Type) bool, seenTypes map[*checker.Type]struct{}, activeSignatures map[*checker.Signature]struct{}) bool
@@ -189,7 +189,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if predicate(t) {
 				return true
 			}
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				if part != t && typeContains(part, predicate, seenTypes, activeSignatures) {
 					return true
 				}
@@ -204,7 +204,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					return true
 				}
 			}
-			for _, sig := range utils.GetCallSignatures(ctx.TypeChecker, t) {
+			for _, sig := range ctx.Types.GetCallSignatures(t) {
 				// Generic signature instantiations can produce fresh recursive return
 				// types. If their shared original target is already active,
 				// conservatively assume the predicate could occur in the unseen cycle
@@ -219,11 +219,11 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				activeSignatures[signatureIdentity] = struct{}{}
 
 				for _, param := range checker.Signature_parameters(sig) {
-					if typeContains(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, param), predicate, seenTypes, activeSignatures) {
+					if typeContains(ctx.Types.GetTypeOfSymbol(param), predicate, seenTypes, activeSignatures) {
 						return true
 					}
 				}
-				if typeContains(checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, sig), predicate, seenTypes, activeSignatures) {
+				if typeContains(ctx.Types.GetReturnTypeOfSignature(sig), predicate, seenTypes, activeSignatures) {
 					return true
 				}
 				delete(activeSignatures, signatureIdentity)
@@ -244,14 +244,14 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 		}
 
 		hasIndexSignature := func(t *checker.Type) bool {
-			return slices.ContainsFunc(utils.UnionTypeParts(t), func(part *checker.Type) bool {
-				return len(checker.Checker_getIndexInfosOfType(ctx.TypeChecker, part)) > 0
+			return slices.ContainsFunc(ctx.Types.UnionTypeParts(t), func(part *checker.Type) bool {
+				return len(ctx.Types.GetIndexInfosOfType(part)) > 0
 			})
 		}
 
 		hasSameProperties := func(uncast, cast *checker.Type) bool {
-			uncastProps := checker.Checker_getPropertiesOfType(ctx.TypeChecker, uncast)
-			castProps := checker.Checker_getPropertiesOfType(ctx.TypeChecker, cast)
+			uncastProps := ctx.Types.GetPropertiesOfType(uncast)
+			castProps := ctx.Types.GetPropertiesOfType(cast)
 			if len(uncastProps) != len(castProps) {
 				return false
 			}
@@ -264,7 +264,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			for _, prop := range uncastProps {
 				castProp := castPropsByName[prop.Name]
 				if castProp == nil ||
-					checker.Checker_isReadonlySymbol(ctx.TypeChecker, prop) != checker.Checker_isReadonlySymbol(ctx.TypeChecker, castProp) {
+					ctx.Types.IsReadonlySymbol(prop) != ctx.Types.IsReadonlySymbol(castProp) {
 					return false
 				}
 			}
@@ -287,14 +287,14 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 		areUnionPartsEquivalentIgnoringUndefined := func(uncast, cast *checker.Type) bool {
 			uncastParts := utils.Set[*checker.Type]{}
-			for _, part := range utils.UnionTypeParts(uncast) {
+			for _, part := range ctx.Types.UnionTypeParts(uncast) {
 				if !utils.IsTypeFlagSet(part, checker.TypeFlagsUndefined) {
 					uncastParts.Add(part)
 				}
 			}
 
 			castPartsCount := 0
-			for _, part := range utils.UnionTypeParts(cast) {
+			for _, part := range ctx.Types.UnionTypeParts(cast) {
 				if utils.IsTypeFlagSet(part, checker.TypeFlagsUndefined) {
 					continue
 				}
@@ -308,10 +308,10 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 		isEmptyObjectType := func(t *checker.Type) bool {
 			return utils.IsTypeFlagSet(t, checker.TypeFlagsNonPrimitive) ||
-				(len(checker.Checker_getPropertiesOfType(ctx.TypeChecker, t)) == 0 &&
-					len(utils.GetCallSignatures(ctx.TypeChecker, t)) == 0 &&
-					len(utils.GetConstructSignatures(ctx.TypeChecker, t)) == 0 &&
-					len(checker.Checker_getIndexInfosOfType(ctx.TypeChecker, t)) == 0)
+				(len(ctx.Types.GetPropertiesOfType(t)) == 0 &&
+					len(ctx.Types.GetCallSignatures(t)) == 0 &&
+					len(ctx.Types.GetConstructSignatures(t)) == 0 &&
+					len(ctx.Types.GetIndexInfosOfType(t)) == 0)
 		}
 
 		hasPhantomTypeArguments := func(t *checker.Type) bool {
@@ -401,8 +401,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if isConceptuallyLiteral(expression) &&
 				(!ast.IsObjectLiteralExpression(expression) ||
 					len(expression.AsObjectLiteralExpression().Properties.Nodes) == 0 ||
-					slices.ContainsFunc(checker.Checker_getPropertiesOfType(ctx.TypeChecker, cast), func(prop *ast.Symbol) bool {
-						return isTypeLiteral(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, prop))
+					slices.ContainsFunc(ctx.Types.GetPropertiesOfType(cast), func(prop *ast.Symbol) bool {
+						return isTypeLiteral(ctx.Types.GetTypeOfSymbol(prop))
 					})) {
 				return false
 			}
@@ -422,7 +422,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					otherPart != nil &&
 					isEmptyObjectType(otherPart) &&
 					!containsTypeVariable(otherPart) {
-					constraint := checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, uncast)
+					constraint := ctx.Types.GetBaseConstraintOfType(uncast)
 					if constraint != nil && !utils.IsNullableType(ctx.TypeChecker, constraint) {
 						return true
 					}
@@ -434,8 +434,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				return false
 			}
 
-			return checker.Checker_isTypeAssignableTo(ctx.TypeChecker, uncast, cast) &&
-				checker.Checker_isTypeAssignableTo(ctx.TypeChecker, cast, uncast)
+			return ctx.Types.IsTypeAssignableTo(uncast, cast) &&
+				ctx.Types.IsTypeAssignableTo(cast, uncast)
 		}
 
 		isTypeAny := func(t *checker.Type) bool {
@@ -450,7 +450,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if utils.IsNullableType(ctx.TypeChecker, t) {
 				return true
 			}
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				if utils.IsTypeFlagSet(part, checker.TypeFlagsAny|checker.TypeFlagsUnknown|checker.TypeFlagsVoid) {
 					return true
 				}
@@ -486,10 +486,10 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 			if isIIFE(expression) {
 				callee := ast.SkipParentheses(expression.AsCallExpression().Expression)
-				functionType := ctx.TypeChecker.GetTypeAtLocation(callee)
-				signatures := ctx.TypeChecker.GetCallSignatures(functionType)
+				functionType := ctx.Types.GetTypeAtLocation(callee)
+				signatures := ctx.Types.GetCallSignatures(functionType)
 				if len(signatures) > 0 {
-					returnType := ctx.TypeChecker.GetReturnTypeOfSignature(signatures[0])
+					returnType := ctx.Types.GetReturnTypeOfSignature(signatures[0])
 					if callee.Type() == nil && utils.IsTypeFlagSet(returnType, checker.TypeFlagsUndefined) {
 						return ctx.TypeChecker.GetVoidType()
 					}
@@ -501,12 +501,12 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			// contextual typing from the assertion itself doesn't leak into generic
 			// inference for the original expression.
 			if isContextSensitiveCallLikeExpression(expression) {
-				if t := checker.Checker_getContextFreeTypeOfExpression(ctx.TypeChecker, expression); t != nil {
+				if t := ctx.Types.GetContextFreeTypeOfExpression(expression); t != nil {
 					return t
 				}
 			}
 
-			return ctx.TypeChecker.GetTypeAtLocation(expression)
+			return ctx.Types.GetTypeAtLocation(expression)
 		}
 
 		parentThroughParens := func(node *ast.Node) *ast.Node {
@@ -539,21 +539,21 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 				typeNodePos := utils.TrimNodeTextRange(ctx.SourceFile, typeNode).Pos()
 				if asKeywordRange.End() > typeNodePos {
-					return []rule.RuleFix{
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{
 						rule.RuleFixRemoveRange(core.NewTextRange(expression.End(), typeNode.Loc.End())),
-					}
+					})
 				}
 				betweenText := ctx.SourceFile.Text()[asKeywordRange.End():typeNodePos]
 				if !utils.IsStringWhiteSpace(betweenText) {
-					return []rule.RuleFix{
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{
 						rule.RuleFixRemoveRange(asKeywordRange),
 						rule.RuleFixRemove(ctx.SourceFile, typeNode),
-					}
+					})
 				}
 
-				return []rule.RuleFix{
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{
 					rule.RuleFixRemoveRange(core.NewTextRange(asKeywordRange.Pos(), typeNodeRange.End())),
-				}
+				})
 			}
 
 			s := scanner.GetScannerForSourceFile(ctx.SourceFile, node.Pos())
@@ -561,7 +561,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			s.ResetPos(typeNode.End())
 			s.Scan()
 			closingAngleBracket := s.TokenRange()
-			return []rule.RuleFix{rule.RuleFixRemoveRange(openingAngleBracket.WithEnd(closingAngleBracket.End()))}
+			return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{rule.RuleFixRemoveRange(openingAngleBracket.WithEnd(closingAngleBracket.End()))})
 		}
 
 		reportUnnecessaryTypeAssertion := func(node *ast.Node, uncastType, castType *checker.Type) {
@@ -591,11 +591,11 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 						buildUnnecessaryTypeAssertionDiagnostic(
 							assertionRange,
 							utils.TrimNodeTextRange(ctx.SourceFile, expressionForType),
-							ctx.TypeChecker.TypeToString(uncastType),
-							ctx.TypeChecker.TypeToString(castType),
+							ctx.Types.TypeToString(uncastType),
+							ctx.Types.TypeToString(castType),
 						),
 						func() []rule.RuleFix {
-							return []rule.RuleFix{rule.RuleFixRemoveRange(core.NewTextRange(commentStart, fixEnd))}
+							return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{rule.RuleFixRemoveRange(core.NewTextRange(commentStart, fixEnd))})
 						},
 					)
 					return
@@ -610,8 +610,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					buildUnnecessaryTypeAssertionDiagnostic(
 						assertionRange,
 						utils.TrimNodeTextRange(ctx.SourceFile, expressionForType),
-						ctx.TypeChecker.TypeToString(uncastType),
-						ctx.TypeChecker.TypeToString(castType),
+						ctx.Types.TypeToString(uncastType),
+						ctx.Types.TypeToString(castType),
 					), func() []rule.RuleFix {
 						return buildAssertionFixes(node)
 					})
@@ -629,11 +629,11 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					buildUnnecessaryTypeAssertionDiagnostic(
 						assertionRange,
 						utils.TrimNodeTextRange(ctx.SourceFile, expressionForType),
-						ctx.TypeChecker.TypeToString(uncastType),
-						ctx.TypeChecker.TypeToString(castType),
+						ctx.Types.TypeToString(uncastType),
+						ctx.Types.TypeToString(castType),
 					),
 					func() []rule.RuleFix {
-						return []rule.RuleFix{rule.RuleFixRemoveRange(assertionRange)}
+						return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{rule.RuleFixRemoveRange(assertionRange)})
 					})
 			}
 		}
@@ -660,13 +660,13 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 		}
 
 		hasGenericCallSignature := func(t *checker.Type) bool {
-			return slices.ContainsFunc(utils.GetCallSignatures(ctx.TypeChecker, t), func(sig *checker.Signature) bool {
+			return slices.ContainsFunc(ctx.Types.GetCallSignatures(t), func(sig *checker.Signature) bool {
 				return len(sig.TypeParameters()) > 0
 			})
 		}
 
 		hasGenericInferenceParameterAtArgument := func(callOrNew *ast.Node, argIndex int, elementPath []int) bool {
-			signature := checker.Checker_getResolvedSignature(ctx.TypeChecker, callOrNew, nil, checker.CheckModeNormal)
+			signature := ctx.Types.GetResolvedSignature(callOrNew)
 			if signature == nil {
 				return false
 			}
@@ -687,7 +687,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				paramIndex = len(params) - 1
 			}
 			param := params[paramIndex]
-			paramType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, param)
+			paramType := ctx.Types.GetTypeOfSymbol(param)
 			if valueDeclaration := param.ValueDeclaration; valueDeclaration != nil &&
 				valueDeclaration.Kind == ast.KindParameter &&
 				valueDeclaration.AsParameterDeclaration().DotDotDotToken != nil {
@@ -702,12 +702,12 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			for _, elementIndex := range elementPath {
 				var elementType *checker.Type
 				if checker.IsTupleType(paramType) {
-					typeArguments := checker.Checker_getTypeArguments(ctx.TypeChecker, paramType)
+					typeArguments := ctx.Types.GetTypeArguments(paramType)
 					if len(typeArguments) > 0 {
 						elementType = typeArguments[min(elementIndex, len(typeArguments)-1)]
 					}
 				} else {
-					elementType = utils.GetNumberIndexType(ctx.TypeChecker, paramType)
+					elementType = ctx.Types.GetNumberIndexType(paramType)
 				}
 				if elementType == nil {
 					break
@@ -719,10 +719,10 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 		}
 
 		genericsMismatch := func(uncast, contextual *checker.Type) bool {
-			return slices.ContainsFunc(checker.Checker_getPropertiesOfType(ctx.TypeChecker, contextual), func(prop *ast.Symbol) bool {
+			return slices.ContainsFunc(ctx.Types.GetPropertiesOfType(contextual), func(prop *ast.Symbol) bool {
 				contextualSigs := checker.Checker_getSignaturesOfType(
 					ctx.TypeChecker,
-					checker.Checker_getTypeOfSymbol(ctx.TypeChecker, prop),
+					ctx.Types.GetTypeOfSymbol(prop),
 					checker.SignatureKindCall,
 				)
 				if !slices.ContainsFunc(contextualSigs, func(sig *checker.Signature) bool {
@@ -731,14 +731,14 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					return false
 				}
 
-				uncastProp := checker.Checker_getPropertyOfType(ctx.TypeChecker, uncast, prop.Name)
+				uncastProp := ctx.Types.GetPropertyOfType(uncast, prop.Name)
 				if uncastProp == nil {
 					return true
 				}
 
 				uncastSigs := checker.Checker_getSignaturesOfType(
 					ctx.TypeChecker,
-					checker.Checker_getTypeOfSymbol(ctx.TypeChecker, uncastProp),
+					ctx.Types.GetTypeOfSymbol(uncastProp),
 					checker.SignatureKindCall,
 				)
 				return !slices.ContainsFunc(uncastSigs, func(sig *checker.Signature) bool {
@@ -754,8 +754,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			}
 
 			parent := parentThroughParens(node)
-			calleeType := ctx.TypeChecker.GetTypeAtLocation(parent.Expression())
-			signatures := ctx.TypeChecker.GetCallSignatures(calleeType)
+			calleeType := ctx.Types.GetTypeAtLocation(parent.Expression())
+			signatures := ctx.Types.GetCallSignatures(calleeType)
 			if len(signatures) <= 1 {
 				return false
 			}
@@ -766,7 +766,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				if argIndex >= len(params) {
 					return true
 				}
-				paramType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, params[argIndex])
+				paramType := ctx.Types.GetTypeOfSymbol(params[argIndex])
 				if valueDeclaration := params[argIndex].ValueDeclaration; valueDeclaration != nil &&
 					valueDeclaration.Kind == ast.KindParameter &&
 					valueDeclaration.AsParameterDeclaration().DotDotDotToken != nil {
@@ -782,9 +782,9 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 			firstParamType := paramTypes[0]
 			if slices.ContainsFunc(paramTypes, func(paramType *checker.Type) bool { return paramType != firstParamType }) {
-				uncastType := ctx.TypeChecker.GetTypeAtLocation(node.Expression())
+				uncastType := ctx.Types.GetTypeAtLocation(node.Expression())
 				return slices.ContainsFunc(paramTypes, func(paramType *checker.Type) bool {
-					return !checker.Checker_isTypeAssignableTo(ctx.TypeChecker, uncastType, paramType)
+					return !ctx.Types.IsTypeAssignableTo(uncastType, paramType)
 				})
 			}
 			return false
@@ -806,17 +806,17 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if objectExpr == nil || !ast.IsObjectLiteralExpression(objectExpr) {
 				return false
 			}
-			if objectContextualType := checker.Checker_getContextualType(ctx.TypeChecker, objectExpr, checker.ContextFlagsNone); objectContextualType != nil && utils.IsUnionType(objectContextualType) {
-				propContextualType := checker.Checker_getContextualType(ctx.TypeChecker, node, checker.ContextFlagsNone)
+			if objectContextualType := ctx.Types.GetContextualType(objectExpr, checker.ContextFlagsNone); objectContextualType != nil && utils.IsUnionType(objectContextualType) {
+				propContextualType := ctx.Types.GetContextualType(node, checker.ContextFlagsNone)
 				if propContextualType == nil {
 					return true
 				}
-				nonNullableContextualType := checker.Checker_GetNonNullableType(ctx.TypeChecker, propContextualType)
+				nonNullableContextualType := ctx.Types.GetNonNullableType(propContextualType)
 				if utils.IsUnionType(nonNullableContextualType) {
 					return true
 				}
-				uncastType := ctx.TypeChecker.GetTypeAtLocation(node.Expression())
-				return !checker.Checker_isTypeAssignableTo(ctx.TypeChecker, uncastType, nonNullableContextualType)
+				uncastType := ctx.Types.GetTypeAtLocation(node.Expression())
+				return !ctx.Types.IsTypeAssignableTo(uncastType, nonNullableContextualType)
 			}
 			objectParent := objectExpr.Parent
 			return objectParent != nil &&
@@ -914,7 +914,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 							continue
 						}
 					}
-					calleeType := ctx.TypeChecker.GetTypeAtLocation(current.Expression())
+					calleeType := ctx.Types.GetTypeAtLocation(current.Expression())
 					if hasGenericCallSignature(calleeType) {
 						return true
 					}
@@ -1000,8 +1000,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			}
 
 			originalExpr := getOriginalExpression(node)
-			originalType := ctx.TypeChecker.GetTypeAtLocation(originalExpr)
-			castType := ctx.TypeChecker.GetTypeAtLocation(node)
+			originalType := ctx.Types.GetTypeAtLocation(originalExpr)
+			castType := ctx.Types.GetTypeAtLocation(node)
 			var isConstrainedTo func(source, target *checker.Type, seen map[*checker.Type]struct{}) bool
 			isConstrainedTo = func(source, target *checker.Type, seen map[*checker.Type]struct{}) bool {
 				if source == target {
@@ -1016,7 +1016,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				seen[source] = struct{}{}
 
 				if utils.IsTypeParameter(source) {
-					return isConstrainedTo(ctx.TypeChecker.GetConstraintOfTypeParameter(source), target, seen)
+					return isConstrainedTo(ctx.Types.GetConstraintOfTypeParameter(source), target, seen)
 				}
 				if utils.IsIntersectionType(source) {
 					return slices.ContainsFunc(source.Types(), func(part *checker.Type) bool {
@@ -1034,9 +1034,9 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			if isTypeUnchanged(node, innerExpression, originalType, castType) && !isTypeAny(castType) {
 				messageId = "unnecessaryAssertion"
 			} else if contextualType != nil && !differentUnrelatedTypeParameters {
-				intermediateType := ctx.TypeChecker.GetTypeAtLocation(innerExpression)
+				intermediateType := ctx.Types.GetTypeAtLocation(innerExpression)
 				if (isTypeAny(intermediateType) || isTypeUnknown(intermediateType)) &&
-					checker.Checker_isTypeAssignableTo(ctx.TypeChecker, originalType, contextualType) {
+					ctx.Types.IsTypeAssignableTo(originalType, contextualType) {
 					messageId = "contextuallyUnnecessary"
 				}
 			}
@@ -1046,7 +1046,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 			description := buildContextuallyUnnecessaryMessage(node.Loc).Message.Description
 			if messageId == "unnecessaryAssertion" {
-				description = buildUnnecessaryAssertionDiagnostic(node.Loc, originalExpr.Loc, ctx.TypeChecker.TypeToString(originalType)).Message.Description
+				description = buildUnnecessaryAssertionDiagnostic(node.Loc, originalExpr.Loc, ctx.Types.TypeToString(originalType)).Message.Description
 			}
 
 			ctx.ReportDiagnosticWithFixes(rule.RuleDiagnostic{
@@ -1064,7 +1064,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					node.Parent.Body() == node {
 					text = "(" + text + ")"
 				}
-				return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, text)}
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, text)})
 			})
 			return true
 		}
@@ -1075,7 +1075,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				return
 			}
 
-			castType := ctx.TypeChecker.GetTypeAtLocation(node)
+			castType := ctx.Types.GetTypeAtLocation(node)
 			castTypeIsLiteral := isTypeLiteral(castType)
 			typeAnnotationIsConstAssertion := isConstAssertion(typeNode)
 
@@ -1088,8 +1088,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 
 			expressionForType := ast.SkipParentheses(expression)
 			if uncastType == castType && ast.IsIdentifier(expressionForType) {
-				if symbol := ctx.TypeChecker.GetSymbolAtLocation(expressionForType); symbol != nil {
-					symbolType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, symbol)
+				if symbol := ctx.Types.GetSymbolAtLocation(expressionForType); symbol != nil {
+					symbolType := ctx.Types.GetTypeOfSymbol(symbol)
 					if symbolType != nil && checker.Type_flags(symbolType)&checker.TypeFlagsConditional != 0 {
 						uncastType = symbolType
 					}
@@ -1113,7 +1113,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 			castIsAny := isTypeAny(castType) && !skipParentTypeForContextualAny(node)
 			var contextualType *checker.Type
 			if !shouldSkipContextualTypeFallback(node, castIsAny) {
-				contextualType = checker.Checker_getContextualType(ctx.TypeChecker, node, checker.ContextFlagsNone)
+				contextualType = ctx.Types.GetContextualType(node, checker.ContextFlagsNone)
 			}
 
 			if contextualType != nil {
@@ -1127,7 +1127,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					anyInvolvedInContextualCheck &&
 					!hasPhantomTypeArgumentMismatch(node, uncastType, contextualType) &&
 					(castIsAny || !genericsMismatch(uncastType, contextualType)) &&
-					(contextualTypeIsAny || checker.Checker_isTypeAssignableTo(ctx.TypeChecker, uncastType, contextualType)) &&
+					(contextualTypeIsAny || ctx.Types.IsTypeAssignableTo(uncastType, contextualType)) &&
 					!isNullishLiteralToUnion(node, castType)
 
 				if isContextuallyUnnecessary {
@@ -1154,7 +1154,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 				}
 
 				buildRemoveExclamationFix := func(exclamation core.TextRange) rule.RuleFix {
-					return rule.RuleFixRemoveRange(exclamation)
+					return rule.RuleFixRemoveRange(exclamation).WithKind(rule.RuleFixKindSafe)
 				}
 
 				if ast.IsAssignmentExpression(node.Parent, true) {
@@ -1169,8 +1169,8 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					return
 				}
 
-				constrainedType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expression)
-				actualType := ctx.TypeChecker.GetTypeAtLocation(expression)
+				constrainedType := ctx.Types.GetConstrainedTypeAtLocation(expression)
+				actualType := ctx.Types.GetTypeAtLocation(expression)
 
 				constrainedTypeIsNullable := isNullableForNonNullAssertion(constrainedType)
 				actualTypeIsNullable := isNullableForNonNullAssertion(actualType)
@@ -1184,7 +1184,7 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 						buildUnnecessaryAssertionDiagnostic(
 							exclamationRange,
 							expression.Loc,
-							ctx.TypeChecker.TypeToString(constrainedType),
+							ctx.Types.TypeToString(constrainedType),
 						),
 						func() []rule.RuleFix { return []rule.RuleFix{buildRemoveExclamationFix(exclamationRange)} },
 					)
@@ -1196,14 +1196,14 @@ var NoUnnecessaryTypeAssertionRule = rule.Rule{
 					}
 
 					var tFlags checker.TypeFlags
-					for _, part := range utils.UnionTypeParts(constrainedType) {
+					for _, part := range ctx.Types.UnionTypeParts(constrainedType) {
 						tFlags |= checker.Type_flags(part)
 					}
 
 					contextualType := utils.GetContextualType(ctx.TypeChecker, node)
 					if contextualType != nil {
 						var contextualFlags checker.TypeFlags
-						for _, part := range utils.UnionTypeParts(contextualType) {
+						for _, part := range ctx.Types.UnionTypeParts(contextualType) {
 							contextualFlags |= checker.Type_flags(part)
 						}
 
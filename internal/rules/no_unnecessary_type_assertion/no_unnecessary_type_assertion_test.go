@@ -1327,6 +1327,7 @@ export const item: { content: Guide | GlossaryItem } = {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "unnecessaryAssertion",
+					FixKind:   "safe",
 					Line:      1,
 					Column:    13,
 				},
@@ -1685,6 +1686,7 @@ nonNull(s);
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "contextuallyUnnecessary",
+					FixKind:   "safe",
 					Line:      4,
 				},
 			},
@@ -58,7 +58,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 		sourceText := ctx.SourceFile.Text()
 
 		doesUnderlyingTypeMatchFlag := func(t *checker.Type, typeFlag checker.TypeFlags) bool {
-			return utils.Every(utils.UnionTypeParts(t), func(part *checker.Type) bool {
+			return utils.Every(ctx.Types.UnionTypeParts(t), func(part *checker.Type) bool {
 				return utils.IsTypeFlagSet(part, typeFlag)
 			})
 		}
@@ -77,7 +77,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 		}
 
 		isAllNumberLiteralIntegers := func(t *checker.Type) bool {
-			parts := utils.UnionTypeParts(t)
+			parts := ctx.Types.UnionTypeParts(t)
 			if len(parts) == 0 {
 				return false
 			}
@@ -159,7 +159,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 			}
 
 			if wrap == nil {
-				return rule.RuleFixReplace(ctx.SourceFile, node, strings.Join(innerCodes, ""))
+				return rule.RuleFixReplace(ctx.SourceFile, node, strings.Join(innerCodes, "")).WithKind(rule.RuleFixKindSafe)
 			}
 
 			code := wrap(innerCodes...)
@@ -167,7 +167,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				code = "(" + code + ")"
 			}
 
-			return rule.RuleFixReplace(ctx.SourceFile, node, code)
+			return rule.RuleFixReplace(ctx.SourceFile, node, code).WithKind(rule.RuleFixKindSafe)
 		}
 
 		buildSuggestions := func(node *ast.Node, primitiveType string, innerNodes ...*ast.Node) []rule.RuleSuggestion {
@@ -193,13 +193,13 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 			expr := node.AsBinaryExpression()
 
 			if isEmptyStringLiteral(expr.Right) {
-				leftType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Left)
+				leftType := ctx.Types.GetConstrainedTypeAtLocation(expr.Left)
 				if doesUnderlyingTypeMatchFlag(leftType, checker.TypeFlagsStringLike) {
 					ctx.ReportDiagnosticWithSuggestions(
 						buildUnnecessaryTypeConversionDiagnostic(
 							core.NewTextRange(expr.Left.End(), node.End()),
 							utils.TrimNodeTextRange(ctx.SourceFile, expr.Left),
-							ctx.TypeChecker.TypeToString(leftType),
+							ctx.Types.TypeToString(leftType),
 						),
 						func() []rule.RuleSuggestion {
 							return buildSuggestions(node, "string", expr.Left)
@@ -209,14 +209,14 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 			}
 
 			if isEmptyStringLiteral(expr.Left) {
-				rightType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Right)
+				rightType := ctx.Types.GetConstrainedTypeAtLocation(expr.Right)
 				if doesUnderlyingTypeMatchFlag(rightType, checker.TypeFlagsStringLike) {
 					rightStart := utils.TrimNodeTextRange(ctx.SourceFile, expr.Right).Pos()
 					ctx.ReportDiagnosticWithSuggestions(
 						buildUnnecessaryTypeConversionDiagnostic(
 							core.NewTextRange(utils.TrimNodeTextRange(ctx.SourceFile, node).Pos(), rightStart),
 							utils.TrimNodeTextRange(ctx.SourceFile, expr.Right),
-							ctx.TypeChecker.TypeToString(rightType),
+							ctx.Types.TypeToString(rightType),
 						),
 						func() []rule.RuleSuggestion {
 							return buildSuggestions(node, "string", expr.Right)
@@ -235,7 +235,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				return
 			}
 
-			leftType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Left)
+			leftType := ctx.Types.GetConstrainedTypeAtLocation(expr.Left)
 			if !doesUnderlyingTypeMatchFlag(leftType, checker.TypeFlagsStringLike) {
 				return
 			}
@@ -244,12 +244,12 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				buildUnnecessaryTypeConversionDiagnostic(
 					utils.TrimNodeTextRange(ctx.SourceFile, node),
 					utils.TrimNodeTextRange(ctx.SourceFile, expr.Left),
-					ctx.TypeChecker.TypeToString(leftType),
+					ctx.Types.TypeToString(leftType),
 				),
 				func() []rule.RuleSuggestion {
 					removeFix := buildWrappingFix(node, []*ast.Node{expr.Left}, nil)
 					if ast.IsExpressionStatement(node.Parent) {
-						removeFix = rule.RuleFixRemove(ctx.SourceFile, node.Parent)
+						removeFix = rule.RuleFixRemove(ctx.SourceFile, node.Parent).WithKind(rule.RuleFixKindSafe)
 					}
 
 					return []rule.RuleSuggestion{
@@ -309,12 +309,12 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				return
 			}
 
-			calleeType := ctx.TypeChecker.GetTypeAtLocation(callee)
+			calleeType := ctx.Types.GetTypeAtLocation(callee)
 			if !utils.IsBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, calleeType, builtins...) {
 				return
 			}
 
-			argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, arg)
+			argType := ctx.Types.GetConstrainedTypeAtLocation(arg)
 			if !doesUnderlyingTypeMatchFlag(argType, typeFlag) {
 				return
 			}
@@ -324,7 +324,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				buildUnnecessaryTypeConversionDiagnostic(
 					utils.TrimNodeTextRange(ctx.SourceFile, callee),
 					utils.TrimNodeTextRange(ctx.SourceFile, arg),
-					ctx.TypeChecker.TypeToString(argType),
+					ctx.Types.TypeToString(argType),
 				),
 				func() []rule.RuleSuggestion {
 					return buildSuggestions(node.AsNode(), primitiveType, arg)
@@ -342,7 +342,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				return
 			}
 
-			objectType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, memberExpr.Expression)
+			objectType := ctx.Types.GetConstrainedTypeAtLocation(memberExpr.Expression)
 			if isEnumType(objectType) || isEnumMemberType(objectType) {
 				return
 			}
@@ -355,7 +355,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				buildUnnecessaryTypeConversionDiagnostic(
 					core.NewTextRange(memberExpr.Name().Pos(), node.AsNode().End()),
 					utils.TrimNodeTextRange(ctx.SourceFile, memberExpr.Expression),
-					ctx.TypeChecker.TypeToString(objectType),
+					ctx.Types.TypeToString(objectType),
 				),
 				func() []rule.RuleSuggestion {
 					return buildSuggestions(node.AsNode(), "string", memberExpr.Expression)
@@ -369,7 +369,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				return
 			}
 
-			argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Operand)
+			argType := ctx.Types.GetConstrainedTypeAtLocation(expr.Operand)
 			if !doesUnderlyingTypeMatchFlag(argType, checker.TypeFlagsNumberLike) {
 				return
 			}
@@ -381,7 +381,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 						utils.TrimNodeTextRange(ctx.SourceFile, expr.Operand).Pos(),
 					),
 					utils.TrimNodeTextRange(ctx.SourceFile, expr.Operand),
-					ctx.TypeChecker.TypeToString(argType),
+					ctx.Types.TypeToString(argType),
 				),
 				func() []rule.RuleSuggestion {
 					return buildSuggestions(node, "number", expr.Operand)
@@ -399,7 +399,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 			}
 
 			outerNode := node.Parent
-			argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Operand)
+			argType := ctx.Types.GetConstrainedTypeAtLocation(expr.Operand)
 			if !doesUnderlyingTypeMatchFlag(argType, checker.TypeFlagsBooleanLike) {
 				return
 			}
@@ -410,7 +410,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				buildUnnecessaryTypeConversionDiagnostic(
 					core.NewTextRange(outerStart, innerStart+1),
 					utils.TrimNodeTextRange(ctx.SourceFile, expr.Operand),
-					ctx.TypeChecker.TypeToString(argType),
+					ctx.Types.TypeToString(argType),
 				),
 				func() []rule.RuleSuggestion {
 					return buildSuggestions(outerNode, "boolean", expr.Operand)
@@ -427,7 +427,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				return
 			}
 
-			argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Operand)
+			argType := ctx.Types.GetConstrainedTypeAtLocation(expr.Operand)
 			if !isAllNumberLiteralIntegers(argType) {
 				return
 			}
@@ -439,7 +439,7 @@ var NoUnnecessaryTypeConversionRule = rule.Rule{
 				buildUnnecessaryTypeConversionDiagnostic(
 					core.NewTextRange(outerStart, innerStart+1),
 					utils.TrimNodeTextRange(ctx.SourceFile, expr.Operand),
-					ctx.TypeChecker.TypeToString(argType),
+					ctx.Types.TypeToString(argType),
 				),
 				func() []rule.RuleSuggestion {
 					return buildSuggestions(outerNode, "number", expr.Operand)
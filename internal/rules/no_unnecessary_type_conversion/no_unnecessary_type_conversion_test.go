@@ -65,10 +65,12 @@ func TestNoUnnecessaryTypeConversion(t *testing.T) {
 						{
 							MessageId: "suggestRemove",
 							Output:    "'asdf';",
+							Kind:      "safe",
 						},
 						{
 							MessageId: "suggestSatisfies",
 							Output:    "'asdf' satisfies string;",
+							Kind:      "safe",
 						},
 					},
 				},
@@ -82,7 +82,7 @@ func symbolFromTypeParameter(ctx rule.RuleContext, typeParameter *ast.Node) *ast
 		return nil
 	}
 
-	return ctx.TypeChecker.GetSymbolAtLocation(typeParameter.AsTypeParameterDeclaration().Name())
+	return ctx.Types.GetSymbolAtLocation(typeParameter.AsTypeParameterDeclaration().Name())
 }
 
 func collectTypeParameterReferenceNodes(ctx rule.RuleContext, node *ast.Node, symbol *ast.Symbol, declarationName *ast.Node) []*ast.Node {
@@ -105,7 +105,7 @@ func collectTypeParameterReferenceNodes(ctx rule.RuleContext, node *ast.Node, sy
 		if ast.IsIdentifier(current) {
 			if current != declarationName {
 				if current.Parent != nil && ast.IsTypeNode(current.Parent) {
-					if currentSymbol := ctx.TypeChecker.GetSymbolAtLocation(current); currentSymbol == symbol {
+					if currentSymbol := ctx.Types.GetSymbolAtLocation(current); currentSymbol == symbol {
 						references = append(references, current)
 					}
 				}
@@ -382,7 +382,7 @@ func collectTypeParameterUsageCounts(
 			if remainingTargets == 0 {
 				return
 			}
-			visitType(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, symbol), assumeMultipleUses, false)
+			visitType(ctx.Types.GetTypeOfSymbol(symbol), assumeMultipleUses, false)
 		}
 	}
 
@@ -392,14 +392,14 @@ func collectTypeParameterUsageCounts(
 		}
 
 		if thisParameter := signature.ThisParameter(); thisParameter != nil {
-			visitType(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, thisParameter), false, false)
+			visitType(ctx.Types.GetTypeOfSymbol(thisParameter), false, false)
 		}
 
 		for _, parameter := range signature.Parameters() {
 			if remainingTargets == 0 {
 				return
 			}
-			visitType(checker.Checker_getTypeOfSymbol(ctx.TypeChecker, parameter), false, false)
+			visitType(ctx.Types.GetTypeOfSymbol(parameter), false, false)
 		}
 
 		for _, typeParameter := range signature.TypeParameters() {
@@ -409,8 +409,8 @@ func collectTypeParameterUsageCounts(
 			visitType(typeParameter, false, false)
 		}
 
-		returnType := ctx.TypeChecker.GetReturnTypeOfSignature(signature)
-		if typePredicate := ctx.TypeChecker.GetTypePredicateOfSignature(signature); typePredicate != nil {
+		returnType := ctx.Types.GetReturnTypeOfSignature(signature)
+		if typePredicate := ctx.Types.GetTypePredicateOfSignature(signature); typePredicate != nil {
 			if predicateType := checker.TypePredicate_t(typePredicate); predicateType != nil {
 				returnType = predicateType
 			}
@@ -434,12 +434,12 @@ func collectTypeParameterUsageCounts(
 					typeParameterDeclaration := declaration.AsTypeParameterDeclaration()
 					if typeParameterDeclaration.Constraint != nil && !visitedConstraints[typeParameterDeclaration.Constraint] {
 						visitedConstraints[typeParameterDeclaration.Constraint] = true
-						visitType(ctx.TypeChecker.GetTypeAtLocation(typeParameterDeclaration.Constraint), false, false)
+						visitType(ctx.Types.GetTypeAtLocation(typeParameterDeclaration.Constraint), false, false)
 					}
 
 					if typeParameterDeclaration.DefaultType != nil && !visitedDefault {
 						visitedDefault = true
-						visitType(ctx.TypeChecker.GetTypeAtLocation(typeParameterDeclaration.DefaultType), false, false)
+						visitType(ctx.Types.GetTypeAtLocation(typeParameterDeclaration.DefaultType), false, false)
 					}
 				}
 			}
@@ -466,14 +466,14 @@ func collectTypeParameterUsageCounts(
 		}
 
 		if checker.Type_flags(typeNode)&checker.TypeFlagsObject != 0 && checker.Type_objectFlags(typeNode)&checker.ObjectFlagsReference != 0 {
-			typeArguments := checker.Checker_getTypeArguments(ctx.TypeChecker, typeNode)
+			typeArguments := ctx.Types.GetTypeArguments(typeNode)
 			if len(typeArguments) != 0 {
 				target := typeNode.Target()
 				for _, typeArgument := range typeArguments {
 					thisAssumeMultipleUses := fromClass || assumeMultipleUses
 					if checker.IsTupleType(target) {
 						thisAssumeMultipleUses = thisAssumeMultipleUses || (isReturnType && !checker.TupleType_readonly(target.AsTupleType()))
-					} else if checker.Checker_isArrayType(ctx.TypeChecker, target) {
+					} else if ctx.Types.IsArrayType(target) {
 						symbolName := ""
 						if typeSymbol := checker.Type_symbol(typeNode); typeSymbol != nil {
 							symbolName = typeSymbol.Name
@@ -501,7 +501,7 @@ func collectTypeParameterUsageCounts(
 		}
 
 		if utils.IsObjectType(typeNode) {
-			properties := checker.Checker_getPropertiesOfType(ctx.TypeChecker, typeNode)
+			properties := ctx.Types.GetPropertiesOfType(typeNode)
 			visitSymbolsList(properties, false)
 
 			if checker.Type_objectFlags(typeNode)&checker.ObjectFlagsMapped != 0 {
@@ -517,17 +517,17 @@ func collectTypeParameterUsageCounts(
 				}
 			}
 
-			visitType(ctx.TypeChecker.GetNumberIndexType(typeNode), true, false)
-			visitType(ctx.TypeChecker.GetStringIndexType(typeNode), true, false)
+			visitType(ctx.Types.GetNumberIndexType(typeNode), true, false)
+			visitType(ctx.Types.GetStringIndexType(typeNode), true, false)
 
-			for _, signature := range ctx.TypeChecker.GetCallSignatures(typeNode) {
+			for _, signature := range ctx.Types.GetCallSignatures(typeNode) {
 				if remainingTargets == 0 {
 					return
 				}
 				functionLikeType = true
 				visitSignature(signature)
 			}
-			for _, signature := range ctx.TypeChecker.GetConstructSignatures(typeNode) {
+			for _, signature := range ctx.Types.GetConstructSignatures(typeNode) {
 				if remainingTargets == 0 {
 					return
 				}
@@ -547,11 +547,11 @@ func collectTypeParameterUsageCounts(
 
 	if ast.IsCallSignatureDeclaration(node) || ast.IsConstructorDeclaration(node) {
 		functionLikeType = true
-		visitSignature(ctx.TypeChecker.GetSignatureFromDeclaration(node))
+		visitSignature(ctx.Types.GetSignatureFromDeclaration(node))
 	}
 
 	if !functionLikeType {
-		visitType(ctx.TypeChecker.GetTypeAtLocation(node), false, false)
+		visitType(ctx.Types.GetTypeAtLocation(node), false, false)
 	}
 
 	return remainingTargets
@@ -647,7 +647,7 @@ func checkNoUnnecessaryTypeParametersNode(ctx rule.RuleContext, node *ast.Node,
 
 				return []rule.RuleSuggestion{{
 					Message:  buildReplaceUsagesWithConstraintMessage(),
-					FixesArr: fixes,
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes),
 				}}
 			})
 	}
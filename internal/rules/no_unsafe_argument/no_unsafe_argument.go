@@ -200,11 +200,11 @@ var NoUnsafeArgumentRule = rule.Rule{
 				return "error typed"
 			}
 
-			return ctx.TypeChecker.TypeToString(t)
+			return ctx.Types.TypeToString(t)
 		}
 
 		describeTypeForSpread := func(t *checker.Type) string {
-			if checker.Checker_isArrayType(ctx.TypeChecker, t) && utils.IsIntrinsicErrorType(checker.Checker_getTypeArguments(ctx.TypeChecker, t)[0]) {
+			if ctx.Types.IsArrayType(t) && utils.IsIntrinsicErrorType(ctx.Types.GetTypeArguments(t)[0]) {
 				return "error"
 			}
 
@@ -216,7 +216,7 @@ var NoUnsafeArgumentRule = rule.Rule{
 				return "error typed"
 			}
 
-			return "of type " + ctx.TypeChecker.TypeToString(t)
+			return "of type " + ctx.Types.TypeToString(t)
 		}
 
 		checkUnsafeArguments := func(
@@ -231,7 +231,7 @@ var NoUnsafeArgumentRule = rule.Rule{
 			}
 
 			// ignore any-typed calls as these are caught by no-unsafe-call
-			if utils.IsTypeAnyType(ctx.TypeChecker.GetTypeAtLocation(callee)) {
+			if utils.IsTypeAnyType(ctx.Types.GetTypeAtLocation(callee)) {
 				return
 			}
 
@@ -249,7 +249,7 @@ var NoUnsafeArgumentRule = rule.Rule{
 				switch argument.Kind {
 				// spreads consume
 				case ast.KindSpreadElement:
-					spreadArgType := ctx.TypeChecker.GetTypeAtLocation(argument.Expression())
+					spreadArgType := ctx.Types.GetTypeAtLocation(argument.Expression())
 
 					if utils.IsTypeAnyType(spreadArgType) {
 						// foo(...any)
@@ -261,7 +261,7 @@ var NoUnsafeArgumentRule = rule.Rule{
 						ctx.ReportNode(argument, buildUnsafeArraySpreadMessage(describeTypeForSpread(spreadArgType)))
 					} else if checker.IsTupleType(spreadArgType) {
 						// foo(...[tuple1, tuple2])
-						spreadTypeArguments := checker.Checker_getTypeArguments(ctx.TypeChecker, spreadArgType)
+						spreadTypeArguments := ctx.Types.GetTypeArguments(spreadArgType)
 						for _, tupleType := range spreadTypeArguments {
 							parameterType := signature.getNextParameterType()
 							if parameterType == nil {
@@ -301,7 +301,7 @@ var NoUnsafeArgumentRule = rule.Rule{
 						continue
 					}
 
-					argumentType := ctx.TypeChecker.GetTypeAtLocation(argument)
+					argumentType := ctx.Types.GetTypeAtLocation(argument)
 					_, _, unsafe := utils.IsUnsafeAssignment(
 						argumentType,
 						parameterType,
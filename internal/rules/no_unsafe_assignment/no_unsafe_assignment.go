@@ -251,13 +251,13 @@ var NoUnsafeAssignmentRule = rule.Rule{
 			senderType *checker.Type,
 			senderNode *ast.Node,
 		) bool {
-			propertySymbols := checker.Checker_getPropertiesOfType(ctx.TypeChecker, senderType)
+			propertySymbols := ctx.Types.GetPropertiesOfType(senderType)
 			if propertySymbols == nil {
 				return false
 			}
 			properties := make(map[string]*checker.Type, len(propertySymbols))
 			for _, property := range propertySymbols {
-				properties[property.Name] = ctx.TypeChecker.GetTypeOfSymbolAtLocation(property, senderNode)
+				properties[property.Name] = ctx.Types.GetTypeOfSymbolAtLocation(property, senderNode)
 			}
 
 			checkObjectProperty := func(propertyKey *ast.Node, propertyValue *ast.Node) bool {
@@ -340,7 +340,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 				return false
 			}
 
-			senderType := ctx.TypeChecker.GetTypeAtLocation(senderNode)
+			senderType := ctx.Types.GetTypeAtLocation(senderNode)
 
 			return checkObjectDestructure(receiverNode, senderType, senderNode)
 		}
@@ -362,7 +362,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 				return true
 			}
 
-			tupleElements := checker.Checker_getTypeArguments(ctx.TypeChecker, senderType)
+			tupleElements := ctx.Types.GetTypeArguments(senderType)
 
 			checkArrayElement := func(receiverElement *ast.Node, receiverIndex int) bool {
 				if receiverElement == nil {
@@ -435,7 +435,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 				return false
 			}
 
-			senderType := ctx.TypeChecker.GetTypeAtLocation(senderNode)
+			senderType := ctx.Types.GetTypeAtLocation(senderNode)
 
 			return checkArrayDestructure(receiverNode, senderType, senderNode)
 		}
@@ -454,7 +454,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 				return false
 			}
 
-			senderType := ctx.TypeChecker.GetTypeAtLocation(senderNode)
+			senderType := ctx.Types.GetTypeAtLocation(senderNode)
 
 			getReceiverType := func() *checker.Type {
 				if compType == comparisonTypeContextual {
@@ -465,7 +465,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 						return receiverType
 					}
 				}
-				return ctx.TypeChecker.GetTypeAtLocation(receiverNode)
+				return ctx.Types.GetTypeAtLocation(receiverNode)
 			}
 
 			if utils.IsTypeAnyType(senderType) {
@@ -489,7 +489,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 					// `var foo = this`
 					thisExpression := utils.GetThisExpression(senderNode)
 					if thisExpression != nil {
-						thisType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, thisExpression)
+						thisType := ctx.Types.GetConstrainedTypeAtLocation(thisExpression)
 						if utils.IsTypeAnyType(thisType) {
 							diagnostic := buildThisAssignmentDiagnostic(
 								primaryRange,
@@ -694,7 +694,7 @@ var NoUnsafeAssignmentRule = rule.Rule{
 						continue
 					}
 
-					restType := ctx.TypeChecker.GetTypeAtLocation(node.Expression())
+					restType := ctx.Types.GetTypeAtLocation(node.Expression())
 					if utils.IsTypeAnyType(restType) || utils.IsTypeAnyArrayType(restType, ctx.TypeChecker) {
 						nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
 						ctx.ReportDiagnostic(buildArraySpreadDiagnostic(
@@ -50,14 +50,14 @@ var NoUnsafeCallRule = rule.Rule{
 			messageBuilder func(t string) rule.RuleMessage,
 			newCall bool,
 		) {
-			t := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node)
+			t := ctx.Types.GetConstrainedTypeAtLocation(node)
 
 			if utils.IsTypeAnyType(t) {
 				if !isNoImplicitThis {
 					// `this()` or `this.foo()` or `this.foo[bar]()`
 					thisExpression := utils.GetThisExpression(node)
 					if thisExpression != nil && utils.IsTypeAnyType(
-						utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, thisExpression),
+						ctx.Types.GetConstrainedTypeAtLocation(thisExpression),
 					) {
 						messageBuilder = buildUnsafeCallThisMessage
 					}
@@ -86,15 +86,15 @@ var NoUnsafeCallRule = rule.Rule{
 				// - they have at least one call signature
 				// - OR they have at least one construct signature.
 
-				constructSignatures := utils.GetConstructSignatures(ctx.TypeChecker, t)
+				constructSignatures := ctx.Types.GetConstructSignatures(t)
 				if len(constructSignatures) > 0 {
 					return
 				}
 
-				callSignatures := utils.GetCallSignatures(ctx.TypeChecker, t)
+				callSignatures := ctx.Types.GetCallSignatures(t)
 				if newCall {
 					if utils.Some(callSignatures, func(signature *checker.Signature) bool {
-						return !utils.IsIntrinsicVoidType(checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature))
+						return !utils.IsIntrinsicVoidType(ctx.Types.GetReturnTypeOfSignature(signature))
 					}) {
 						return
 					}
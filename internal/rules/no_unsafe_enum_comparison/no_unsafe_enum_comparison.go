@@ -66,8 +66,8 @@ func getEnumValueType(t *checker.Type) checker.TypeFlags {
 	return checker.TypeFlagsNone
 }
 
-func typeIsPrimitiveLike(t *checker.Type, flags checker.TypeFlags) bool {
-	return utils.Every(utils.UnionTypeParts(t), func(unionPart *checker.Type) bool {
+func typeIsPrimitiveLike(ctx rule.RuleContext, t *checker.Type, flags checker.TypeFlags) bool {
+	return utils.Every(ctx.Types.UnionTypeParts(t), func(unionPart *checker.Type) bool {
 		return utils.Some(utils.IntersectionTypeParts(unionPart), func(intersectionPart *checker.Type) bool {
 			return utils.IsTypeFlagSet(intersectionPart, flags)
 		})
@@ -77,9 +77,9 @@ func typeIsPrimitiveLike(t *checker.Type, flags checker.TypeFlags) bool {
 /**
  * @returns Whether the right type is an unsafe comparison against any left type.
  */
-func typeViolates(leftTypeParts []*checker.Type, rightType *checker.Type) bool {
-	rightNumberLike := typeIsPrimitiveLike(rightType, checker.TypeFlagsNumber|checker.TypeFlagsNumberLike)
-	rightStringLike := typeIsPrimitiveLike(rightType, checker.TypeFlagsString|checker.TypeFlagsStringLike)
+func typeViolates(ctx rule.RuleContext, leftTypeParts []*checker.Type, rightType *checker.Type) bool {
+	rightNumberLike := typeIsPrimitiveLike(ctx, rightType, checker.TypeFlagsNumber|checker.TypeFlagsNumberLike)
+	rightStringLike := typeIsPrimitiveLike(ctx, rightType, checker.TypeFlagsString|checker.TypeFlagsStringLike)
 	if !rightNumberLike && !rightStringLike {
 		return false
 	}
@@ -129,8 +129,8 @@ var NoUnsafeEnumComparisonRule = rule.Rule{
 			// declare const something: Fruit | Vegetable;
 			// something === Fruit.Apple;
 			// ```
-			leftTypeParts := utils.UnionTypeParts(leftType)
-			rightTypeParts := utils.UnionTypeParts(rightType)
+			leftTypeParts := ctx.Types.UnionTypeParts(leftType)
+			rightTypeParts := ctx.Types.UnionTypeParts(rightType)
 
 			// If a type exists in both sides, we consider this comparison safe:
 			//
@@ -144,9 +144,9 @@ var NoUnsafeEnumComparisonRule = rule.Rule{
 				}
 			}
 
-			l := typeViolates(leftTypeParts, rightType)
+			l := typeViolates(ctx, leftTypeParts, rightType)
 
-			return (l || typeViolates(rightTypeParts, leftType))
+			return (l || typeViolates(ctx, rightTypeParts, leftType))
 		}
 
 		return rule.RuleListeners{
@@ -157,8 +157,8 @@ var NoUnsafeEnumComparisonRule = rule.Rule{
 					return
 				}
 
-				leftType := ctx.TypeChecker.GetTypeAtLocation(expr.Left)
-				rightType := ctx.TypeChecker.GetTypeAtLocation(expr.Right)
+				leftType := ctx.Types.GetTypeAtLocation(expr.Left)
+				rightType := ctx.Types.GetTypeAtLocation(expr.Right)
 
 				if isMismatchedComparison(leftType, rightType) {
 					diagnostic := buildComparisonDiagnostic(
@@ -183,8 +183,8 @@ var NoUnsafeEnumComparisonRule = rule.Rule{
 			ast.KindCaseClause: func(node *ast.Node) {
 				switchExpression := node.Parent.Parent.Expression()
 				caseExpression := node.Expression()
-				leftType := ctx.TypeChecker.GetTypeAtLocation(switchExpression)
-				rightType := ctx.TypeChecker.GetTypeAtLocation(caseExpression)
+				leftType := ctx.Types.GetTypeAtLocation(switchExpression)
+				rightType := ctx.Types.GetTypeAtLocation(caseExpression)
 
 				if isMismatchedComparison(leftType, rightType) {
 					ctx.ReportDiagnostic(buildComparisonDiagnostic(
@@ -441,6 +441,7 @@ func TestNoUnsafeEnumComparisonRule(t *testing.T) {
         }
         Fruit.Apple === Fruit.Apple;
       `,
+							Kind: "safe",
 						},
 					},
 				},
@@ -117,7 +117,7 @@ func enumComparisonSuggestions(
 		if enumKey := getEnumKeyForLiteral(sourceFile, typeChecker, node, expr.Left, utils.GetEnumLiterals(leftType), rightValue); enumKey != "" {
 			return []rule.RuleSuggestion{{
 				Message:  buildReplaceValueWithEnumMessage(),
-				FixesArr: []rule.RuleFix{rule.RuleFixReplace(sourceFile, expr.Right, enumKey)},
+				FixesArr: []rule.RuleFix{rule.RuleFixReplace(sourceFile, expr.Right, enumKey).WithKind(rule.RuleFixKindSafe)},
 			}}
 		}
 	}
@@ -126,7 +126,7 @@ func enumComparisonSuggestions(
 		if enumKey := getEnumKeyForLiteral(sourceFile, typeChecker, node, expr.Right, utils.GetEnumLiterals(rightType), leftValue); enumKey != "" {
 			return []rule.RuleSuggestion{{
 				Message:  buildReplaceValueWithEnumMessage(),
-				FixesArr: []rule.RuleFix{rule.RuleFixReplace(sourceFile, expr.Left, enumKey)},
+				FixesArr: []rule.RuleFix{rule.RuleFixReplace(sourceFile, expr.Left, enumKey).WithKind(rule.RuleFixKindSafe)},
 			}}
 		}
 	}
@@ -109,7 +109,7 @@ var NoUnsafeMemberAccessRule = rule.Rule{
 				}
 			}
 
-			t := ctx.TypeChecker.GetTypeAtLocation(expression)
+			t := ctx.Types.GetTypeAtLocation(expression)
 			state := stateSafe
 			if utils.IsTypeAnyType(t) {
 				state = stateUnsafe
@@ -137,7 +137,7 @@ var NoUnsafeMemberAccessRule = rule.Rule{
 					thisExpression := utils.GetThisExpression(node)
 
 					if thisExpression != nil && utils.IsTypeAnyType(
-						utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, thisExpression)) {
+						ctx.Types.GetConstrainedTypeAtLocation(thisExpression)) {
 						ctx.ReportNode(property, buildUnsafeThisMemberExpressionMessage(propertyName))
 						return state
 					}
@@ -181,7 +181,7 @@ var NoUnsafeMemberAccessRule = rule.Rule{
 					return
 				}
 
-				t := ctx.TypeChecker.GetTypeAtLocation(arg)
+				t := ctx.Types.GetTypeAtLocation(arg)
 
 				if utils.IsTypeAnyType(t) {
 					loc := utils.TrimNodeTextRange(ctx.SourceFile, arg)
@@ -78,7 +78,7 @@ var NoUnsafeReturnRule = rule.Rule{
 			returnNode *ast.Node,
 			primaryRange core.TextRange,
 		) {
-			t := ctx.TypeChecker.GetTypeAtLocation(returnNode)
+			t := ctx.Types.GetTypeAtLocation(returnNode)
 
 			anyType := utils.DiscriminateAnyType(
 				t,
@@ -92,7 +92,7 @@ var NoUnsafeReturnRule = rule.Rule{
 			}
 
 			// function has an explicit return type, so ensure it's a safe return
-			returnNodeType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, returnNode)
+			returnNodeType := ctx.Types.GetConstrainedTypeAtLocation(returnNode)
 
 			// function expressions will not have their return type modified based on receiver typing
 			// so we have to use the contextual typing in these cases, i.e.
@@ -105,7 +105,7 @@ var NoUnsafeReturnRule = rule.Rule{
 				usesContextualType = functionType != nil
 			}
 			if functionType == nil {
-				functionType = ctx.TypeChecker.GetTypeAtLocation(functionNode)
+				functionType = ctx.Types.GetTypeAtLocation(functionNode)
 			}
 			callSignatures := utils.CollectAllCallSignatures(ctx.TypeChecker, functionType)
 			var expectedRange *core.TextRange
@@ -113,7 +113,7 @@ var NoUnsafeReturnRule = rule.Rule{
 			if returnTypeNode := functionNode.Type(); returnTypeNode != nil {
 				r := utils.TrimNodeTextRange(ctx.SourceFile, returnTypeNode)
 				expectedRange = &r
-				expectedType = renderReturnType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(returnTypeNode))
+				expectedType = renderReturnType(ctx.TypeChecker, ctx.Types.GetTypeAtLocation(returnTypeNode))
 			} else if usesContextualType {
 				for _, signature := range callSignatures {
 					declaration := checker.Signature_declaration(signature)
@@ -122,7 +122,7 @@ var NoUnsafeReturnRule = rule.Rule{
 					}
 					r := utils.TrimNodeTextRange(ctx.SourceFile, declaration.Type())
 					expectedRange = &r
-					expectedType = renderReturnType(ctx.TypeChecker, checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature))
+					expectedType = renderReturnType(ctx.TypeChecker, ctx.Types.GetReturnTypeOfSignature(signature))
 					break
 				}
 			}
@@ -140,7 +140,7 @@ var NoUnsafeReturnRule = rule.Rule{
 			// function return type, we shouldn't complain (it's intentional, even if unsafe)
 			if functionNode.Type() != nil {
 				for _, signature := range callSignatures {
-					signatureReturnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+					signatureReturnType := ctx.Types.GetReturnTypeOfSignature(signature)
 
 					if returnNodeType == signatureReturnType ||
 						utils.IsTypeFlagSet(
@@ -150,8 +150,8 @@ var NoUnsafeReturnRule = rule.Rule{
 						return
 					}
 					if ast.HasSyntacticModifier(functionNode, ast.ModifierFlagsAsync) {
-						awaitedSignatureReturnType := checker.Checker_getAwaitedType(ctx.TypeChecker, signatureReturnType)
-						awaitedReturnNodeType := checker.Checker_getAwaitedType(ctx.TypeChecker, returnNodeType)
+						awaitedSignatureReturnType := ctx.Types.GetAwaitedType(signatureReturnType)
+						awaitedReturnNodeType := ctx.Types.GetAwaitedType(returnNodeType)
 
 						if awaitedSignatureReturnType == awaitedReturnNodeType || (awaitedSignatureReturnType != nil && utils.IsTypeFlagSet(awaitedSignatureReturnType, checker.TypeFlagsAny|checker.TypeFlagsUnknown)) {
 							return
@@ -164,7 +164,7 @@ var NoUnsafeReturnRule = rule.Rule{
 				// Allow cases when the declared return type of the function is either unknown or unknown[]
 				// and the function is returning any or any[].
 				for _, signature := range callSignatures {
-					functionReturnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+					functionReturnType := ctx.Types.GetReturnTypeOfSignature(signature)
 					if anyType == utils.DiscriminatedAnyTypeAny && utils.IsTypeUnknownType(functionReturnType) {
 						return
 					}
@@ -172,7 +172,7 @@ var NoUnsafeReturnRule = rule.Rule{
 					if anyType == utils.DiscriminatedAnyTypeAnyArray && utils.IsTypeUnknownArrayType(functionReturnType, ctx.TypeChecker) {
 						return
 					}
-					awaitedType := checker.Checker_getAwaitedType(ctx.TypeChecker, functionReturnType)
+					awaitedType := ctx.Types.GetAwaitedType(functionReturnType)
 					if awaitedType != nil &&
 						anyType == utils.DiscriminatedAnyTypePromiseAny &&
 						utils.IsTypeUnknownType(awaitedType) {
@@ -198,7 +198,7 @@ var NoUnsafeReturnRule = rule.Rule{
 				if !isNoImplicitThis {
 					// `return this`
 					thisExpression := utils.GetThisExpression(returnNode)
-					if thisExpression != nil && utils.IsTypeAnyType(utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, thisExpression)) {
+					if thisExpression != nil && utils.IsTypeAnyType(ctx.Types.GetConstrainedTypeAtLocation(thisExpression)) {
 						report(buildUnsafeReturnThisMessage(typeString))
 						return
 					}
@@ -213,7 +213,7 @@ var NoUnsafeReturnRule = rule.Rule{
 			}
 
 			signature := callSignatures[0]
-			functionReturnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+			functionReturnType := ctx.Types.GetReturnTypeOfSignature(signature)
 
 			receiver, sender, unsafe := utils.IsUnsafeAssignment(
 				returnNodeType,
@@ -226,7 +226,7 @@ var NoUnsafeReturnRule = rule.Rule{
 				return
 			}
 
-			report(buildUnsafeReturnAssignmentMessage(ctx.TypeChecker.TypeToString(sender), ctx.TypeChecker.TypeToString(receiver)))
+			report(buildUnsafeReturnAssignmentMessage(ctx.Types.TypeToString(sender), ctx.Types.TypeToString(receiver)))
 		}
 
 		return rule.RuleListeners{
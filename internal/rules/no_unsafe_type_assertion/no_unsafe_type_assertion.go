@@ -106,8 +106,8 @@ var NoUnsafeTypeAssertionRule = rule.Rule{
 		checkExpression := func(node *ast.Node) {
 			expression := node.Expression()
 			typeAnnotation := node.Type()
-			expressionType := ctx.TypeChecker.GetTypeAtLocation(expression)
-			assertedType := ctx.TypeChecker.GetTypeAtLocation(typeAnnotation)
+			expressionType := ctx.Types.GetTypeAtLocation(expression)
+			assertedType := ctx.Types.GetTypeAtLocation(typeAnnotation)
 			report := func(message rule.RuleMessage) {
 				ctx.ReportDiagnostic(buildUnsafeTypeAssertionDiagnostic(
 					getAssertionRange(ctx.SourceFile, node, expression, typeAnnotation),
@@ -145,31 +145,31 @@ var NoUnsafeTypeAssertionRule = rule.Rule{
 			// won't fail on excess property check.
 			expressionWidenedType := expressionType
 			if isObjectLiteralType(expressionType) {
-				expressionWidenedType = checker.Checker_getWidenedType(ctx.TypeChecker, expressionType)
+				expressionWidenedType = ctx.Types.GetWidenedType(expressionType)
 			}
 
-			if checker.Checker_isTypeAssignableTo(ctx.TypeChecker, expressionWidenedType, assertedType) {
+			if ctx.Types.IsTypeAssignableTo(expressionWidenedType, assertedType) {
 				return
 			}
 
 			// Produce a more specific error message when targeting a type parameter
 			if utils.IsTypeParameter(assertedType) {
-				assertedTypeConstraint := checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, assertedType)
+				assertedTypeConstraint := ctx.Types.GetBaseConstraintOfType(assertedType)
 				if assertedTypeConstraint == nil {
 					// asserting to an unconstrained type parameter is unsafe
-					report(buildUnsafeToUnconstrainedTypeAssertionMessage(ctx.TypeChecker.TypeToString(assertedType)))
+					report(buildUnsafeToUnconstrainedTypeAssertionMessage(ctx.Types.TypeToString(assertedType)))
 					return
 				}
 
 				// special case message if the original type is assignable to the
 				// constraint of the target type parameter
-				if checker.Checker_isTypeAssignableTo(ctx.TypeChecker, expressionWidenedType, assertedTypeConstraint) {
-					report(buildUnsafeTypeAssertionAssignableToConstraintMessage(ctx.TypeChecker.TypeToString(assertedType)))
+				if ctx.Types.IsTypeAssignableTo(expressionWidenedType, assertedTypeConstraint) {
+					report(buildUnsafeTypeAssertionAssignableToConstraintMessage(ctx.Types.TypeToString(assertedType)))
 					return
 				}
 			}
 
-			report(buildUnsafeTypeAssertionMessage(ctx.TypeChecker.TypeToString(assertedType)))
+			report(buildUnsafeTypeAssertionMessage(ctx.Types.TypeToString(assertedType)))
 		}
 
 		return rule.RuleListeners{
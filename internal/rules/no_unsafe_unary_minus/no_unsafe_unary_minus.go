@@ -27,11 +27,11 @@ var NoUnsafeUnaryMinusRule = rule.Rule{
 					return
 				}
 
-				argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, expr.Operand)
+				argType := ctx.Types.GetConstrainedTypeAtLocation(expr.Operand)
 
-				for _, t := range utils.UnionTypeParts(argType) {
+				for _, t := range ctx.Types.UnionTypeParts(argType) {
 					if !utils.IsTypeFlagSet(t, checker.TypeFlagsAny|checker.TypeFlagsNever|checker.TypeFlagsBigIntLike|checker.TypeFlagsNumberLike) {
-						ctx.ReportNode(node, buildUnaryMinusMessage(ctx.TypeChecker.TypeToString(t)))
+						ctx.ReportNode(node, buildUnaryMinusMessage(ctx.Types.TypeToString(t)))
 						break
 					}
 				}
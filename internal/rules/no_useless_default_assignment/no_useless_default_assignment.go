@@ -59,14 +59,14 @@ func buildUselessUndefinedMessage(pluralAssignmentType string) rule.RuleMessage
 	}
 }
 
-func canBeUndefined(t *checker.Type) bool {
+func canBeUndefined(ctx rule.RuleContext, t *checker.Type) bool {
 	if t == nil {
 		return false
 	}
 	if utils.IsTypeAnyType(t) || utils.IsTypeUnknownType(t) {
 		return true
 	}
-	return slices.ContainsFunc(utils.UnionTypeParts(t), utils.IsTypeUndefinedType)
+	return slices.ContainsFunc(ctx.Types.UnionTypeParts(t), utils.IsTypeUndefinedType)
 }
 
 func getPropertyName(node *ast.Node) (string, bool) {
@@ -244,7 +244,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					return nil
 				}
 
-				symbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, sourceType, propertyName)
+				symbol := ctx.Types.GetPropertyOfType(sourceType, propertyName)
 				if symbol == nil {
 					return nil
 				}
@@ -259,7 +259,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					}
 				}
 
-				return ctx.TypeChecker.GetTypeOfSymbolAtLocation(symbol, bindingElement)
+				return ctx.Types.GetTypeOfSymbolAtLocation(symbol, bindingElement)
 			}
 
 			if ast.IsArrayBindingPattern(parentPattern) {
@@ -284,7 +284,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 
 			parent := pattern.Parent
 			if ast.IsVariableDeclaration(parent) && parent.Initializer() != nil {
-				return ctx.TypeChecker.GetTypeAtLocation(parent.Initializer())
+				return ctx.Types.GetTypeAtLocation(parent.Initializer())
 			}
 
 			if ast.IsParameterDeclaration(parent) {
@@ -293,7 +293,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					return nil
 				}
 
-				signature := ctx.TypeChecker.GetSignatureFromDeclaration(functionNode)
+				signature := ctx.Types.GetSignatureFromDeclaration(functionNode)
 				if signature == nil {
 					return nil
 				}
@@ -315,7 +315,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					return nil
 				}
 
-				return checker.Checker_getTypeOfSymbol(ctx.TypeChecker, parameters[paramIndex])
+				return ctx.Types.GetTypeOfSymbol(parameters[paramIndex])
 			}
 
 			if ast.IsBindingElement(parent) {
@@ -350,7 +350,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 			message := buildUselessDefaultAssignmentMessage(assignmentType)
 			typeText := ""
 			if valueType != nil && isSimpleTypeForMessage(valueType) {
-				typeText = ctx.TypeChecker.TypeToString(valueType)
+				typeText = ctx.Types.TypeToString(valueType)
 				message = buildUselessDefaultAssignmentWithTypeMessage(assignmentType, typeText)
 			}
 
@@ -378,9 +378,10 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 				Message:       message,
 				LabeledRanges: labeledRanges,
 			}, func() []rule.RuleSuggestion {
+				// The default is still used if the value is nullish at runtime
 				return []rule.RuleSuggestion{{
 					Message:  buildRemoveDefaultAssignmentSuggestionMessage(),
-					FixesArr: fixes,
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, fixes),
 				}}
 			})
 		}
@@ -391,7 +392,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 				return
 			}
 			ctx.ReportNodeWithFixes(initializer, buildUselessUndefinedMessage(getPluralAssignmentType(assignmentType)), func() []rule.RuleFix {
-				return []rule.RuleFix{buildRemoveDefaultFix(node)}
+				return []rule.RuleFix{buildRemoveDefaultFix(node).WithKind(rule.RuleFixKindSafe)}
 			})
 		}
 
@@ -412,7 +413,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					}
 				}
 
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
@@ -427,12 +428,12 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 				return
 			}
 
-			contextualType := checker.Checker_getContextualType(ctx.TypeChecker, parent, checker.ContextFlagsNone)
+			contextualType := ctx.Types.GetContextualType(parent, checker.ContextFlagsNone)
 			if contextualType == nil {
 				return
 			}
 
-			signatures := utils.GetCallSignatures(ctx.TypeChecker, contextualType)
+			signatures := ctx.Types.GetCallSignatures(contextualType)
 			if len(signatures) == 0 || checker.Signature_declaration(signatures[0]) == parent {
 				return
 			}
@@ -452,8 +453,8 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 			}
 
 			if !utils.IsSymbolFlagSet(paramSymbol, ast.SymbolFlagsOptional) {
-				paramType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, paramSymbol)
-				if !utils.IsTypeParameter(paramType) && !canBeUndefined(paramType) {
+				paramType := ctx.Types.GetTypeOfSymbol(paramSymbol)
+				if !utils.IsTypeParameter(paramType) && !canBeUndefined(ctx, paramType) {
 					reportUselessDefaultAssignment(node, "parameter", paramType)
 				}
 			}
@@ -469,7 +470,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 			if utils.IsUndefinedIdentifier(initializer) {
 				if ast.IsParameterDeclaration(node) {
 					parameter := node.AsParameterDeclaration()
-					if parameter.Type != nil && canBeUndefined(checker.Checker_getTypeFromTypeNode(ctx.TypeChecker, parameter.Type)) {
+					if parameter.Type != nil && canBeUndefined(ctx, ctx.Types.GetTypeFromTypeNode(parameter.Type)) {
 						reportPreferOptionalSyntax(node)
 						return
 					}
@@ -493,7 +494,7 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 			parent := node.Parent
 			if ast.IsObjectBindingPattern(parent) {
 				propertyType := getTypeOfBindingElement(node)
-				if propertyType != nil && !canBeUndefined(propertyType) {
+				if propertyType != nil && !canBeUndefined(ctx, propertyType) {
 					reportUselessDefaultAssignment(node, "property", propertyType)
 				}
 				return
@@ -505,13 +506,13 @@ var NoUselessDefaultAssignmentRule = rule.Rule{
 					return
 				}
 
-				tupleArgs := checker.Checker_getTypeArguments(ctx.TypeChecker, sourceType)
+				tupleArgs := ctx.Types.GetTypeArguments(sourceType)
 				elementIndex := findNodeIndex(parent.AsBindingPattern().Elements.Nodes, node)
 				if elementIndex < 0 || elementIndex >= len(tupleArgs) {
 					return
 				}
 
-				if !canBeUndefined(tupleArgs[elementIndex]) {
+				if !canBeUndefined(ctx, tupleArgs[elementIndex]) {
 					reportUselessDefaultAssignment(node, "property", tupleArgs[elementIndex])
 				}
 			}
@@ -211,6 +211,7 @@ func TestNoUselessDefaultAssignmentRule(t *testing.T) {
 						{
 							MessageId: "removeDefaultAssignment",
 							Output:    "\n        function Bar({ foo }: { foo: string }) {\n          return foo;\n        }\n      ",
+							Kind:      "unsafe",
 						},
 					},
 				},
@@ -360,6 +361,7 @@ func TestNoUselessDefaultAssignmentRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "uselessUndefined",
+					FixKind:   "safe",
 					Line:      2,
 					Column:    26,
 					EndColumn: 35,
@@ -416,6 +418,7 @@ func TestNoUselessDefaultAssignmentRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferOptionalSyntax",
+					FixKind:   "safe",
 					Line:      2,
 					Column:    66,
 					EndColumn: 75,
@@ -19,21 +19,21 @@ var NonNullableTypeAssertionStyleRule = rule.Rule{
 	Name: "non-nullable-type-assertion-style",
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		getTypesIfNotLoose := func(node *ast.Node) []*checker.Type {
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
+			t := ctx.Types.GetTypeAtLocation(node)
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsAny|checker.TypeFlagsUnknown) {
 				return nil
 			}
-			return utils.UnionTypeParts(t)
+			return ctx.Types.UnionTypeParts(t)
 		}
 
 		couldBeNullable := func(t *checker.Type) bool {
 			if utils.IsTypeParameter(t) {
-				t = checker.Checker_getBaseConstraintOfType(ctx.TypeChecker, t)
+				t = ctx.Types.GetBaseConstraintOfType(t)
 				if t == nil {
 					return true
 				}
 			}
-			for _, p := range utils.UnionTypeParts(t) {
+			for _, p := range ctx.Types.UnionTypeParts(t) {
 				if utils.IsTypeFlagSet(p, checker.TypeFlagsNullable) {
 					return true
 				}
@@ -99,7 +99,7 @@ var NonNullableTypeAssertionStyleRule = rule.Rule{
 			ctx.ReportNodeWithSuggestions(node, buildPreferNonNullAssertionMessage(), func() []rule.RuleSuggestion {
 				return []rule.RuleSuggestion{{
 					Message:  buildPreferNonNullAssertionMessage(),
-					FixesArr: fixes,
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes),
 				}}
 			})
 		}
@@ -75,6 +75,7 @@ const bar = maybe as string;
 declare const maybe: string | undefined;
 const bar = maybe!;
       `,
+							Kind: "safe",
 						},
 					},
 				},
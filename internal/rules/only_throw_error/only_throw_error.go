@@ -108,8 +108,8 @@ func isRethrownError(ctx rule.RuleContext, node *ast.Node) bool {
 
 	// Verify that the object is actually a thenable (Promise)
 	objectNode := propAccess.Expression
-	objectType := ctx.TypeChecker.GetTypeAtLocation(objectNode)
-	if !utils.IsThenableType(ctx.TypeChecker, objectNode, objectType) {
+	objectType := ctx.Types.GetTypeAtLocation(objectNode)
+	if !ctx.Types.IsThenableType(objectNode, objectType) {
 		return false
 	}
 
@@ -124,7 +124,7 @@ var OnlyThrowErrorRule = rule.Rule{
 		return rule.RuleListeners{
 			ast.KindThrowStatement: func(node *ast.Node) {
 				expr := node.Expression()
-				t := ctx.TypeChecker.GetTypeAtLocation(expr)
+				t := ctx.Types.GetTypeAtLocation(expr)
 
 				if utils.TypeMatchesSomeSpecifier(t, opts.Allow, ctx.Program) {
 					return
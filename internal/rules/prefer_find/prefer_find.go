@@ -265,7 +265,7 @@ var PreferFindRule = rule.Rule{
 					return staticValue{kind: staticValueNumber, numberValue: math.Inf(1)}, true
 				}
 
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+				symbol := ctx.Types.GetSymbolAtLocation(node)
 				if symbol == nil {
 					return staticValue{}, false
 				}
@@ -361,14 +361,14 @@ var PreferFindRule = rule.Rule{
 		isArrayish := func(t *checker.Type) bool {
 			isAtLeastOneArrayishComponent := false
 
-			for _, unionPart := range utils.UnionTypeParts(t) {
+			for _, unionPart := range ctx.Types.UnionTypeParts(t) {
 				if utils.IsTypeNullType(unionPart) || utils.IsTypeUndefinedType(unionPart) {
 					continue
 				}
 
 				isArrayOrIntersectionThereof := true
 				for _, intersectionPart := range utils.IntersectionTypeParts(unionPart) {
-					if !checker.Checker_isArrayType(ctx.TypeChecker, intersectionPart) && !checker.IsTupleType(intersectionPart) {
+					if !ctx.Types.IsArrayType(intersectionPart) && !checker.IsTupleType(intersectionPart) {
 						isArrayOrIntersectionThereof = false
 						break
 					}
@@ -426,7 +426,7 @@ var PreferFindRule = rule.Rule{
 					return nil
 				}
 
-				filteredObjectType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, callee.Expression())
+				filteredObjectType := ctx.Types.GetConstrainedTypeAtLocation(callee.Expression())
 				if !isArrayish(filteredObjectType) {
 					return nil
 				}
@@ -565,7 +565,7 @@ var PreferFindRule = rule.Rule{
 
 				return []rule.RuleSuggestion{{
 					Message:  buildPreferFindSuggestionMessage(),
-					FixesArr: fixes,
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, fixes),
 				}}
 			})
 		}
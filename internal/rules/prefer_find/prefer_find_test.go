@@ -32,7 +32,7 @@ func TestPreferFindRule(t *testing.T) {
 	}, []rule_tester.InvalidTestCase{
 		{
 			Code:   "\ndeclare const arr: string[];\narr.filter(item => item === 'aha')[0];\n      ",
-			Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferFind", Line: 3, Suggestions: []rule_tester.InvalidTestCaseSuggestion{{MessageId: "preferFindSuggestion", Output: "\ndeclare const arr: string[];\narr.find(item => item === 'aha');\n      "}}}},
+			Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferFind", Line: 3, Suggestions: []rule_tester.InvalidTestCaseSuggestion{{MessageId: "preferFindSuggestion", Output: "\ndeclare const arr: string[];\narr.find(item => item === 'aha');\n      ", Kind: "unsafe"}}}},
 		},
 		{
 			Code:   "\ndeclare const arr: Array<string>;\nconst zero = 0;\narr.filter(item => item === 'aha')[zero];\n      ",
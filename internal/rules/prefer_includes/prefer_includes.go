@@ -147,7 +147,7 @@ var PreferIncludesRule = rule.Rule{
 				return ""
 			}
 
-			symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+			symbol := ctx.Types.GetSymbolAtLocation(node)
 			if symbol == nil || symbol.ValueDeclaration == nil {
 				return ""
 			}
@@ -241,13 +241,13 @@ var PreferIncludesRule = rule.Rule{
 				}
 
 				// Get the type at this location
-				t := ctx.TypeChecker.GetTypeAtLocation(typeDecl)
+				t := ctx.Types.GetTypeAtLocation(typeDecl)
 				if t == nil {
 					return false
 				}
 
 				// Check if this type has an includes method
-				includesSymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, t, "includes")
+				includesSymbol := ctx.Types.GetPropertyOfType(t, "includes")
 				if includesSymbol == nil || includesSymbol.Declarations == nil {
 					return false
 				}
@@ -355,12 +355,12 @@ var PreferIncludesRule = rule.Rule{
 
 				// Check the argument type has includes method
 				argument := callExpr.Arguments.Nodes[0]
-				argType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, argument)
+				argType := ctx.Types.GetConstrainedTypeAtLocation(argument)
 				if argType == nil {
 					return
 				}
 
-				includesSymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, argType, "includes")
+				includesSymbol := ctx.Types.GetPropertyOfType(argType, "includes")
 				if includesSymbol == nil || includesSymbol.Declarations == nil {
 					return
 				}
@@ -407,7 +407,9 @@ var PreferIncludesRule = rule.Rule{
 					Text:  ".includes('" + escapedPattern + "')",
 				})
 
-				ctx.ReportNodeWithFixes(node, buildPreferStringIncludesMessage(), func() []rule.RuleFix { return fixes })
+				ctx.ReportNodeWithFixes(node, buildPreferStringIncludesMessage(), func() []rule.RuleFix {
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
+				})
 			},
 			// Handle: array.indexOf(item) !== -1 -> array.includes(item)
 			ast.KindBinaryExpression: func(node *ast.Node) {
@@ -444,7 +446,7 @@ var PreferIncludesRule = rule.Rule{
 				}
 
 				// Get the symbol of indexOf method
-				indexOfSymbol := ctx.TypeChecker.GetSymbolAtLocation(nameNode)
+				indexOfSymbol := ctx.Types.GetSymbolAtLocation(nameNode)
 				if indexOfSymbol == nil {
 					return
 				}
@@ -475,7 +477,14 @@ var PreferIncludesRule = rule.Rule{
 					})
 				}
 
-				ctx.ReportNodeWithFixes(node, buildPreferIncludesMessage(), func() []rule.RuleFix { return fixes })
+				// On arrays, `includes` finds `NaN` where `indexOf` does not
+				fixKind := rule.RuleFixKindUnsafe
+				if utils.IsTypeFlagSet(ctx.Types.GetConstrainedTypeAtLocation(propAccess.Expression), checker.TypeFlagsStringLike) {
+					fixKind = rule.RuleFixKindSafe
+				}
+				ctx.ReportNodeWithFixes(node, buildPreferIncludesMessage(), func() []rule.RuleFix {
+					return rule.RuleFixesWithKind(fixKind, fixes)
+				})
 			},
 		}
 	},
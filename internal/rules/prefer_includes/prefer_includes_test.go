@@ -129,6 +129,7 @@ func TestPreferIncludesRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferIncludes",
+					FixKind:   "safe",
 					Line:      3,
 					Column:    11,
 				},
@@ -315,6 +316,7 @@ func TestPreferIncludesRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferStringIncludes",
+					FixKind:   "safe",
 				},
 			},
 		},
@@ -421,6 +423,7 @@ func TestPreferIncludesRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferIncludes",
+					FixKind:   "unsafe",
 				},
 			},
 		},
@@ -298,7 +298,7 @@ func isBooleanConstructorContext(ctx rule.RuleContext, node *ast.Node) bool {
 		callExpr := parent.AsCallExpression()
 		if ast.IsIdentifier(callExpr.Expression) && callExpr.Expression.Text() == "Boolean" {
 			// The Boolean being called - check if it's the global Boolean
-			symbol := ctx.TypeChecker.GetSymbolAtLocation(callExpr.Expression)
+			symbol := ctx.Types.GetSymbolAtLocation(callExpr.Expression)
 			if symbol != nil {
 				// Check if this is the global Boolean (no user-defined declarations in source files)
 				for _, decl := range symbol.Declarations {
@@ -355,7 +355,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 			if utils.IsTypeFlagSet(t, checker.TypeFlagsAny|checker.TypeFlagsUnknown) {
 				return true
 			}
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				flags := checker.Type_flags(part)
 				if flags&checker.TypeFlagsNullable != 0 {
 					return true
@@ -382,7 +382,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 			}
 
 			// Check if any type constituents match the ignorable flags
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				for _, intersectionPart := range utils.IntersectionTypeParts(part) {
 					if utils.IsTypeFlagSet(intersectionPart, ignorableFlags) {
 						return false
@@ -396,7 +396,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 		// isTruthinessCheckEligibleForPreferNullish determines whether a control flow construct
 		// that uses the truthiness of a test expression is eligible for conversion
 		isTruthinessCheckEligibleForPreferNullish := func(node, testNode *ast.Node) bool {
-			testType := ctx.TypeChecker.GetTypeAtLocation(testNode)
+			testType := ctx.Types.GetTypeAtLocation(testNode)
 			if !isTypeEligibleForPreferNullish(testType) {
 				return false
 			}
@@ -438,7 +438,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 				return false
 			}
 
-			t := ctx.TypeChecker.GetTypeAtLocation(targetNode)
+			t := ctx.Types.GetTypeAtLocation(targetNode)
 			flags := checker.Type_flags(t)
 
 			// Skip if the type is any or unknown
@@ -449,7 +449,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 			// Check for null/undefined in union parts
 			hasNullType := false
 			hasUndefinedType := false
-			for _, part := range utils.UnionTypeParts(t) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
 				partFlags := checker.Type_flags(part)
 				if partFlags&checker.TypeFlagsNull != 0 {
 					hasNullType = true
@@ -570,9 +570,10 @@ var PreferNullishCoalescingRule = rule.Rule{
 				}
 				fixes = append(fixes, rule.RuleFixReplace(ctx.SourceFile, binExpr.OperatorToken, newOperator))
 
+				// `??` only falls back on null and undefined, unlike `||`
 				return []rule.RuleSuggestion{{
 					Message:  buildSuggestNullishCoalescingMessage(),
-					FixesArr: fixes,
+					FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, fixes),
 				}}
 			})
 		}
@@ -779,7 +780,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 					return []rule.RuleSuggestion{{
 						Message: buildSuggestNullishCoalescingMessage(),
 						FixesArr: []rule.RuleFix{
-							rule.RuleFixReplace(ctx.SourceFile, node, newText),
+							rule.RuleFixReplace(ctx.SourceFile, node, newText).WithKind(rule.RuleFixKindUnsafe),
 						},
 					}}
 				})
@@ -895,7 +896,7 @@ var PreferNullishCoalescingRule = rule.Rule{
 					return []rule.RuleSuggestion{{
 						Message: buildSuggestNullishCoalescingMessage(),
 						FixesArr: []rule.RuleFix{
-							rule.RuleFixReplace(ctx.SourceFile, node, newText),
+							rule.RuleFixReplace(ctx.SourceFile, node, newText).WithKind(rule.RuleFixKindUnsafe),
 						},
 					}}
 				})
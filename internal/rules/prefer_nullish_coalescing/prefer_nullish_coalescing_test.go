@@ -12,6 +12,7 @@ func nullishSuggestion(output string) []rule_tester.InvalidTestCaseSuggestion {
 	return []rule_tester.InvalidTestCaseSuggestion{{
 		MessageId: "suggestNullishCoalescing",
 		Output:    output,
+		Kind:      "unsafe",
 	}}
 }
 
@@ -749,8 +749,8 @@ func (processor *chainProcessor) getTypeInfo(node *ast.Node) *TypeInfo {
 		return info
 	}
 
-	nodeType := processor.ctx.TypeChecker.GetTypeAtLocation(node)
-	parts := utils.UnionTypeParts(nodeType)
+	nodeType := processor.ctx.Types.GetTypeAtLocation(node)
+	parts := processor.ctx.Types.UnionTypeParts(nodeType)
 
 	info := &TypeInfo{
 		parts: parts,
@@ -846,16 +846,22 @@ func (processor *chainProcessor) isChainAlreadySeen(node *ast.Node) bool {
 }
 
 func (processor *chainProcessor) reportChainWithFixes(node *ast.Node, fixes []rule.RuleFix, useSuggestion bool) {
+	// Without the option, chains are only fixed when the optional chain cannot
+	// change the result
+	fixKind := rule.RuleFixKindSafe
+	if processor.opts.AllowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing {
+		fixKind = rule.RuleFixKindUnsafe
+	}
 	if useSuggestion {
 		processor.ctx.ReportNodeWithSuggestions(node, buildPreferOptionalChainMessage(), func() []rule.RuleSuggestion {
 			return []rule.RuleSuggestion{{
 				Message:  buildOptionalChainSuggestMessage(),
-				FixesArr: fixes,
+				FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, fixes),
 			}}
 		})
 	} else {
 		processor.ctx.ReportNodeWithFixes(node, buildPreferOptionalChainMessage(), func() []rule.RuleFix {
-			return fixes
+			return rule.RuleFixesWithKind(fixKind, fixes)
 		})
 	}
 }
@@ -3297,7 +3303,7 @@ func (processor *chainProcessor) generateAndChainFixAndReport(node *ast.Node, ch
 		}
 		if hasNonTypeofAfterFirst && chain[0].comparedExpr != nil {
 			typeofTarget := chain[0].comparedExpr
-			symbol := processor.ctx.TypeChecker.GetSymbolAtLocation(typeofTarget)
+			symbol := processor.ctx.Types.GetSymbolAtLocation(typeofTarget)
 			isUndeclared := symbol == nil || len(symbol.Declarations) == 0
 
 			if isUndeclared {
@@ -3701,7 +3707,7 @@ func (processor *chainProcessor) handleEmptyObjectPattern(node *ast.Node) {
 	accessRange := processor.getNodeRange(accessExpr)
 
 	fixes := []rule.RuleFix{
-		rule.RuleFixReplaceRange(accessRange, newCode),
+		rule.RuleFixReplaceRange(accessRange, newCode).WithKind(rule.RuleFixKindUnsafe),
 	}
 
 	// (foo || {}).bar returns {} when foo is falsy, while foo?.bar returns undefined
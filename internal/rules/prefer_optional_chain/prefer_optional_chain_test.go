@@ -1028,6 +1028,7 @@ if (!a || b === a || b.indexOf('input, button, a')) {
 						{
 							MessageId: "optionalChainSuggest",
 							Output:    `foo?.bar;`,
+							Kind:      "unsafe",
 						},
 					},
 				},
@@ -1619,6 +1620,7 @@ if (!a || b === a || b.indexOf('input, button, a')) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferOptionalChain",
+					FixKind:   "safe",
 				},
 			},
 		},
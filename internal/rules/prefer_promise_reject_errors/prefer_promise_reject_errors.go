@@ -2,7 +2,6 @@ package prefer_promise_reject_errors
 
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
-	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -22,7 +21,7 @@ var PreferPromiseRejectErrorsRule = rule.Rule{
 		checkRejectCall := func(callExpression *ast.CallExpression) {
 			if len(callExpression.Arguments.Nodes) != 0 {
 				argument := callExpression.Arguments.Nodes[0]
-				t := ctx.TypeChecker.GetTypeAtLocation(argument)
+				t := ctx.Types.GetTypeAtLocation(argument)
 
 				if utils.TypeMatchesSomeSpecifier(t, opts.Allow, ctx.Program) {
 					return
@@ -44,8 +43,8 @@ var PreferPromiseRejectErrorsRule = rule.Rule{
 		}
 
 		typeAtLocationIsLikePromise := func(node *ast.Node) bool {
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
-			return (utils.IsPromiseConstructorLike(ctx.Program, ctx.TypeChecker, t) || utils.IsPromiseLike(ctx.Program, ctx.TypeChecker, t))
+			t := ctx.Types.GetTypeAtLocation(node)
+			return (utils.IsPromiseConstructorLike(ctx.Program, ctx.TypeChecker, t) || ctx.Types.IsPromiseLike(t))
 		}
 
 		return rule.RuleListeners{
@@ -57,13 +56,13 @@ var PreferPromiseRejectErrorsRule = rule.Rule{
 				if ast.IsAccessExpression(callee) {
 					// TODO(port): getStaticMemberAccessValue -> GetAccessedPropertyName is an
 					// enhancement, we should probably add tests for it
-					methodName, _ := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+					methodName, _ := ctx.Types.GetAccessedPropertyName(callee)
 					if methodName == "reject" && typeAtLocationIsLikePromise(callee.Expression()) {
 						checkRejectCall(expr)
 					}
 					// reject(...)
 				} else if ast.IsIdentifier(callee) {
-					symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+					symbol := ctx.Types.GetSymbolAtLocation(callee)
 					if symbol == nil {
 						return
 					}
@@ -94,7 +93,7 @@ var PreferPromiseRejectErrorsRule = rule.Rule{
 						break
 					}
 
-					if !utils.IsPromiseConstructorLike(ctx.Program, ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(parentNode.Expression())) {
+					if !utils.IsPromiseConstructorLike(ctx.Program, ctx.TypeChecker, ctx.Types.GetTypeAtLocation(parentNode.Expression())) {
 						return
 					}
 
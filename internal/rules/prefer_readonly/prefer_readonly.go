@@ -344,7 +344,7 @@ func getTypeAnnotationForViolatingNode(
 	violatingType *checker.Type,
 	initializerType *checker.Type,
 ) string {
-	annotation := ctx.TypeChecker.TypeToString(violatingType)
+	annotation := ctx.Types.TypeToString(violatingType)
 	if annotation == "" {
 		return ""
 	}
@@ -353,12 +353,12 @@ func getTypeAnnotationForViolatingNode(
 		return annotation
 	}
 
-	symbol := ctx.TypeChecker.ResolveName(annotation, node, ast.SymbolFlagsType, false)
+	symbol := ctx.Types.ResolveName(annotation, node, ast.SymbolFlagsType, false)
 	if symbol == nil {
 		return ""
 	}
 
-	valueSymbol := ctx.TypeChecker.ResolveName(annotation, node, ast.SymbolFlagsValue, false)
+	valueSymbol := ctx.Types.ResolveName(annotation, node, ast.SymbolFlagsValue, false)
 	if valueSymbol != nil {
 		valueSymbol = checker.SkipAlias(valueSymbol, ctx.TypeChecker)
 	}
@@ -368,7 +368,7 @@ func getTypeAnnotationForViolatingNode(
 		return ""
 	}
 
-	definitionType := checker.Checker_getDeclaredTypeOfSymbol(ctx.TypeChecker, symbol)
+	definitionType := ctx.Types.GetDeclaredTypeOfSymbol(symbol)
 	if definitionType != violatingType {
 		return ""
 	}
@@ -424,8 +424,8 @@ var PreferReadonlyRule = rule.Rule{
 				if ast.IsPropertyDeclaration(violatingNode) {
 					property := violatingNode.AsPropertyDeclaration()
 					if property.Type == nil && property.Initializer != nil && ast.IsIdentifier(nameNode) && finalizedScope.memberHasConstructorModifications(nameNode.Text()) {
-						violatingType := ctx.TypeChecker.GetTypeAtLocation(violatingNode)
-						initializerType := ctx.TypeChecker.GetTypeAtLocation(property.Initializer)
+						violatingType := ctx.Types.GetTypeAtLocation(violatingNode)
+						initializerType := ctx.Types.GetTypeAtLocation(property.Initializer)
 
 						if violatingType != nil && initializerType != nil && violatingType != initializerType && checker.Type_flags(initializerType)&checker.TypeFlagsLiteral != 0 {
 							typeAnnotation := getTypeAnnotationForViolatingNode(ctx, violatingNode, violatingType, initializerType)
@@ -461,7 +461,9 @@ var PreferReadonlyRule = rule.Rule{
 			}
 
 			for _, report := range reports {
-				ctx.ReportNodeWithFixes(report.reportNode, report.message, func() []rule.RuleFix { return report.fixes })
+				ctx.ReportNodeWithFixes(report.reportNode, report.message, func() []rule.RuleFix {
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, report.fixes)
+				})
 			}
 		}
 
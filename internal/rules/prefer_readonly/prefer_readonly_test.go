@@ -717,7 +717,7 @@ class Foo {
           private static readonly incorrectlyModifiableStatic = 7;
         }
       `},
-			Errors: []rule_tester.InvalidTestCaseError{{MessageId: `preferReadonly`, Line: 3, Column: 11, EndLine: 3, EndColumn: 53}},
+			Errors: []rule_tester.InvalidTestCaseError{{MessageId: `preferReadonly`, Line: 3, Column: 11, EndLine: 3, EndColumn: 53, FixKind: `safe`}},
 		},
 		{
 			Code: `
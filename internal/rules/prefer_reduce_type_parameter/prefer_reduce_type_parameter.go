@@ -35,7 +35,7 @@ var PreferReduceTypeParameterRule = rule.Rule{
 					return
 				}
 
-				propertyName, found := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+				propertyName, found := ctx.Types.GetAccessedPropertyName(callee)
 				if !found || propertyName != "reduce" {
 					return
 				}
@@ -43,18 +43,18 @@ var PreferReduceTypeParameterRule = rule.Rule{
 				assertionExpr := secondArg.Expression()
 				assertionType := secondArg.Type()
 
-				initializerType := ctx.TypeChecker.GetTypeAtLocation(assertionExpr)
-				assertedType := ctx.TypeChecker.GetTypeAtLocation(assertionType)
+				initializerType := ctx.Types.GetTypeAtLocation(assertionExpr)
+				assertedType := ctx.Types.GetTypeAtLocation(assertionType)
 
 				// don't report this if the resulting fix will be a type error
-				if !checker.Checker_isTypeAssignableTo(ctx.TypeChecker, initializerType, assertedType) {
+				if !ctx.Types.IsTypeAssignableTo(initializerType, assertedType) {
 					return
 				}
 
-				calleeObjType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, callee.Expression())
+				calleeObjType := ctx.Types.GetConstrainedTypeAtLocation(callee.Expression())
 
 				if utils.TypeRecurser(calleeObjType, func(t *checker.Type) bool {
-					return !checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t)
+					return !ctx.Types.IsArrayOrTupleType(t)
 				}) {
 					return
 				}
@@ -68,7 +68,9 @@ var PreferReduceTypeParameterRule = rule.Rule{
 				if expr.TypeArguments == nil {
 					fixes = append(fixes, rule.RuleFixInsertAfter(callee, "<"+ctx.SourceFile.Text()[assertionType.Pos():assertionType.End()]+">"))
 				}
-				ctx.ReportNodeWithFixes(secondArg, buildPreferTypeParameterMessage(), func() []rule.RuleFix { return fixes })
+				ctx.ReportNodeWithFixes(secondArg, buildPreferTypeParameterMessage(), func() []rule.RuleFix {
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
+				})
 			},
 		}
 	},
@@ -108,6 +108,7 @@ arr.reduce<string | undefined>(acc => acc, arr.shift());
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "preferTypeParameter",
+					FixKind:   "safe",
 					Line:      3,
 					Column:    44,
 				},
@@ -189,7 +189,7 @@ var PreferRegexpExecRule = rule.Rule{
 				ast.KindNullKeyword:
 				return staticArgumentValue{kind: staticArgumentValueOther}
 			case ast.KindIdentifier:
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+				symbol := ctx.Types.GetSymbolAtLocation(node)
 				if symbol == nil {
 					return staticArgumentValue{kind: staticArgumentValueUnknown}
 				}
@@ -273,7 +273,7 @@ var PreferRegexpExecRule = rule.Rule{
 				return []rule.RuleFix{
 					buildWrappingFix(callNode, []*ast.Node{objectNode, argumentNode}, func(code ...string) string {
 						return buildExpression(code[0], code[1])
-					}),
+					}).WithKind(rule.RuleFixKindSafe),
 				}
 			})
 		}
@@ -290,21 +290,21 @@ var PreferRegexpExecRule = rule.Rule{
 					return
 				}
 
-				propertyName, ok := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+				propertyName, ok := ctx.Types.GetAccessedPropertyName(callee)
 				if !ok || propertyName != "match" {
 					return
 				}
 
 				objectNode := callee.Expression()
-				objectType := ctx.TypeChecker.GetTypeAtLocation(objectNode)
+				objectType := ctx.Types.GetTypeAtLocation(objectNode)
 				if utils.GetTypeName(ctx.TypeChecker, objectType) != "string" {
 					return
 				}
 
 				argumentNode := callExpression.Arguments.Nodes[0]
 				staticArgument := getStaticArgumentValue(argumentNode, map[*ast.Symbol]struct{}{})
-				argumentType := ctx.TypeChecker.GetTypeAtLocation(argumentNode)
-				argumentTypes := collectArgumentTypes(utils.UnionTypeParts(argumentType))
+				argumentType := ctx.Types.GetTypeAtLocation(argumentNode)
+				argumentTypes := collectArgumentTypes(ctx.Types.UnionTypeParts(argumentType))
 
 				if staticArgument.kind == staticArgumentValueRegExp && strings.Contains(staticArgument.regExpFlags, "g") {
 					return
@@ -100,6 +100,7 @@ text.match(returnsRegexp());
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: `regExpExecOverStringMatch`,
+					FixKind:   `safe`,
 					Line:      1,
 					Column:    13,
 				},
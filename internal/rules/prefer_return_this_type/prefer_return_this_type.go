@@ -62,7 +62,7 @@ var PreferReturnThisTypeRule = rule.Rule{
 				}
 			}
 
-			classType := ctx.TypeChecker.GetTypeAtLocation(originalClass).AsInterfaceType()
+			classType := ctx.Types.GetTypeAtLocation(originalClass).AsInterfaceType()
 
 			if ast.IsBlock(body) {
 				hasReturnThis := false
@@ -81,7 +81,7 @@ var PreferReturnThisTypeRule = rule.Rule{
 						return false
 					}
 
-					t := ctx.TypeChecker.GetTypeAtLocation(expr)
+					t := ctx.Types.GetTypeAtLocation(expr)
 					if classType.AsType() == t {
 						return true
 					}
@@ -99,13 +99,15 @@ var PreferReturnThisTypeRule = rule.Rule{
 				if classType == nil {
 					return
 				}
-				t := ctx.TypeChecker.GetTypeAtLocation(body)
+				t := ctx.Types.GetTypeAtLocation(body)
 				if checker.InterfaceType_thisType(classType) != t {
 					return
 				}
 			}
 
-			ctx.ReportNodeWithFixes(node, buildUseThisTypeMessage(), func() []rule.RuleFix { return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "this")} })
+			ctx.ReportNodeWithFixes(node, buildUseThisTypeMessage(), func() []rule.RuleFix {
+				return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "this").WithKind(rule.RuleFixKindSafe)}
+			})
 		}
 
 		return rule.RuleListeners{
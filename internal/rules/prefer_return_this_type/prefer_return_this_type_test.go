@@ -130,6 +130,7 @@ class Foo {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "useThisType",
+					FixKind:   "safe",
 					Line:      3,
 					Column:    8,
 				},
@@ -152,7 +152,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 		}
 
 		isStringType := func(node *ast.Node) bool {
-			t := ctx.TypeChecker.GetTypeAtLocation(ast.SkipParentheses(node))
+			t := ctx.Types.GetTypeAtLocation(ast.SkipParentheses(node))
 			return utils.GetTypeName(ctx.TypeChecker, t) == "string"
 		}
 
@@ -192,7 +192,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 			}
 
 			if ast.IsIdentifier(node) {
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+				symbol := ctx.Types.GetSymbolAtLocation(node)
 				if symbol == nil || symbol.ValueDeclaration == nil || !ast.IsVariableDeclaration(symbol.ValueDeclaration) {
 					return "", false
 				}
@@ -227,7 +227,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 				return nullishKindUndefined
 			}
 			if ast.IsIdentifier(node) {
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+				symbol := ctx.Types.GetSymbolAtLocation(node)
 				if symbol == nil || symbol.ValueDeclaration == nil || !ast.IsVariableDeclaration(symbol.ValueDeclaration) {
 					return nullishKindUnknown
 				}
@@ -422,7 +422,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 					flags = args[1].AsStringLiteral().Text
 				}
 			case ast.IsIdentifier(node):
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+				symbol := ctx.Types.GetSymbolAtLocation(node)
 				if symbol == nil || symbol.ValueDeclaration == nil || !ast.IsVariableDeclaration(symbol.ValueDeclaration) {
 					return nil, false
 				}
@@ -472,7 +472,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 				rule.RuleFixReplaceRange(rightRange.WithPos(rightRange.End()).WithEnd(binRange.End()), ")"),
 			)
 
-			return fixes
+			return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 		}
 
 		fixWithArgument := func(bin *ast.BinaryExpression, callExpr *ast.CallExpression, memberNode *ast.Node, kind string) []rule.RuleFix {
@@ -490,7 +490,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 				rule.RuleFixRemoveRange(callRange.WithPos(callRange.End()).WithEnd(binRange.End())),
 			)
 
-			return fixes
+			return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 		}
 
 		reportStringIndexOrCharAt := func(bin *ast.BinaryExpression, memberNode *ast.Node, indexNode *ast.Node) {
@@ -633,7 +633,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 						rule.RuleFixRemoveRange(callRange.WithPos(callRange.End()).WithEnd(binRange.End())),
 					)
 
-					return fixes
+					return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 				})
 			case "slice", "substring":
 				obj := memberObject(memberNode)
@@ -784,7 +784,7 @@ var PreferStringStartsEndsWithRule = rule.Rule{
 				}
 				fixes = append(fixes, rule.RuleFixInsertAfter(arg, fmt.Sprintf("%s%s(%s", memberOptionalOperator(memberNode), method, strconv.Quote(parsed.text))))
 
-				return fixes
+				return rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes)
 			})
 		}
 
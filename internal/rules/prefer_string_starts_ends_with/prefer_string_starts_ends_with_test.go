@@ -468,6 +468,7 @@ func TestPreferStringStartsEndsWithRule(t *testing.T) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: `preferStartsWith`,
+					FixKind:   `safe`,
 				},
 			},
 		},
@@ -70,7 +70,7 @@ var PromiseFunctionAsyncRule = rule.Rule{
 				return false
 			}
 
-			bases := checker.Checker_getBaseTypes(ctx.TypeChecker, t)
+			bases := ctx.Types.GetBaseTypes(t)
 			if matchAnyInstead {
 				return utils.Some(bases, predicate)
 			}
@@ -84,8 +84,8 @@ var PromiseFunctionAsyncRule = rule.Rule{
 				return
 			}
 
-			t := ctx.TypeChecker.GetTypeAtLocation(node)
-			signatures := utils.GetCallSignatures(ctx.TypeChecker, t)
+			t := ctx.Types.GetTypeAtLocation(node)
+			signatures := ctx.Types.GetCallSignatures(t)
 			if len(signatures) == 0 {
 				return
 			}
@@ -94,7 +94,7 @@ var PromiseFunctionAsyncRule = rule.Rule{
 
 			everySignatureReturnsPromise := true
 			for _, signature := range signatures {
-				returnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+				returnType := ctx.Types.GetReturnTypeOfSignature(signature)
 				if !opts.AllowAny && utils.IsTypeFlagSet(returnType, checker.TypeFlagsAnyOrUnknown) {
 					// Report without auto fixer because the return type is unknown
 					// TODO(port): getFunctionHeadLoc
@@ -119,7 +119,7 @@ var PromiseFunctionAsyncRule = rule.Rule{
 			isHybridReturnType := false
 			if !hasExplicitReturnType {
 				for _, signature := range signatures {
-					returnType := checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature)
+					returnType := ctx.Types.GetReturnTypeOfSignature(signature)
 					if utils.IsUnionType(returnType) {
 						// Check if not every part of the union is a promise type
 						allPartsArePromise := utils.Every(returnType.Types(), func(part *checker.Type) bool {
@@ -133,12 +133,14 @@ var PromiseFunctionAsyncRule = rule.Rule{
 				}
 			}
 
+			// Errors thrown synchronously become rejections in an async function,
+			// so the fixes are unsafe.
 			insertAsyncFix := func() rule.RuleFix {
-				return rule.RuleFixInsertBefore(ctx.SourceFile, node, " async ")
+				return rule.RuleFixInsertBefore(ctx.SourceFile, node, " async ").WithKind(rule.RuleFixKindUnsafe)
 			}
 			if ast.IsMethodDeclaration(node) {
 				insertAsyncFix = func() rule.RuleFix {
-					return rule.RuleFixInsertBefore(ctx.SourceFile, node.Name(), " async ")
+					return rule.RuleFixInsertBefore(ctx.SourceFile, node.Name(), " async ").WithKind(rule.RuleFixKindUnsafe)
 				}
 			}
 			if ast.IsFunctionDeclaration(node) {
@@ -146,7 +148,7 @@ var PromiseFunctionAsyncRule = rule.Rule{
 				if modifiers != nil && len(modifiers.NodeList.Nodes) > 0 {
 					lastModifier := modifiers.NodeList.Nodes[len(modifiers.NodeList.Nodes)-1]
 					insertAsyncFix = func() rule.RuleFix {
-						return rule.RuleFixInsertAfter(lastModifier, " async")
+						return rule.RuleFixInsertAfter(lastModifier, " async").WithKind(rule.RuleFixKindUnsafe)
 					}
 				}
 			}
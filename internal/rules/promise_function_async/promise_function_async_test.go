@@ -254,6 +254,7 @@ const nonAsyncPromiseFunctionExpressionA =  async function (p: Promise<void>) {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "missingAsync",
+					FixKind:   "unsafe",
 				},
 			},
 		},
@@ -854,6 +855,7 @@ function promiseInUnionWithoutExplicitReturnType(p: boolean) {
   return p ? Promise.resolve(5) : 5;
 }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
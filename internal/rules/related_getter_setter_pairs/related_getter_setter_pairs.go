@@ -2,7 +2,6 @@ package related_getter_setter_pairs
 
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
-	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/typescript-eslint/tsgolint/internal/rule"
 	"github.com/typescript-eslint/tsgolint/internal/utils"
 )
@@ -18,10 +17,10 @@ var RelatedGetterSetterPairsRule = rule.Rule{
 	Name: "related-getter-setter-pairs",
 	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
 		checkAccessorsPair := func(getter *ast.GetAccessorDeclaration, setter *ast.SetAccessorDeclaration) {
-			getType := ctx.TypeChecker.GetTypeAtLocation(getter.AsNode())
-			setType := ctx.TypeChecker.GetTypeAtLocation(setter.Parameters.Nodes[0])
+			getType := ctx.Types.GetTypeAtLocation(getter.AsNode())
+			setType := ctx.Types.GetTypeAtLocation(setter.Parameters.Nodes[0])
 
-			if !checker.Checker_isTypeAssignableTo(ctx.TypeChecker, getType, setType) {
+			if !ctx.Types.IsTypeAssignableTo(getType, setType) {
 				ctx.ReportNode(getter.Type, buildMismatchMessage())
 			}
 		}
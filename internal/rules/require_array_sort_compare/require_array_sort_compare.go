@@ -31,22 +31,22 @@ var RequireArraySortCompareRule = rule.Rule{
 					return
 				}
 
-				if propertyName, found := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee); !found || (propertyName != "sort" && propertyName != "toSorted") {
+				if propertyName, found := ctx.Types.GetAccessedPropertyName(callee); !found || (propertyName != "sort" && propertyName != "toSorted") {
 					return
 				}
 
-				calleeObjType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, callee.Expression())
+				calleeObjType := ctx.Types.GetConstrainedTypeAtLocation(callee.Expression())
 
-				if opts.IgnoreStringArrays && checker.Checker_isArrayOrTupleType(ctx.TypeChecker, calleeObjType) {
-					if utils.Every(checker.Checker_getTypeArguments(ctx.TypeChecker, calleeObjType), func(t *checker.Type) bool {
+				if opts.IgnoreStringArrays && ctx.Types.IsArrayOrTupleType(calleeObjType) {
+					if utils.Every(ctx.Types.GetTypeArguments(calleeObjType), func(t *checker.Type) bool {
 						return utils.IsTypeFlagSet(t, checker.TypeFlagsString) || utils.GetTypeName(ctx.TypeChecker, t) == "string"
 					}) {
 						return
 					}
 				}
 
-				if utils.Every(utils.UnionTypeParts(calleeObjType), func(t *checker.Type) bool {
-					return checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t)
+				if utils.Every(ctx.Types.UnionTypeParts(calleeObjType), func(t *checker.Type) bool {
+					return ctx.Types.IsArrayOrTupleType(t)
 				}) {
 					ctx.ReportNode(node, buildRequireCompareMessage())
 				}
@@ -230,9 +230,10 @@ var RequireAwaitRule = rule.Rule{
 					utils.GetFunctionHeadLoc(ctx.SourceFile, node),
 					buildMissingAwaitMessage(),
 					func() []rule.RuleSuggestion {
+						// The function no longer returns a promise
 						return []rule.RuleSuggestion{{
 							Message:  buildRemoveAsyncMessage(),
-							FixesArr: buildRemoveAsyncFixes(ctx.SourceFile, node, asyncToken, isGen),
+							FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, buildRemoveAsyncFixes(ctx.SourceFile, node, asyncToken, isGen)),
 						}}
 					},
 				)
@@ -274,7 +275,7 @@ var RequireAwaitRule = rule.Rule{
 					return
 				}
 
-				if utils.IsThenableType(ctx.TypeChecker, body, ctx.TypeChecker.GetTypeAtLocation(body)) {
+				if ctx.Types.IsThenableType(body, ctx.Types.GetTypeAtLocation(body)) {
 					markAsHasAwait()
 				}
 			},
@@ -313,13 +314,13 @@ var RequireAwaitRule = rule.Rule{
 				}
 
 				if node.AsYieldExpression().AsteriskToken == nil {
-					if utils.IsThenableType(ctx.TypeChecker, argument, ctx.TypeChecker.GetTypeAtLocation(argument)) {
+					if ctx.Types.IsThenableType(argument, ctx.Types.GetTypeAtLocation(argument)) {
 						currentScope.isAsyncYield = true
 					}
 					return
 				}
 
-				t := ctx.TypeChecker.GetTypeAtLocation(argument)
+				t := ctx.Types.GetTypeAtLocation(argument)
 				hasAsyncYield := utils.TypeRecurser(t, func(t *checker.Type) bool {
 					return utils.GetWellKnownSymbolPropertyOfType(t, "asyncIterator", ctx.TypeChecker) != nil
 				})
@@ -333,7 +334,7 @@ var RequireAwaitRule = rule.Rule{
 				}
 
 				expr := node.Expression()
-				if expr != nil && utils.IsThenableType(ctx.TypeChecker, expr, ctx.TypeChecker.GetTypeAtLocation(expr)) {
+				if expr != nil && ctx.Types.IsThenableType(expr, ctx.Types.GetTypeAtLocation(expr)) {
 					markAsHasAwait()
 				}
 			},
@@ -244,6 +244,7 @@ function numberOne(): number {
   return 1;
 }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
@@ -83,7 +83,7 @@ var RestrictPlusOperandsRule = rule.Rule{
 		}
 
 		getTypeConstrained := func(node *ast.Node) *checker.Type {
-			return checker.Checker_getBaseTypeOfLiteralType(ctx.TypeChecker, utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node))
+			return ctx.Types.GetBaseTypeOfLiteralType(ctx.Types.GetConstrainedTypeAtLocation(node))
 		}
 
 		globalRegexpType := checker.Checker_globalRegExpType(ctx.TypeChecker)
@@ -105,9 +105,9 @@ var RestrictPlusOperandsRule = rule.Rule{
 			foundInvalid := false
 
 			var flags checker.TypeFlags
-			baseTypeString := ctx.TypeChecker.TypeToString(baseType)
+			baseTypeString := ctx.Types.TypeToString(baseType)
 
-			for _, part := range utils.UnionTypeParts(baseType) {
+			for _, part := range ctx.Types.UnionTypeParts(baseType) {
 				flags |= checker.Type_flags(part)
 				if utils.IsTypeFlagSet(part, invalidFlags) {
 					return flags, baseTypeString, true
@@ -136,8 +136,8 @@ var RestrictPlusOperandsRule = rule.Rule{
 		) {
 			leftType := getTypeConstrained(node.Left)
 			rightType := getTypeConstrained(node.Right)
-			leftTypeString := ctx.TypeChecker.TypeToString(leftType)
-			rightTypeString := ctx.TypeChecker.TypeToString(rightType)
+			leftTypeString := ctx.Types.TypeToString(leftType)
+			rightTypeString := ctx.Types.TypeToString(rightType)
 			leftRange := utils.TrimNodeTextRange(ctx.SourceFile, node.Left)
 			rightRange := utils.TrimNodeTextRange(ctx.SourceFile, node.Right)
 
@@ -52,13 +52,13 @@ var RestrictTemplateExpressionsRule = rule.Rule{
 
 		var isTypeAllowed func(innerType *checker.Type) bool
 		isTypeAllowed = func(innerType *checker.Type) bool {
-			return utils.Every(utils.UnionTypeParts(innerType), func(t *checker.Type) bool {
+			return utils.Every(ctx.Types.UnionTypeParts(innerType), func(t *checker.Type) bool {
 				return utils.Some(utils.IntersectionTypeParts(t), func(t *checker.Type) bool {
 					return utils.IsTypeFlagSet(t, allowedFlags) ||
 						utils.MatchesTypeOrBaseType(ctx.TypeChecker, t, func(t *checker.Type) bool {
 							return utils.TypeMatchesSomeSpecifier(t, opts.Allow, ctx.Program)
 						}) ||
-						(opts.AllowArray && checker.Checker_isArrayOrTupleType(ctx.TypeChecker, t) && isTypeAllowed(utils.GetNumberIndexType(ctx.TypeChecker, t))) ||
+						(opts.AllowArray && ctx.Types.IsArrayOrTupleType(t) && isTypeAllowed(ctx.Types.GetNumberIndexType(t))) ||
 						(opts.AllowRegExp && t == globalRegexpType)
 				})
 			})
@@ -81,7 +81,7 @@ var RestrictTemplateExpressionsRule = rule.Rule{
 						diagnosticRange := utils.TrimNodeTextRange(ctx.SourceFile, expression)
 						ctx.ReportDiagnostic(buildInvalidTypeDiagnostic(
 							diagnosticRange,
-							ctx.TypeChecker.TypeToString(expressionType),
+							ctx.Types.TypeToString(expressionType),
 						))
 					}
 				}
@@ -197,19 +197,21 @@ var ReturnAwaitRule = rule.Rule{
 			}
 		}
 
+		// Adding or removing an await changes when the function resumes, so the
+		// fixes are unsafe.
 		removeAwaitFix := func(node *ast.Node) rule.RuleFix {
-			return rule.RuleFixRemoveRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Pos()))
+			return rule.RuleFixRemoveRange(scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Pos())).WithKind(rule.RuleFixKindUnsafe)
 		}
 		insertAwaitFix := func(node *ast.Node, isHighPrecedence bool) []rule.RuleFix {
 			if isHighPrecedence {
-				return []rule.RuleFix{
+				return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 					rule.RuleFixInsertBefore(ctx.SourceFile, node, "await "),
-				}
+				})
 			}
-			return []rule.RuleFix{
+			return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, []rule.RuleFix{
 				rule.RuleFixInsertBefore(ctx.SourceFile, node, "await ("),
 				rule.RuleFixInsertAfter(node, ")"),
-			}
+			})
 		}
 
 		test := func(node *ast.Node) {
@@ -222,7 +224,7 @@ var ReturnAwaitRule = rule.Rule{
 				child = node
 			}
 
-			t := ctx.TypeChecker.GetTypeAtLocation(child)
+			t := ctx.Types.GetTypeAtLocation(child)
 			certainty := utils.NeedsToBeAwaited(ctx.TypeChecker, node, t)
 
 			if certainty != utils.TypeAwaitableAlways {
@@ -514,6 +514,7 @@ class C<R extends unknown> {
 			Errors: []rule_tester.InvalidTestCaseError{
 				{
 					MessageId: "nonPromiseAwait",
+					FixKind:   "unsafe",
 					Line:      3,
 				},
 			},
@@ -621,6 +622,7 @@ class C<R extends unknown> {
           }
         }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
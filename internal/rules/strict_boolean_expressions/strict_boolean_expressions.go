@@ -152,7 +152,7 @@ var StrictBooleanExpressionsRule = rule.Rule{
 			ast.KindCallExpression: func(node *ast.Node) {
 				callExpr := node.AsCallExpression()
 
-				assertedArgument := findTruthinessAssertedArgument(ctx.TypeChecker, callExpr)
+				assertedArgument := findTruthinessAssertedArgument(ctx, callExpr)
 				if assertedArgument != nil {
 					traverseNode(ctx, assertedArgument, opts, &traversedNodes, true)
 				}
@@ -167,20 +167,20 @@ var StrictBooleanExpressionsRule = rule.Rule{
 							ctx.ReportNode(arg, buildPredicateCannotBeAsyncMessage())
 							return
 						}
-						funcType := ctx.TypeChecker.GetTypeAtLocation(arg)
-						signatures := ctx.TypeChecker.GetCallSignatures(funcType)
+						funcType := ctx.Types.GetTypeAtLocation(arg)
+						signatures := ctx.Types.GetCallSignatures(funcType)
 						var types []*checker.Type
 						for _, signature := range signatures {
-							returnType := ctx.TypeChecker.GetReturnTypeOfSignature(signature)
+							returnType := ctx.Types.GetReturnTypeOfSignature(signature)
 							typeFlags := checker.Type_flags(returnType)
 							if typeFlags&checker.TypeFlagsTypeParameter != 0 {
-								constraint := ctx.TypeChecker.GetConstraintOfTypeParameter(returnType)
+								constraint := ctx.Types.GetConstraintOfTypeParameter(returnType)
 								if constraint != nil {
 									returnType = constraint
 								}
 							}
 
-							types = append(types, utils.UnionTypeParts(returnType)...)
+							types = append(types, ctx.Types.UnionTypeParts(returnType)...)
 						}
 						checkCondition(ctx, node, types, opts)
 					}
@@ -196,7 +196,7 @@ var StrictBooleanExpressionsRule = rule.Rule{
 	},
 }
 
-func findTruthinessAssertedArgument(typeChecker *checker.Checker, callExpr *ast.CallExpression) *ast.Node {
+func findTruthinessAssertedArgument(ctx rule.RuleContext, callExpr *ast.CallExpression) *ast.Node {
 	var checkableArguments []*ast.Node
 	for _, argument := range callExpr.Arguments.Nodes {
 		if argument.Kind == ast.KindSpreadElement {
@@ -208,32 +208,32 @@ func findTruthinessAssertedArgument(typeChecker *checker.Checker, callExpr *ast.
 		return nil
 	}
 
-	calleeType := typeChecker.GetTypeAtLocation(callExpr.Expression)
+	calleeType := ctx.Types.GetTypeAtLocation(callExpr.Expression)
 	if calleeType == nil {
 		return nil
 	}
 
-	unionTypes := utils.UnionTypeParts(calleeType)
+	unionTypes := ctx.Types.UnionTypeParts(calleeType)
 	isUnionType := len(unionTypes) > 1
 
 	node := callExpr.AsNode()
-	signature := typeChecker.GetResolvedSignature(node)
+	signature := ctx.Types.GetResolvedSignature(node)
 
 	if signature == nil {
 		if !isUnionType {
 			return nil
 		}
 
-		return findTruthinessAssertedArgumentInUnionSignatures(typeChecker, unionTypes, checkableArguments)
+		return findTruthinessAssertedArgumentInUnionSignatures(ctx.TypeChecker, unionTypes, checkableArguments)
 	}
 
-	firstTypePredicateResult := typeChecker.GetTypePredicateOfSignature(signature)
+	firstTypePredicateResult := ctx.Types.GetTypePredicateOfSignature(signature)
 	if firstTypePredicateResult == nil {
 		if !isUnionType {
 			return nil
 		}
 
-		return findTruthinessAssertedArgumentInUnionSignatures(typeChecker, unionTypes, checkableArguments)
+		return findTruthinessAssertedArgumentInUnionSignatures(ctx.TypeChecker, unionTypes, checkableArguments)
 	}
 
 	return findTruthinessAssertedArgumentInPredicate(firstTypePredicateResult, checkableArguments)
@@ -268,8 +268,8 @@ func findTruthinessAssertedArgumentInPredicate(typePredicate *checker.TypePredic
 }
 
 func checkNode(ctx rule.RuleContext, node *ast.Node, opts StrictBooleanExpressionsOptions) {
-	nodeType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, node)
-	checkCondition(ctx, node, utils.UnionTypeParts(nodeType), opts)
+	nodeType := ctx.Types.GetConstrainedTypeAtLocation(node)
+	checkCondition(ctx, node, ctx.Types.UnionTypeParts(nodeType), opts)
 }
 
 func traverseLogicalExpression(ctx rule.RuleContext, binExpr *ast.BinaryExpression, opts StrictBooleanExpressionsOptions, traversedNodes *utils.Set[*ast.Node], isCondition bool) {
@@ -41,20 +41,20 @@ var StrictVoidReturnRule = rule.Rule{
 		}
 
 		isAllowedType := func(t *checker.Type) bool {
-			return utils.Every(utils.UnionTypeParts(t), func(typePart *checker.Type) bool {
+			return utils.Every(ctx.Types.UnionTypeParts(t), func(typePart *checker.Type) bool {
 				return utils.IsTypeFlagSet(typePart, allowedReturnTypeFlags)
 			})
 		}
 
 		isVoidReturningFunctionType := func(t *checker.Type) bool {
 			returnTypes := []*checker.Type{}
-			for _, typePart := range utils.UnionTypeParts(t) {
-				for _, signature := range utils.GetCallSignatures(ctx.TypeChecker, typePart) {
-					returnTypes = append(returnTypes, checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature))
+			for _, typePart := range ctx.Types.UnionTypeParts(t) {
+				for _, signature := range ctx.Types.GetCallSignatures(typePart) {
+					returnTypes = append(returnTypes, ctx.Types.GetReturnTypeOfSignature(signature))
 				}
 			}
 			return len(returnTypes) > 0 && utils.Every(returnTypes, func(returnType *checker.Type) bool {
-				return utils.Every(utils.UnionTypeParts(returnType), func(typePart *checker.Type) bool {
+				return utils.Every(ctx.Types.UnionTypeParts(returnType), func(typePart *checker.Type) bool {
 					return utils.IsTypeFlagSet(typePart, checker.TypeFlagsVoid)
 				})
 			})
@@ -77,9 +77,9 @@ var StrictVoidReturnRule = rule.Rule{
 
 		var reportIfNonVoidFunction func(funcNode *ast.Node)
 		reportIfNonVoidFunction = func(funcNode *ast.Node) {
-			actualType := checker.Checker_getApparentType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(funcNode))
-			if utils.Every(utils.GetCallSignatures(ctx.TypeChecker, actualType), func(signature *checker.Signature) bool {
-				return isAllowedType(checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, signature))
+			actualType := ctx.Types.GetApparentType(ctx.Types.GetTypeAtLocation(funcNode))
+			if utils.Every(ctx.Types.GetCallSignatures(actualType), func(signature *checker.Signature) bool {
+				return isAllowedType(ctx.Types.GetReturnTypeOfSignature(signature))
 			}) {
 				return
 			}
@@ -126,7 +126,7 @@ var StrictVoidReturnRule = rule.Rule{
 				if ast.IsReturnStatement(node) {
 					returnStatement := node.AsReturnStatement()
 					if returnStatement.Expression != nil {
-						returnType := ctx.TypeChecker.GetTypeAtLocation(returnStatement.Expression)
+						returnType := ctx.Types.GetTypeAtLocation(returnStatement.Expression)
 						if !isAllowedType(returnType) {
 							ctx.ReportNode(node, buildNonVoidReturnMessage())
 						}
@@ -139,7 +139,7 @@ var StrictVoidReturnRule = rule.Rule{
 		}
 
 		checkExpressionNode := func(node *ast.Node) bool {
-			expectedType := checker.Checker_getContextualType(ctx.TypeChecker, node, checker.ContextFlagsNone)
+			expectedType := ctx.Types.GetContextualType(node, checker.ContextFlagsNone)
 			if expectedType != nil && isVoidReturningFunctionType(expectedType) {
 				reportIfNonVoidFunction(node)
 				return true
@@ -148,12 +148,12 @@ var StrictVoidReturnRule = rule.Rule{
 		}
 
 		checkFunctionCallNode := func(callNode *ast.Expression) {
-			funcType := ctx.TypeChecker.GetTypeAtLocation(callNode.Expression())
-			signatures := utils.Flatten(utils.Map(utils.UnionTypeParts(funcType), func(typePart *checker.Type) []*checker.Signature {
+			funcType := ctx.Types.GetTypeAtLocation(callNode.Expression())
+			signatures := utils.Flatten(utils.Map(ctx.Types.UnionTypeParts(funcType), func(typePart *checker.Type) []*checker.Signature {
 				if ast.IsCallExpression(callNode) {
-					return utils.GetCallSignatures(ctx.TypeChecker, typePart)
+					return ctx.Types.GetCallSignatures(typePart)
 				}
-				return utils.GetConstructSignatures(ctx.TypeChecker, typePart)
+				return ctx.Types.GetConstructSignatures(typePart)
 			}))
 
 			for argIdx, argNode := range callNode.Arguments() {
@@ -167,10 +167,10 @@ var StrictVoidReturnRule = rule.Rule{
 					if argIdx >= len(parameters) {
 						continue
 					}
-					paramType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(parameters[argIdx], callNode.Expression())
-					for _, paramTypePart := range utils.UnionTypeParts(paramType) {
-						for _, paramSignature := range utils.GetCallSignatures(ctx.TypeChecker, paramTypePart) {
-							argExpectedReturnTypes = append(argExpectedReturnTypes, checker.Checker_getReturnTypeOfSignature(ctx.TypeChecker, paramSignature))
+					paramType := ctx.Types.GetTypeOfSymbolAtLocation(parameters[argIdx], callNode.Expression())
+					for _, paramTypePart := range ctx.Types.UnionTypeParts(paramType) {
+						for _, paramSignature := range ctx.Types.GetCallSignatures(paramTypePart) {
+							argExpectedReturnTypes = append(argExpectedReturnTypes, ctx.Types.GetReturnTypeOfSignature(paramSignature))
 						}
 					}
 				}
@@ -195,7 +195,7 @@ var StrictVoidReturnRule = rule.Rule{
 				return ""
 			}
 
-			if symbol := ctx.TypeChecker.GetSymbolAtLocation(nameNode); symbol != nil && symbol.Name != "" {
+			if symbol := ctx.Types.GetSymbolAtLocation(nameNode); symbol != nil && symbol.Name != "" {
 				return symbol.Name
 			}
 
@@ -230,7 +230,7 @@ var StrictVoidReturnRule = rule.Rule{
 				return nil
 			}
 
-			memberSymbol := ctx.TypeChecker.GetSymbolAtLocation(memberNameNode)
+			memberSymbol := ctx.Types.GetSymbolAtLocation(memberNameNode)
 			if memberSymbol == nil {
 				return nil
 			}
@@ -238,12 +238,12 @@ var StrictVoidReturnRule = rule.Rule{
 			baseMemberTypes := []*checker.Type{}
 			for _, heritageClause := range heritageClauses.Nodes {
 				for _, heritageTypeNode := range heritageClause.AsHeritageClause().Types.Nodes {
-					heritageType := ctx.TypeChecker.GetTypeAtLocation(heritageTypeNode)
-					heritageMember := checker.Checker_getPropertyOfType(ctx.TypeChecker, heritageType, memberSymbol.Name)
+					heritageType := ctx.Types.GetTypeAtLocation(heritageTypeNode)
+					heritageMember := ctx.Types.GetPropertyOfType(heritageType, memberSymbol.Name)
 					if heritageMember == nil {
 						continue
 					}
-					baseMemberTypes = append(baseMemberTypes, ctx.TypeChecker.GetTypeOfSymbolAtLocation(heritageMember, memberNode))
+					baseMemberTypes = append(baseMemberTypes, ctx.Types.GetTypeOfSymbolAtLocation(heritageMember, memberNode))
 				}
 			}
 
@@ -255,7 +255,7 @@ var StrictVoidReturnRule = rule.Rule{
 				return
 			}
 
-			objType := checker.Checker_getContextualType(ctx.TypeChecker, methodNode.Parent, checker.ContextFlagsNone)
+			objType := ctx.Types.GetContextualType(methodNode.Parent, checker.ContextFlagsNone)
 			if objType == nil {
 				return
 			}
@@ -263,11 +263,11 @@ var StrictVoidReturnRule = rule.Rule{
 			if memberName == "" {
 				return
 			}
-			propertySymbol := checker.Checker_getPropertyOfType(ctx.TypeChecker, objType, memberName)
+			propertySymbol := ctx.Types.GetPropertyOfType(objType, memberName)
 			if propertySymbol == nil {
 				return
 			}
-			expectedType := ctx.TypeChecker.GetTypeOfSymbolAtLocation(propertySymbol, methodNode)
+			expectedType := ctx.Types.GetTypeOfSymbolAtLocation(propertySymbol, methodNode)
 			if isVoidReturningFunctionType(expectedType) {
 				reportIfNonVoidFunction(methodNode)
 			}
@@ -63,9 +63,9 @@ func isLiteralLikeType(t *checker.Type) bool {
  *
  * Default cases are never superfluous in switches with non-literal types.
  */
-func doesTypeContainNonLiteralType(t *checker.Type) bool {
+func doesTypeContainNonLiteralType(ctx rule.RuleContext, t *checker.Type) bool {
 	return utils.Some(
-		utils.UnionTypeParts(t),
+		ctx.Types.UnionTypeParts(t),
 		func(t *checker.Type) bool {
 			return utils.Every(
 				utils.IntersectionTypeParts(t),
@@ -105,7 +105,7 @@ func getCommentDefaultCase(sourceFile *ast.SourceFile, node *ast.SwitchStatement
 	return nil
 }
 
-func getSwitchMetadata(sourceFile *ast.SourceFile, typeChecker *checker.Checker, node *ast.SwitchStatement, commentPattern *regexp2.Regexp) *SwitchMetadata {
+func getSwitchMetadata(ctx rule.RuleContext, node *ast.SwitchStatement, commentPattern *regexp2.Regexp) *SwitchMetadata {
 	cases := node.CaseBlock.AsCaseBlock().Clauses.Nodes
 	defaultCaseIndex := slices.IndexFunc(cases, ast.IsDefaultClause)
 	var defaultCase *ast.CaseOrDefaultClause
@@ -114,10 +114,10 @@ func getSwitchMetadata(sourceFile *ast.SourceFile, typeChecker *checker.Checker,
 	}
 	var defaultCaseComment *ast.CommentRange
 	if defaultCase == nil {
-		defaultCaseComment = getCommentDefaultCase(sourceFile, node, commentPattern)
+		defaultCaseComment = getCommentDefaultCase(ctx.SourceFile, node, commentPattern)
 	}
 
-	discriminantType := utils.GetConstrainedTypeAtLocation(typeChecker, node.Expression)
+	discriminantType := ctx.Types.GetConstrainedTypeAtLocation(node.Expression)
 	symbolName := ""
 	if discriminantType.Symbol() != nil {
 		symbolName = discriminantType.Symbol().Name
@@ -130,14 +130,14 @@ func getSwitchMetadata(sourceFile *ast.SourceFile, typeChecker *checker.Checker,
 			continue
 		}
 
-		caseType := utils.GetConstrainedTypeAtLocation(typeChecker, c.AsCaseOrDefaultClause().Expression)
+		caseType := ctx.Types.GetConstrainedTypeAtLocation(c.AsCaseOrDefaultClause().Expression)
 		caseTypeSet[caseType] = struct{}{}
 		if utils.IsTypeFlagSet(caseType, checker.TypeFlagsUndefined) {
 			hasUndefinedCase = true
 		}
 	}
 
-	containsNonLiteralType := doesTypeContainNonLiteralType(discriminantType)
+	containsNonLiteralType := doesTypeContainNonLiteralType(ctx, discriminantType)
 
 	missingLiteralBranchTypes := make([]*checker.Type, 0, 10)
 	utils.TypeRecurser(discriminantType, func(t *checker.Type) bool {
@@ -270,7 +270,8 @@ func applyMissingCases(sourceFile *ast.SourceFile, node *ast.SwitchStatement, de
 
 func fixSwitch(sourceFile *ast.SourceFile, typeChecker *checker.Checker, node *ast.SwitchStatement, missingBranchTypes []*checker.Type, defaultCase *ast.CaseOrDefaultClause, symbolName string) []rule.RuleFix {
 	missingCases := buildMissingCases(typeChecker, missingBranchTypes, symbolName)
-	return applyMissingCases(sourceFile, node, defaultCase, missingCases)
+	// The added cases throw for values that previously fell through
+	return rule.RuleFixesWithKind(rule.RuleFixKindUnsafe, applyMissingCases(sourceFile, node, defaultCase, missingCases))
 }
 
 var SwitchExhaustivenessCheckRule = rule.Rule{
@@ -297,7 +298,7 @@ var SwitchExhaustivenessCheckRule = rule.Rule{
 						continue
 					}
 
-					missingBranches = append(missingBranches, ctx.TypeChecker.TypeToString(missingType))
+					missingBranches = append(missingBranches, ctx.Types.TypeToString(missingType))
 				}
 
 				ctx.ReportNodeWithSuggestions(node.Expression, buildSwitchIsNotExhaustiveMessage(strings.Join(missingBranches, " | ")), func() []rule.RuleSuggestion {
@@ -344,7 +345,7 @@ var SwitchExhaustivenessCheckRule = rule.Rule{
 
 				stmt := node.AsSwitchStatement()
 
-				metadata := getSwitchMetadata(ctx.SourceFile, ctx.TypeChecker, stmt, commentPattern)
+				metadata := getSwitchMetadata(ctx, stmt, commentPattern)
 				checkSwitchExhaustive(stmt, metadata)
 				checkSwitchUnnecessaryDefaultCase(metadata)
 				checkSwitchNoUnionDefaultCase(stmt, metadata)
@@ -790,6 +790,7 @@ switch (value) {
 case "literal": { throw new Error('Not implemented yet: "literal" case') }
 }
       `,
+							Kind: "unsafe",
 						},
 					},
 				},
@@ -210,7 +210,7 @@ var UnboundMethodRule = rule.Rule{
 			//
 			// See related discussion https://github.com/typescript-eslint/typescript-eslint/pull/8952#discussion_r1576543310
 			if ast.IsIdentifier(object) && ast.IsIdentifier(property) {
-				objectSymbol := ctx.TypeChecker.GetSymbolAtLocation(object)
+				objectSymbol := ctx.Types.GetSymbolAtLocation(object)
 				notImported := objectSymbol != nil && isNotImported(objectSymbol, ctx.SourceFile)
 
 				if notImported {
@@ -224,7 +224,7 @@ var UnboundMethodRule = rule.Rule{
 
 			// if `${object.name}.${property.name}` doesn't match any of
 			// the nativelyBoundMembers, then we fallback to type-level checks
-			return utils.IsBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(object), supportedGlobalTypes...) && utils.IsAnyBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(property))
+			return utils.IsBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, ctx.Types.GetTypeAtLocation(object), supportedGlobalTypes...) && utils.IsAnyBuiltinSymbolLike(ctx.Program, ctx.TypeChecker, ctx.Types.GetTypeAtLocation(property))
 		}
 
 		checkIfMethodAndReport := func(node *ast.Node, dangerousReference *ast.Node, symbol *ast.Symbol) bool {
@@ -254,7 +254,7 @@ var UnboundMethodRule = rule.Rule{
 
 			if initNode != nil {
 				if !isNativelyBound(initNode, propertyName) {
-					reported := checkIfMethodAndReport(propertyName, propertyName, checker.Checker_getPropertyOfType(ctx.TypeChecker, ctx.TypeChecker.GetTypeAtLocation(initNode), propertyName.Text()))
+					reported := checkIfMethodAndReport(propertyName, propertyName, ctx.Types.GetPropertyOfType(ctx.Types.GetTypeAtLocation(initNode), propertyName.Text()))
 					if reported {
 						return
 					}
@@ -267,8 +267,8 @@ var UnboundMethodRule = rule.Rule{
 				}
 			}
 
-			utils.TypeRecurser(ctx.TypeChecker.GetTypeAtLocation(patternNode), func(t *checker.Type) bool {
-				return checkIfMethodAndReport(propertyName, propertyName, checker.Checker_getPropertyOfType(ctx.TypeChecker, t, propertyName.Text()))
+			utils.TypeRecurser(ctx.Types.GetTypeAtLocation(patternNode), func(t *checker.Type) bool {
+				return checkIfMethodAndReport(propertyName, propertyName, ctx.Types.GetPropertyOfType(t, propertyName.Text()))
 			})
 		}
 
@@ -278,7 +278,7 @@ var UnboundMethodRule = rule.Rule{
 					return
 				}
 
-				checkIfMethodAndReport(node, node.Name(), ctx.TypeChecker.GetSymbolAtLocation(node))
+				checkIfMethodAndReport(node, node.Name(), ctx.Types.GetSymbolAtLocation(node))
 			},
 
 			rule.ListenerOnAllowPattern(ast.KindObjectLiteralExpression): func(node *ast.Node) {
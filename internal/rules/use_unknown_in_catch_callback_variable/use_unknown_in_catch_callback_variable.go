@@ -62,8 +62,8 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 		var collectFlaggedNodes func(node *ast.Node) []*ast.Node
 
 		isFlaggableHandlerType := func(t *checker.Type) bool {
-			for _, part := range utils.UnionTypeParts(t) {
-				for _, callSignature := range utils.GetCallSignatures(ctx.TypeChecker, part) {
+			for _, part := range ctx.Types.UnionTypeParts(t) {
+				for _, callSignature := range ctx.Types.GetCallSignatures(part) {
 					params := checker.Signature_parameters(callSignature)
 					if len(params) == 0 {
 						continue
@@ -71,15 +71,15 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 
 					firstParam := params[0]
 
-					firstParamType := checker.Checker_getTypeOfSymbol(ctx.TypeChecker, firstParam)
+					firstParamType := ctx.Types.GetTypeOfSymbol(firstParam)
 					decl := firstParam.ValueDeclaration
 
 					if decl != nil && decl.AsParameterDeclaration().DotDotDotToken != nil {
 						// a rest arg that's not an array or tuple should definitely be flagged.
-						if !checker.Checker_isArrayOrTupleType(ctx.TypeChecker, firstParamType) {
+						if !ctx.Types.IsArrayOrTupleType(firstParamType) {
 							return true
 						}
-						firstParamType = checker.Checker_getTypeArguments(ctx.TypeChecker, firstParamType)[0]
+						firstParamType = ctx.Types.GetTypeArguments(firstParamType)[0]
 					}
 
 					if !utils.IsTypeFlagSet(firstParamType, checker.TypeFlagsUnknown) {
@@ -105,7 +105,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 				n := node.AsConditionalExpression()
 				return append(collectFlaggedNodes(n.WhenTrue), collectFlaggedNodes(n.WhenFalse)...)
 			case ast.KindArrowFunction, ast.KindFunctionExpression:
-				t := ctx.TypeChecker.GetTypeAtLocation(node)
+				t := ctx.Types.GetTypeAtLocation(node)
 				if isFlaggableHandlerType(t) {
 					return []*ast.Node{node}
 				}
@@ -121,7 +121,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 					return
 				}
 
-				propertyName, found := checker.Checker_getAccessedPropertyName(ctx.TypeChecker, callee)
+				propertyName, found := ctx.Types.GetAccessedPropertyName(callee)
 				if !found {
 					return
 				}
@@ -154,7 +154,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 					}
 				}
 
-				if !utils.IsThenableType(ctx.TypeChecker, callee, ctx.TypeChecker.GetTypeAtLocation(callee.Expression())) {
+				if !ctx.Types.IsThenableType(callee, ctx.Types.GetTypeAtLocation(callee.Expression())) {
 					return
 				}
 
@@ -169,7 +169,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 							ctx.ReportNodeWithSuggestions(catchParamNode, buildUseUnknownMessage(method), func() []rule.RuleSuggestion {
 								return []rule.RuleSuggestion{{
 									Message:  buildAddUnknownRestTypeAnnotationSuggestionMessage(),
-									FixesArr: []rule.RuleFix{rule.RuleFixInsertAfter(catchVariable, ": [unknown]")},
+									FixesArr: []rule.RuleFix{rule.RuleFixInsertAfter(catchVariable, ": [unknown]").WithKind(rule.RuleFixKindSafe)},
 								}}
 							})
 							continue
@@ -179,7 +179,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 							return []rule.RuleSuggestion{{
 								Message: buildWrongRestTypeAnnotationSuggestionMessage(),
 								FixesArr: []rule.RuleFix{
-									rule.RuleFixReplace(ctx.SourceFile, catchTypeAnnotation, "[unknown]"),
+									rule.RuleFixReplace(ctx.SourceFile, catchTypeAnnotation, "[unknown]").WithKind(rule.RuleFixKindSafe),
 								},
 							}}
 						})
@@ -208,7 +208,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 							ctx.ReportNodeWithSuggestions(catchParamNode, buildUseUnknownMessage(method), func() []rule.RuleSuggestion {
 								return []rule.RuleSuggestion{{
 									Message:  buildAddUnknownTypeAnnotationSuggestionMessage(),
-									FixesArr: fixes,
+									FixesArr: rule.RuleFixesWithKind(rule.RuleFixKindSafe, fixes),
 								}}
 							})
 							break
@@ -216,7 +216,7 @@ var UseUnknownInCatchCallbackVariableRule = rule.Rule{
 						ctx.ReportNodeWithSuggestions(catchParamNode, buildUseUnknownMessage(method), func() []rule.RuleSuggestion {
 							return []rule.RuleSuggestion{{
 								Message:  buildWrongTypeAnnotationSuggestionMessage(),
-								FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, catchTypeAnnotation, "unknown")},
+								FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, catchTypeAnnotation, "unknown").WithKind(rule.RuleFixKindSafe)},
 							}}
 						})
 					case ast.KindArrayBindingPattern:
@@ -229,6 +229,7 @@ Promise.resolve().catch((e: unknown, ...rest: []) => {
   throw err;
 });
       `,
+							Kind: "safe",
 						},
 					},
 				},
@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/microsoft/typescript-go/shim/bundled"
 	"github.com/microsoft/typescript-go/shim/compiler"
@@ -27,7 +29,22 @@ func enhanceHelpDiagnosticMessage(msg string) string {
 	return msg
 }
 
+// Time spent creating a program.
+type ProgramTimings struct {
+	Start time.Time
+	// Parsing the tsconfig, resolving and parsing the files
+	Create time.Duration
+	Bind   time.Duration
+}
+
 func CreateProgram(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath string, host compiler.CompilerHost, suppressProgramDiagnostics bool) (*compiler.Program, []diagnostic.Internal, error) {
+	return CreateProgramWithTimings(singleThreaded, fs, cwd, tsconfigPath, host, suppressProgramDiagnostics, nil)
+}
+
+// Same as CreateProgram, but also records the time spent in timings, unless it
+// is nil.
+func CreateProgramWithTimings(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath string, host compiler.CompilerHost, suppressProgramDiagnostics bool, timings *ProgramTimings) (*compiler.Program, []diagnostic.Internal, error) {
+	start := time.Now()
 	resolvedConfigPath := tspath.ResolvePath(cwd, tsconfigPath)
 	if !fs.FileExists(resolvedConfigPath) {
 		return nil, nil, fmt.Errorf("couldn't read tsconfig at %v", resolvedConfigPath)
@@ -112,30 +129,161 @@ func CreateProgram(singleThreaded bool, fs vfs.FS, cwd string, tsconfigPath stri
 
 	// TODO: report syntactic diagnostics?
 
-	program.BindSourceFiles()
+	bindProgram(program, start, timings)
 
 	return program, nil, nil
 }
 
+// Compiler options of the inferred project, the program of the files which are
+// not included by any tsconfig. The values are the ones of tsconfig.json, and
+// unset options keep the defaults of CreateInferredProjectProgram.
+type InferredProjectOptions struct {
+	// Replaces the default strictNullChecks and strictFunctionTypes
+	Strict *bool `json:"strict,omitempty"`
+	// e.g. "es2022"
+	Target string `json:"target,omitempty"`
+	// e.g. ["es2022", "dom"]
+	Lib []string `json:"lib,omitempty"`
+	// "node10", "node16", "nodenext" or "bundler". Also sets `module`, which
+	// must match.
+	ModuleResolution string   `json:"moduleResolution,omitempty"`
+	AllowJs          *bool    `json:"allowJs,omitempty"`
+	CheckJs          *bool    `json:"checkJs,omitempty"`
+	Types            []string `json:"types,omitempty"`
+}
+
+var inferredProjectTargets = map[string]core.ScriptTarget{
+	"es5":    core.ScriptTargetES5,
+	"es6":    core.ScriptTargetES2015,
+	"es2015": core.ScriptTargetES2015,
+	"es2016": core.ScriptTargetES2016,
+	"es2017": core.ScriptTargetES2017,
+	"es2018": core.ScriptTargetES2018,
+	"es2019": core.ScriptTargetES2019,
+	"es2020": core.ScriptTargetES2020,
+	"es2021": core.ScriptTargetES2021,
+	"es2022": core.ScriptTargetES2022,
+	"es2023": core.ScriptTargetES2023,
+	"es2024": core.ScriptTargetES2024,
+	"es2025": core.ScriptTargetES2025,
+	"esnext": core.ScriptTargetESNext,
+}
+
+type inferredProjectModuleResolution struct {
+	resolution core.ModuleResolutionKind
+	module     core.ModuleKind
+}
+
+var inferredProjectModuleResolutions = map[string]inferredProjectModuleResolution{
+	"node":     {core.ModuleResolutionKindNode10, core.ModuleKindCommonJS},
+	"node10":   {core.ModuleResolutionKindNode10, core.ModuleKindCommonJS},
+	"node16":   {core.ModuleResolutionKindNode16, core.ModuleKindNode16},
+	"nodenext": {core.ModuleResolutionKindNodeNext, core.ModuleKindNodeNext},
+	"bundler":  {core.ModuleResolutionKindBundler, core.ModuleKindESNext},
+}
+
+func tristate(value bool) core.Tristate {
+	if value {
+		return core.TSTrue
+	}
+	return core.TSFalse
+}
+
+// Returns the compiler options of the inferred project, or an error if an
+// option has an unknown value. o may be nil.
+func (o *InferredProjectOptions) CompilerOptions() (*core.CompilerOptions, error) {
+	options := &core.CompilerOptions{
+		AllowJs:                    core.TSTrue,
+		Module:                     core.ModuleKindESNext,
+		ModuleResolution:           core.ModuleResolutionKindBundler,
+		Target:                     core.ScriptTargetES2022,
+		Jsx:                        core.JsxEmitReactJSX,
+		AllowImportingTsExtensions: core.TSTrue,
+		StrictNullChecks:           core.TSTrue,
+		StrictFunctionTypes:        core.TSTrue,
+		SourceMap:                  core.TSTrue,
+		ESModuleInterop:            core.TSTrue,
+		AllowNonTsExtensions:       core.TSTrue,
+		ResolveJsonModule:          core.TSTrue,
+	}
+	if o == nil {
+		return options, nil
+	}
+
+	if o.Strict != nil {
+		options.Strict = tristate(*o.Strict)
+		options.StrictNullChecks = core.TSUnknown
+		options.StrictFunctionTypes = core.TSUnknown
+	}
+	if o.Target != "" {
+		target, ok := inferredProjectTargets[strings.ToLower(o.Target)]
+		if !ok {
+			return nil, fmt.Errorf("unknown target %q", o.Target)
+		}
+		options.Target = target
+	}
+	if o.Lib != nil {
+		options.Lib = make([]string, len(o.Lib))
+		for i, lib := range o.Lib {
+			lib = strings.ToLower(lib)
+			if _, ok := tsoptions.GetLibFileName(lib); !ok {
+				return nil, fmt.Errorf("unknown lib %q", o.Lib[i])
+			}
+			options.Lib[i] = lib
+		}
+	}
+	if o.ModuleResolution != "" {
+		moduleResolution, ok := inferredProjectModuleResolutions[strings.ToLower(o.ModuleResolution)]
+		if !ok {
+			return nil, fmt.Errorf("unknown moduleResolution %q", o.ModuleResolution)
+		}
+		options.ModuleResolution = moduleResolution.resolution
+		options.Module = moduleResolution.module
+	}
+	if o.AllowJs != nil {
+		options.AllowJs = tristate(*o.AllowJs)
+	}
+	if o.CheckJs != nil {
+		options.CheckJs = tristate(*o.CheckJs)
+	}
+	if o.Types != nil {
+		options.Types = o.Types
+	}
+	return options, nil
+}
+
 func CreateInferredProjectProgram(singleThreaded bool, fs vfs.FS, cwd string, host compiler.CompilerHost, fileNames []string) (*compiler.Program, []diagnostic.Internal, error) {
+	return CreateInferredProjectProgramWithTimings(singleThreaded, fs, cwd, host, fileNames, nil, nil)
+}
+
+// Same as CreateInferredProjectProgram, but with the compiler options of
+// inferredOptions, which may be nil, and also records the time spent in
+// timings, unless it is nil.
+func CreateInferredProjectProgramWithTimings(singleThreaded bool, fs vfs.FS, cwd string, host compiler.CompilerHost, fileNames []string, inferredOptions *InferredProjectOptions, timings *ProgramTimings) (*compiler.Program, []diagnostic.Internal, error) {
+	start := time.Now()
+	compilerOptions, err := inferredOptions.CompilerOptions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid inferred project options: %w", err)
+	}
+	if logger := Logger(LogSubsystemProgram); logger.Enabled(context.Background(), LogLevelDebug) {
+		logger.Debug("inferred project options",
+			"strict", compilerOptions.Strict.IsTrue(),
+			"strictNullChecks", compilerOptions.GetStrictOptionValue(compilerOptions.StrictNullChecks),
+			"target", compilerOptions.Target,
+			"lib", compilerOptions.Lib,
+			"module", compilerOptions.Module,
+			"moduleResolution", compilerOptions.ModuleResolution,
+			"allowJs", compilerOptions.AllowJs.IsTrue(),
+			"checkJs", compilerOptions.CheckJs.IsTrue(),
+			"types", compilerOptions.Types,
+		)
+	}
+
 	opts := compiler.ProgramOptions{
 		Config: &tsoptions.ParsedCommandLine{
 			ParsedConfig: &core.ParsedOptions{
-				CompilerOptions: &core.CompilerOptions{
-					AllowJs:                    core.TSTrue,
-					Module:                     core.ModuleKindESNext,
-					ModuleResolution:           core.ModuleResolutionKindBundler,
-					Target:                     core.ScriptTargetES2022,
-					Jsx:                        core.JsxEmitReactJSX,
-					AllowImportingTsExtensions: core.TSTrue,
-					StrictNullChecks:           core.TSTrue,
-					StrictFunctionTypes:        core.TSTrue,
-					SourceMap:                  core.TSTrue,
-					ESModuleInterop:            core.TSTrue,
-					AllowNonTsExtensions:       core.TSTrue,
-					ResolveJsonModule:          core.TSTrue,
-				},
-				FileNames: fileNames,
+				CompilerOptions: compilerOptions,
+				FileNames:       fileNames,
 			},
 		},
 		SingleThreaded: core.TSTrue,
@@ -148,6 +296,19 @@ func CreateInferredProjectProgram(singleThreaded bool, fs vfs.FS, cwd string, ho
 
 	// TODO: report syntactic diagnostics?
 
-	program.BindSourceFiles()
+	bindProgram(program, start, timings)
 	return program, nil, nil
 }
+
+// Binds the files of program, which was started to be created at start.
+func bindProgram(program *compiler.Program, start time.Time, timings *ProgramTimings) {
+	if timings == nil {
+		program.BindSourceFiles()
+		return
+	}
+	bindStart := time.Now()
+	timings.Start = start
+	timings.Create = bindStart.Sub(start)
+	program.BindSourceFiles()
+	timings.Bind = time.Since(bindStart)
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"gotest.tools/v3/assert"
+)
+
+func TestInferredProjectOptions(t *testing.T) {
+	var defaults *InferredProjectOptions
+	options, err := defaults.CompilerOptions()
+	assert.NilError(t, err)
+	assert.Equal(t, options.Target, core.ScriptTargetES2022)
+	assert.Equal(t, options.StrictNullChecks, core.TSTrue)
+	assert.Equal(t, options.Strict, core.TSUnknown)
+
+	strict := true
+	checkJs := true
+	options, err = (&InferredProjectOptions{
+		Strict:           &strict,
+		Target:           "ES2020",
+		Lib:              []string{"es2020", "DOM"},
+		ModuleResolution: "nodenext",
+		CheckJs:          &checkJs,
+		Types:            []string{},
+	}).CompilerOptions()
+	assert.NilError(t, err)
+	assert.Equal(t, options.Strict, core.TSTrue)
+	assert.Assert(t, options.GetStrictOptionValue(options.StrictNullChecks))
+	assert.Equal(t, options.Target, core.ScriptTargetES2020)
+	assert.DeepEqual(t, options.Lib, []string{"es2020", "dom"})
+	assert.Equal(t, options.ModuleResolution, core.ModuleResolutionKindNodeNext)
+	assert.Equal(t, options.Module, core.ModuleKindNodeNext)
+	assert.Equal(t, options.AllowJs, core.TSTrue)
+	assert.Equal(t, options.CheckJs, core.TSTrue)
+	assert.DeepEqual(t, options.Types, []string{})
+
+	_, err = (&InferredProjectOptions{Target: "es3"}).CompilerOptions()
+	assert.ErrorContains(t, err, `unknown target "es3"`)
+	_, err = (&InferredProjectOptions{Lib: []string{"es2099"}}).CompilerOptions()
+	assert.ErrorContains(t, err, `unknown lib "es2099"`)
+	_, err = (&InferredProjectOptions{ModuleResolution: "classic"}).CompilerOptions()
+	assert.ErrorContains(t, err, `unknown moduleResolution "classic"`)
+}
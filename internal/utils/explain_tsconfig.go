@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"github.com/microsoft/typescript-go/shim/tspath"
+)
+
+// The search FindTsconfigForFile performs for a file, step by step.
+type ConfigExplanation struct {
+	FileName string
+	// The tsconfig.json or jsconfig.json closest to the file, where the search
+	// starts. Empty if there is none.
+	NearestConfig string
+	Steps         []ConfigExplanationStep
+	// The tsconfig of the file, empty if the file goes to the inferred project
+	ConfigFileName string
+}
+
+type ConfigStepKind uint8
+
+const (
+	// A config, or a project it references, was checked for the file
+	ConfigStepVisit ConfigStepKind = iota
+	// None of the configs contain the file, the search continues with the
+	// config of a parent directory
+	ConfigStepAncestor
+	// The config sets `disableSolutionSearching`, so the configs of parent
+	// directories are not searched
+	ConfigStepSolutionSearchingDisabled
+	// No config contains the file, and the earlier result is used
+	ConfigStepFallback
+	// A config matching the preferred config names was checked for the file,
+	// before the usual search
+	ConfigStepPreferred
+)
+
+type ConfigRejection uint8
+
+const (
+	// The config contains the file
+	ConfigRejectionNone ConfigRejection = iota
+	ConfigRejectionNotLoaded
+	ConfigRejectionNoFiles
+	// A composite project whose `include` and `files` cannot match the file
+	ConfigRejectionCompositeMismatch
+	// The file is not one of the `FileNames()` of the config
+	ConfigRejectionNotInFileNames
+)
+
+func (r ConfigRejection) String() string {
+	switch r {
+	case ConfigRejectionNone:
+		return "contains the file"
+	case ConfigRejectionNotLoaded:
+		return "could not be loaded"
+	case ConfigRejectionNoFiles:
+		return "has no files"
+	case ConfigRejectionCompositeMismatch:
+		return "is a composite project whose include patterns do not match the file"
+	case ConfigRejectionNotInFileNames:
+		return "does not include the file"
+	}
+	return "unknown"
+}
+
+type ConfigExplanationStep struct {
+	Kind           ConfigStepKind
+	ConfigFileName string
+	// Only for ConfigStepVisit: the config which references this one, empty for
+	// the config the search started from
+	ReferencedBy string
+	// Only for ConfigStepVisit and ConfigStepPreferred
+	Rejection ConfigRejection
+}
+
+func (e *ConfigExplanation) add(step ConfigExplanationStep) {
+	if e != nil {
+		e.Steps = append(e.Steps, step)
+	}
+}
+
+// Same as FindTsconfigForFile, but returns the steps of the search. Slower, as
+// the search is not shared with other files.
+func (r *TsConfigResolver) ExplainTsconfigForFile(filePath string) ConfigExplanation {
+	explanation := ConfigExplanation{FileName: filePath}
+	path := tspath.ToPath(filePath, r.currentDirectory, r.fs.UseCaseSensitiveFileNames())
+
+	explanation.NearestConfig = r.configFileRegistryBuilder.ComputeConfigFileName(filePath, false, nil)
+	if preferred := r.findPreferredConfig(filePath, path, &explanation); preferred != "" {
+		explanation.ConfigFileName = preferred
+		return explanation
+	}
+	if explanation.NearestConfig == "" {
+		return explanation
+	}
+
+	result := r.findConfigWithReferences(filePath, path, explanation.NearestConfig, nil, nil, &explanation)
+	explanation.ConfigFileName = result.configFileName
+	return explanation
+}
+
+// Reports why the config at configFileName does not contain the file, or
+// ConfigRejectionNone if it does.
+func (r *TsConfigResolver) CheckConfigForFile(configFileName string, filePath string) ConfigRejection {
+	_, rejection := r.checkConfig(filePath, r.toPath(filePath), configFileName)
+	return rejection
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+	"path"
 	"path/filepath"
 	"runtime"
 	"slices"
@@ -19,6 +21,11 @@ type TsConfigResolver struct {
 	fs                        vfs.FS
 	currentDirectory          string
 	configFileRegistryBuilder *project.ConfigFileRegistryBuilder
+	// See SetPreferredConfigs
+	preferredConfigs []string
+	// The preferred configs of each searched directory, in the order of the
+	// patterns
+	preferredConfigsByDirectory collections.SyncMap[tspath.Path, []string]
 }
 
 func NewTsConfigResolver(fs vfs.FS, currentDirectory string) *TsConfigResolver {
@@ -33,20 +40,98 @@ func NewTsConfigResolver(fs vfs.FS, currentDirectory string) *TsConfigResolver {
 	}
 }
 
+// Makes the resolver look for configs whose file name matches one of patterns,
+// e.g. `tsconfig.test.json` or `tsconfig.*.json`, before the usual search. The
+// configs are searched in the directory of each file and its parents up to the
+// current directory, nearest first, and in the order of patterns within a
+// directory. The first config containing the file is used; if none does, the
+// usual search applies.
+func (r *TsConfigResolver) SetPreferredConfigs(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil || strings.ContainsRune(pattern, '/') {
+			return fmt.Errorf("invalid config file name pattern %q", pattern)
+		}
+	}
+	r.preferredConfigs = patterns
+	r.preferredConfigsByDirectory = collections.SyncMap[tspath.Path, []string]{}
+	return nil
+}
+
+// Returns the preferred config containing the file, or "" if there is none.
+func (r *TsConfigResolver) findPreferredConfig(fileName string, path tspath.Path, explanation *ConfigExplanation) string {
+	if len(r.preferredConfigs) == 0 {
+		return ""
+	}
+
+	currentDirectory := r.toPath(r.currentDirectory)
+	for directory := tspath.GetDirectoryPath(fileName); ; {
+		directoryPath := r.toPath(directory)
+		for _, configFileName := range r.preferredConfigsIn(directory, directoryPath) {
+			_, rejection := r.checkConfig(fileName, path, configFileName)
+			explanation.add(ConfigExplanationStep{
+				Kind:           ConfigStepPreferred,
+				ConfigFileName: configFileName,
+				Rejection:      rejection,
+			})
+			if rejection == ConfigRejectionNone {
+				return configFileName
+			}
+		}
+
+		// Files outside of the current directory are searched up to the root
+		parent := tspath.GetDirectoryPath(directory)
+		if directoryPath == currentDirectory || parent == directory {
+			return ""
+		}
+		directory = parent
+	}
+}
+
+// Returns the configs in directory matching the preferred patterns, in the
+// order of the patterns.
+func (r *TsConfigResolver) preferredConfigsIn(directory string, directoryPath tspath.Path) []string {
+	if configFileNames, ok := r.preferredConfigsByDirectory.Load(directoryPath); ok {
+		return configFileNames
+	}
+
+	var configFileNames []string
+	files := r.fs.GetAccessibleEntries(directory).Files
+	for _, pattern := range r.preferredConfigs {
+		for _, file := range files {
+			if r.matchesConfigPattern(pattern, file) {
+				configFileNames = append(configFileNames, tspath.CombinePaths(directory, file))
+			}
+		}
+	}
+	configFileNames, _ = r.preferredConfigsByDirectory.LoadOrStore(directoryPath, configFileNames)
+	return configFileNames
+}
+
+func (r *TsConfigResolver) matchesConfigPattern(pattern string, file string) bool {
+	if !r.fs.UseCaseSensitiveFileNames() {
+		pattern, file = strings.ToLower(pattern), strings.ToLower(file)
+	}
+	matched, _ := path.Match(pattern, file)
+	return matched
+}
+
 // Finds the tsconfig.json that governs the given file
 // Reference: `findOrCreateDefaultConfiguredProjectForOpenScriptInfo` typescript-go/internal/project/projectcollectionbuilder.go:629-671
 func (r *TsConfigResolver) FindTsconfigForFile(filePath string, skipSearchInDirectoryOfFile bool) (configPath string, found bool) {
+	normalizedPath := tspath.ToPath(filePath, r.currentDirectory, r.fs.UseCaseSensitiveFileNames())
+	if preferred := r.findPreferredConfig(filePath, normalizedPath, nil); preferred != "" {
+		return preferred, true
+	}
+
 	configFileName := r.configFileRegistryBuilder.ComputeConfigFileName(filePath, skipSearchInDirectoryOfFile, nil)
 
 	if configFileName == "" {
 		return "", false
 	}
 
-	normalizedPath := tspath.ToPath(filePath, r.currentDirectory, r.fs.UseCaseSensitiveFileNames())
-
 	// Search through the config and its references
 	// This corresponds to findOrCreateDefaultConfiguredProjectWorker
-	result := r.findConfigWithReferences(filePath, normalizedPath, configFileName, nil, nil)
+	result := r.findConfigWithReferences(filePath, normalizedPath, configFileName, nil, nil, nil)
 
 	if result.configFileName != "" {
 		return result.configFileName, true
@@ -72,11 +157,17 @@ func (r *TsConfigResolver) findConfigWithReferences(
 	configFileName string,
 	visited *collections.SyncSet[searchNode],
 	fallback *configSearchResult,
+	explanation *ConfigExplanation,
 ) configSearchResult {
 	var configs collections.SyncMap[tspath.Path, *tsoptions.ParsedCommandLine]
 	if visited == nil {
 		visited = &collections.SyncSet[searchNode]{}
 	}
+	// Only used for the explanation
+	var referencedBy map[string]string
+	if explanation != nil {
+		referencedBy = make(map[string]string)
+	}
 
 	search := BreadthFirstSearch(
 		searchNode{configFileName: configFileName},
@@ -84,70 +175,28 @@ func (r *TsConfigResolver) findConfigWithReferences(
 			if config, ok := configs.Load(r.toPath(node.configFileName)); ok && len(config.ProjectReferences()) > 0 {
 				references := config.ResolvedProjectReferencePaths()
 				return Map(references, func(configFileName string) searchNode {
+					if referencedBy != nil {
+						if _, ok := referencedBy[configFileName]; !ok {
+							referencedBy[configFileName] = node.configFileName
+						}
+					}
 					return searchNode{configFileName: configFileName}
 				})
 			}
 			return nil
 		},
 		func(node searchNode) (isResult bool, stop bool) {
-			configFilePath := r.toPath(node.configFileName)
-
-			config := r.configFileRegistryBuilder.FindOrAcquireConfigForFile(
-				node.configFileName, configFilePath, path, project.ProjectLoadKindCreate, nil,
-			)
-			if config == nil {
-				return false, false
-			}
-			configs.Store(configFilePath, config)
-			if len(config.FileNames()) == 0 {
-				return false, false
-			}
-			if config.CompilerOptions().Composite == core.TSTrue {
-				// For composite projects, we can get an early negative result.
-				// !!! what about declaration files in node_modules? wouldn't it be better to
-				//     check project inclusion if the project is already loaded?
-				if !config.PossiblyMatchesFileName(fileName) {
-					return false, false
-				}
-			}
-
-			if slices.ContainsFunc(config.FileNames(), func(file string) bool {
-				// Fast checks:
-				// 1) check if the strings happen to already be equal (subject to case sensitivity of FS)
-				// 2) check if the base names are equal (subject to case sensitivity of FS)
-
-				// If we're on a case-insensitive FS and the strings are equal, we can return true immediately,
-				// no need to allocate and do any path conversions.
-				if r.fs.UseCaseSensitiveFileNames() {
-					if file == string(path) {
-						return true
-					}
-				} else {
-					if strings.EqualFold(file, string(path)) {
-						return true
-					}
-				}
-
-				// If the base names don't match, we can return false immediately.
-				pathBaseName := filepath.Base(string(path))
-				fileBaseName := filepath.Base(file)
-				if r.fs.UseCaseSensitiveFileNames() {
-					if fileBaseName != pathBaseName {
-						return false
-					}
-				} else {
-					if !strings.EqualFold(fileBaseName, pathBaseName) {
-						return false
-					}
-				}
-
-				// Finally, do a full path conversion and comparison (note: this allocates)
-				return r.toPath(file) == path
-			}) {
-				return true, true
+			config, rejection := r.checkConfig(fileName, path, node.configFileName)
+			if config != nil {
+				configs.Store(r.toPath(node.configFileName), config)
 			}
-
-			return false, false
+			explanation.add(ConfigExplanationStep{
+				Kind:           ConfigStepVisit,
+				ConfigFileName: node.configFileName,
+				ReferencedBy:   referencedBy[node.configFileName],
+				Rejection:      rejection,
+			})
+			return rejection == ConfigRejectionNone, rejection == ConfigRejectionNone
 		},
 		BreadthFirstSearchOptions[searchNode]{
 			Visited: visited,
@@ -178,26 +227,90 @@ func (r *TsConfigResolver) findConfigWithReferences(
 	// workspace.
 	if config, ok := configs.Load(r.toPath(configFileName)); ok && config.CompilerOptions().DisableSolutionSearching.IsTrue() {
 		if fallback != nil {
+			explanation.add(ConfigExplanationStep{Kind: ConfigStepSolutionSearchingDisabled, ConfigFileName: configFileName})
 			return *fallback
 		}
 	}
 
 	if ancestorConfigName := r.getAncestorConfigFileName(fileName, path, configFileName); ancestorConfigName != "" {
+		explanation.add(ConfigExplanationStep{Kind: ConfigStepAncestor, ConfigFileName: ancestorConfigName})
 		return r.findConfigWithReferences(
 			fileName,
 			path,
 			ancestorConfigName,
 			visited,
 			fallback,
+			explanation,
 		)
 	}
 	if fallback != nil {
+		explanation.add(ConfigExplanationStep{Kind: ConfigStepFallback, ConfigFileName: fallback.configFileName})
 		return *fallback
 	}
 
 	return configSearchResult{configFileName: ""}
 }
 
+// Loads the config at configFileName, and checks whether it contains the file.
+// The config is nil if it could not be loaded.
+func (r *TsConfigResolver) checkConfig(fileName string, path tspath.Path, configFileName string) (*tsoptions.ParsedCommandLine, ConfigRejection) {
+	config := r.configFileRegistryBuilder.FindOrAcquireConfigForFile(
+		configFileName, r.toPath(configFileName), path, project.ProjectLoadKindCreate, nil,
+	)
+	if config == nil {
+		return nil, ConfigRejectionNotLoaded
+	}
+	if len(config.FileNames()) == 0 {
+		return config, ConfigRejectionNoFiles
+	}
+	if config.CompilerOptions().Composite == core.TSTrue {
+		// For composite projects, we can get an early negative result.
+		// !!! what about declaration files in node_modules? wouldn't it be better to
+		//     check project inclusion if the project is already loaded?
+		if !config.PossiblyMatchesFileName(fileName) {
+			return config, ConfigRejectionCompositeMismatch
+		}
+	}
+
+	if slices.ContainsFunc(config.FileNames(), func(file string) bool {
+		// Fast checks:
+		// 1) check if the strings happen to already be equal (subject to case sensitivity of FS)
+		// 2) check if the base names are equal (subject to case sensitivity of FS)
+
+		// If we're on a case-insensitive FS and the strings are equal, we can return true immediately,
+		// no need to allocate and do any path conversions.
+		if r.fs.UseCaseSensitiveFileNames() {
+			if file == string(path) {
+				return true
+			}
+		} else {
+			if strings.EqualFold(file, string(path)) {
+				return true
+			}
+		}
+
+		// If the base names don't match, we can return false immediately.
+		pathBaseName := filepath.Base(string(path))
+		fileBaseName := filepath.Base(file)
+		if r.fs.UseCaseSensitiveFileNames() {
+			if fileBaseName != pathBaseName {
+				return false
+			}
+		} else {
+			if !strings.EqualFold(fileBaseName, pathBaseName) {
+				return false
+			}
+		}
+
+		// Finally, do a full path conversion and comparison (note: this allocates)
+		return r.toPath(file) == path
+	}) {
+		return config, ConfigRejectionNone
+	}
+
+	return config, ConfigRejectionNotInFileNames
+}
+
 func (r *TsConfigResolver) getAncestorConfigFileName(fileName string, path tspath.Path, nearestConfigFileName string) string {
 	ancestorConfigName := r.configFileRegistryBuilder.GetAncestorConfigFileName(fileName, path, nearestConfigFileName, nil)
 	if ancestorConfigName != "" {
@@ -214,9 +327,21 @@ type ResolutionResult struct {
 }
 
 func (r *TsConfigResolver) work(in <-chan string, out chan<- ResolutionResult) {
+	logger := Logger(LogSubsystemResolver)
 	for file := range in {
+		fileNormalized := tspath.ToPath(file, r.currentDirectory, r.fs.UseCaseSensitiveFileNames())
+		if preferred := r.findPreferredConfig(file, fileNormalized, nil); preferred != "" {
+			LogTrace(logger, "resolved preferred tsconfig", "file", file, "tsconfig", preferred)
+			out <- ResolutionResult{
+				file:   file,
+				config: preferred,
+			}
+			continue
+		}
+
 		config := r.configFileRegistryBuilder.ComputeConfigFileName(file, false, nil)
 		if config == "" {
+			LogTrace(logger, "no tsconfig found", "file", file)
 			out <- ResolutionResult{
 				file:   file,
 				config: config,
@@ -224,10 +349,9 @@ func (r *TsConfigResolver) work(in <-chan string, out chan<- ResolutionResult) {
 			continue
 		}
 
-		fileNormalized := tspath.ToPath(file, r.currentDirectory, r.fs.UseCaseSensitiveFileNames())
-
 		// Search through the config and its references
-		result := r.findConfigWithReferences(file, fileNormalized, config, nil, nil)
+		result := r.findConfigWithReferences(file, fileNormalized, config, nil, nil, nil)
+		LogTrace(logger, "resolved tsconfig", "file", file, "nearest", config, "tsconfig", result.configFileName)
 		out <- ResolutionResult{
 			config: result.configFileName,
 			file:   file,
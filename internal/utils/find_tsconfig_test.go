@@ -243,3 +243,125 @@ func TestFindTsConfigParallel_Determinism(t *testing.T) {
 		}
 	}
 }
+
+func TestExplainTsconfigForFile_AncestorConfig(t *testing.T) {
+	rootDir := t.TempDir()
+	filePath := filepath.Join(rootDir, "packages", "cli", "src", "index.ts")
+	nestedConfigPath := filepath.Join(rootDir, "packages", "cli", "tsconfig.json")
+	rootConfigPath := filepath.Join(rootDir, "tsconfig.json")
+
+	assert.NilError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+	assert.NilError(t, os.WriteFile(filePath, []byte("export {};\n"), 0o644))
+	assert.NilError(t, os.WriteFile(rootConfigPath, []byte(`{}`), 0o644))
+	assert.NilError(t, os.WriteFile(nestedConfigPath, []byte(`{ "include": ["test"] }`), 0o644))
+
+	resolver := NewTsConfigResolver(osvfs.FS(), rootDir)
+	explanation := resolver.ExplainTsconfigForFile(filePath)
+
+	assert.Equal(t, explanation.NearestConfig, nestedConfigPath)
+	assert.Equal(t, explanation.ConfigFileName, rootConfigPath)
+	assert.DeepEqual(t, explanation.Steps, []ConfigExplanationStep{
+		{Kind: ConfigStepVisit, ConfigFileName: nestedConfigPath, Rejection: ConfigRejectionNoFiles},
+		{Kind: ConfigStepAncestor, ConfigFileName: rootConfigPath},
+		{Kind: ConfigStepVisit, ConfigFileName: rootConfigPath, Rejection: ConfigRejectionNone},
+	})
+
+	config, _ := resolver.FindTsconfigForFile(filePath, false)
+	assert.Equal(t, explanation.ConfigFileName, config)
+}
+
+func TestExplainTsconfigForFile_NoConfig(t *testing.T) {
+	rootDir := t.TempDir()
+	filePath := filepath.Join(rootDir, "index.ts")
+	assert.NilError(t, os.WriteFile(filePath, []byte("export {};\n"), 0o644))
+
+	explanation := NewTsConfigResolver(osvfs.FS(), rootDir).ExplainTsconfigForFile(filePath)
+	assert.Equal(t, explanation.NearestConfig, "")
+	assert.Equal(t, explanation.ConfigFileName, "")
+	assert.Equal(t, len(explanation.Steps), 0)
+}
+
+func TestFindTsConfigParallel_PreferredConfigs(t *testing.T) {
+	rootDir := t.TempDir()
+	filePath := filepath.Join(rootDir, "src", "index.ts")
+	testFilePath := filepath.Join(rootDir, "src", "index.test.ts")
+	rootConfigPath := filepath.Join(rootDir, "tsconfig.json")
+	testConfigPath := filepath.Join(rootDir, "tsconfig.test.json")
+
+	assert.NilError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+	assert.NilError(t, os.WriteFile(filePath, []byte("export {};\n"), 0o644))
+	assert.NilError(t, os.WriteFile(testFilePath, []byte("export {};\n"), 0o644))
+	assert.NilError(t, os.WriteFile(rootConfigPath, []byte(`{}`), 0o644))
+	assert.NilError(t, os.WriteFile(testConfigPath, []byte(`{ "include": ["src/**/*.test.ts"] }`), 0o644))
+
+	resolver := NewTsConfigResolver(osvfs.FS(), rootDir)
+	assert.NilError(t, resolver.SetPreferredConfigs([]string{"tsconfig.*.json"}))
+
+	results := resolver.FindTsConfigParallel([]string{filePath, testFilePath})
+	assert.Equal(t, results[filePath], rootConfigPath)
+	assert.Equal(t, results[testFilePath], testConfigPath)
+
+	explanation := resolver.ExplainTsconfigForFile(filePath)
+	assert.Equal(t, explanation.ConfigFileName, rootConfigPath)
+	assert.DeepEqual(t, explanation.Steps[0], ConfigExplanationStep{
+		Kind:           ConfigStepPreferred,
+		ConfigFileName: testConfigPath,
+		Rejection:      ConfigRejectionNotInFileNames,
+	})
+
+	assert.ErrorContains(t, resolver.SetPreferredConfigs([]string{"config/tsconfig.json"}), "invalid config file name pattern")
+}
+
+func TestFindTsConfigParallel_PreferredConfigsAboveCurrentDirectory(t *testing.T) {
+	rootDir := t.TempDir()
+	packageDir := filepath.Join(rootDir, "package")
+	testFilePath := filepath.Join(packageDir, "src", "index.test.ts")
+	packageConfigPath := filepath.Join(packageDir, "tsconfig.json")
+
+	assert.NilError(t, os.MkdirAll(filepath.Dir(testFilePath), 0o755))
+	assert.NilError(t, os.WriteFile(testFilePath, []byte("export {};\n"), 0o644))
+	assert.NilError(t, os.WriteFile(packageConfigPath, []byte(`{}`), 0o644))
+	assert.NilError(t, os.WriteFile(filepath.Join(rootDir, "tsconfig.test.json"), []byte(`{ "include": ["package/src/**/*.test.ts"] }`), 0o644))
+
+	resolver := NewTsConfigResolver(osvfs.FS(), packageDir)
+	assert.NilError(t, resolver.SetPreferredConfigs([]string{"tsconfig.*.json"}))
+
+	results := resolver.FindTsConfigParallel([]string{testFilePath})
+	assert.Equal(t, results[testFilePath], packageConfigPath)
+}
+
+func TestCheckConfigForFile(t *testing.T) {
+	rootDir := t.TempDir()
+	filePath := filepath.Join(rootDir, "src", "index.ts")
+	otherFilePath := filepath.Join(rootDir, "src", "other.ts")
+	libFilePath := filepath.Join(rootDir, "lib", "index.ts")
+
+	assert.NilError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+	assert.NilError(t, os.MkdirAll(filepath.Dir(libFilePath), 0o755))
+	for _, file := range []string{filePath, otherFilePath, libFilePath} {
+		assert.NilError(t, os.WriteFile(file, []byte("export {};\n"), 0o644))
+	}
+
+	configs := map[string]string{
+		"tsconfig.json":           `{}`,
+		"tsconfig.empty.json":     `{ "include": ["test"] }`,
+		"tsconfig.composite.json": `{ "compilerOptions": { "composite": true }, "include": ["lib"] }`,
+		"tsconfig.other.json":     `{ "files": ["src/other.ts"] }`,
+	}
+	for name, text := range configs {
+		assert.NilError(t, os.WriteFile(filepath.Join(rootDir, name), []byte(text), 0o644))
+	}
+
+	resolver := NewTsConfigResolver(osvfs.FS(), rootDir)
+	for _, tc := range []struct {
+		config    string
+		rejection ConfigRejection
+	}{
+		{"tsconfig.json", ConfigRejectionNone},
+		{"tsconfig.empty.json", ConfigRejectionNoFiles},
+		{"tsconfig.composite.json", ConfigRejectionCompositeMismatch},
+		{"tsconfig.other.json", ConfigRejectionNotInFileNames},
+	} {
+		assert.Equal(t, resolver.CheckConfigForFile(filepath.Join(rootDir, tc.config), filePath), tc.rejection, tc.config)
+	}
+}
@@ -1,22 +1,168 @@
 package utils
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"strings"
 	"sync"
 )
 
-type LogLevel uint8
+type LogLevel = slog.Level
 
 const (
-	LogLevelNormal LogLevel = iota
-	LogLevelDebug
+	LogLevelError = slog.LevelError
+	LogLevelWarn  = slog.LevelWarn
+	LogLevelInfo  = slog.LevelInfo
+	LogLevelDebug = slog.LevelDebug
+	// e.g. every linted file
+	LogLevelTrace = slog.LevelDebug - 4
 )
 
-var GetLogLevel = sync.OnceValue(func() LogLevel {
-	switch os.Getenv("OXC_LOG") {
-	case "debug":
-		return LogLevelDebug
-	default:
-		return LogLevelNormal
+var logLevelNames = map[string]LogLevel{
+	"error": LogLevelError,
+	"warn":  LogLevelWarn,
+	"info":  LogLevelInfo,
+	"debug": LogLevelDebug,
+	"trace": LogLevelTrace,
+}
+
+type LogSubsystem string
+
+const (
+	// Finding the tsconfig of files
+	LogSubsystemResolver LogSubsystem = "resolver"
+	// Creating programs
+	LogSubsystemProgram LogSubsystem = "program"
+	// Running rules on programs, headless and LSP sessions
+	LogSubsystemLinter LogSubsystem = "linter"
+	// Diagnostics reported by rules
+	LogSubsystemRules LogSubsystem = "rules"
+)
+
+var logSubsystems = []LogSubsystem{LogSubsystemResolver, LogSubsystemProgram, LogSubsystemLinter, LogSubsystemRules}
+
+type LogConfig struct {
+	Level LogLevel
+	// Overrides of Level
+	Subsystems map[LogSubsystem]LogLevel
+	JSON       bool
+}
+
+func (c LogConfig) levelOf(subsystem LogSubsystem) LogLevel {
+	if level, ok := c.Subsystems[subsystem]; ok {
+		return level
+	}
+	return c.Level
+}
+
+// Parses the value of OXC_LOG.
+func ParseLogConfig(value string) (LogConfig, error) {
+	config := LogConfig{
+		Level:      LogLevelWarn,
+		Subsystems: make(map[LogSubsystem]LogLevel),
+	}
+	for directive := range strings.SplitSeq(value, ",") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+
+		subsystem, levelName, hasSubsystem := strings.Cut(directive, "=")
+		if !hasSubsystem {
+			levelName = directive
+		}
+		level, ok := logLevelNames[strings.ToLower(levelName)]
+		if !ok {
+			return config, fmt.Errorf("unknown log level %q", levelName)
+		}
+
+		if !hasSubsystem {
+			config.Level = level
+			continue
+		}
+		known := false
+		for _, s := range logSubsystems {
+			known = known || string(s) == subsystem
+		}
+		if !known {
+			return config, fmt.Errorf("unknown log subsystem %q", subsystem)
+		}
+		config.Subsystems[LogSubsystem(subsystem)] = level
 	}
-})
+	return config, nil
+}
+
+var (
+	loggersMu sync.Mutex
+	loggers   map[LogSubsystem]*slog.Logger
+	// Configures the loggers from the environment, unless ConfigureLogging was
+	// called before
+	configureDefaultLogging sync.Once
+)
+
+// Sets up the loggers of Logger to write to w.
+func ConfigureLogging(config LogConfig, w io.Writer) {
+	configureDefaultLogging.Do(func() {})
+	configureLogging(config, w)
+}
+
+func configureLogging(config LogConfig, w io.Writer) {
+	l := make(map[LogSubsystem]*slog.Logger, len(logSubsystems))
+	for _, subsystem := range logSubsystems {
+		options := &slog.HandlerOptions{
+			Level:       config.levelOf(subsystem),
+			ReplaceAttr: replaceLogLevel,
+		}
+		var handler slog.Handler
+		if config.JSON {
+			handler = slog.NewJSONHandler(w, options)
+		} else {
+			handler = slog.NewTextHandler(w, options)
+		}
+		l[subsystem] = slog.New(handler).With("subsystem", string(subsystem))
+	}
+
+	loggersMu.Lock()
+	defer loggersMu.Unlock()
+	loggers = l
+}
+
+// slog names the levels below debug "DEBUG-4".
+func replaceLogLevel(groups []string, attr slog.Attr) slog.Attr {
+	if attr.Key == slog.LevelKey && len(groups) == 0 {
+		if level, ok := attr.Value.Any().(slog.Level); ok && level == LogLevelTrace {
+			return slog.String(slog.LevelKey, "TRACE")
+		}
+	}
+	return attr
+}
+
+// Returns the logger of subsystem. Logs are written to stderr, and configured
+// by environment variables:
+//
+//   - OXC_LOG is a comma-separated list of a default level and of
+//     `subsystem=level` overrides, e.g. `OXC_LOG=info,linter=trace`. The levels
+//     are error, warn, info, debug and trace. Defaults to warn.
+//   - OXC_LOG_FORMAT=json writes one JSON object per line instead of text, for
+//     frontends forwarding the logs into their own output.
+func Logger(subsystem LogSubsystem) *slog.Logger {
+	configureDefaultLogging.Do(func() {
+		config, err := ParseLogConfig(os.Getenv("OXC_LOG"))
+		config.JSON = os.Getenv("OXC_LOG_FORMAT") == "json"
+		configureLogging(config, os.Stderr)
+		if err != nil {
+			loggers[LogSubsystemLinter].Warn("invalid OXC_LOG", "error", err)
+		}
+	})
+
+	loggersMu.Lock()
+	defer loggersMu.Unlock()
+	return loggers[subsystem]
+}
+
+func LogTrace(logger *slog.Logger, msg string, args ...any) {
+	logger.Log(context.Background(), LogLevelTrace, msg, args...)
+}
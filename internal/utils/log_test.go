@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-json-experiment/json"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseLogConfig(t *testing.T) {
+	config, err := ParseLogConfig("")
+	assert.NilError(t, err)
+	assert.Equal(t, config.Level, LogLevelWarn)
+
+	config, err = ParseLogConfig("debug")
+	assert.NilError(t, err)
+	assert.Equal(t, config.Level, LogLevelDebug)
+	assert.Equal(t, config.levelOf(LogSubsystemRules), LogLevelDebug)
+
+	config, err = ParseLogConfig("info, linter=trace,resolver=ERROR")
+	assert.NilError(t, err)
+	assert.Equal(t, config.levelOf(LogSubsystemProgram), LogLevelInfo)
+	assert.Equal(t, config.levelOf(LogSubsystemLinter), LogLevelTrace)
+	assert.Equal(t, config.levelOf(LogSubsystemResolver), LogLevelError)
+
+	_, err = ParseLogConfig("verbose")
+	assert.ErrorContains(t, err, `unknown log level "verbose"`)
+	_, err = ParseLogConfig("parser=debug")
+	assert.ErrorContains(t, err, `unknown log subsystem "parser"`)
+}
+
+func TestConfigureLogging(t *testing.T) {
+	var output bytes.Buffer
+	config, err := ParseLogConfig("warn,linter=trace")
+	assert.NilError(t, err)
+	config.JSON = true
+	ConfigureLogging(config, &output)
+	defer ConfigureLogging(LogConfig{Level: LogLevelWarn}, os.Stderr)
+
+	LogTrace(Logger(LogSubsystemLinter), "linting file", "file", "a.ts")
+	Logger(LogSubsystemProgram).Info("creating program")
+	Logger(LogSubsystemProgram).Warn("slow program")
+	assert.Assert(t, !Logger(LogSubsystemRules).Enabled(t.Context(), LogLevelDebug))
+
+	var records []map[string]any
+	for line := range strings.Lines(output.String()) {
+		var record map[string]any
+		assert.NilError(t, json.Unmarshal([]byte(line), &record))
+		delete(record, "time")
+		records = append(records, record)
+	}
+	assert.DeepEqual(t, records, []map[string]any{
+		{"level": "TRACE", "msg": "linting file", "subsystem": "linter", "file": "a.ts"},
+		{"level": "WARN", "msg": "slow program", "subsystem": "program"},
+	})
+}